@@ -0,0 +1,232 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	defaultAPIAddress = "127.0.0.1:8484"
+	apiWidgetsPath    = "/widgets"
+)
+
+// APIServer is an optional HTTP server that exposes the state of the running widgets as
+// JSON and lets external tools trigger widget actions. It only ever listens on a loopback
+// address or a unix socket:
+//
+//	wtf:
+//	  api:
+//	    enabled: true
+//	    address: "127.0.0.1:8484"   <- or
+//	    socket: "~/.config/wtf/wtf.sock"
+//
+// The following endpoints are available:
+//
+//	GET  /widgets                 Lists every widget and its current content
+//	GET  /widgets/{name}          Returns a single widget, its current content and values
+//	POST /widgets/{name}/refresh  Refreshes the widget's data
+//	POST /widgets/{name}/focus    Gives the widget onscreen focus
+type APIServer struct {
+	listener net.Listener
+	server   *http.Server
+	wtfApp   *WtfApp
+}
+
+// WidgetState is the representation of a widget returned by the API
+type WidgetState struct {
+	RenderedWidget
+
+	Enabled         bool      `json:"enabled"`
+	Error           string    `json:"error,omitempty"`
+	FocusChar       string    `json:"focusChar"`
+	RefreshedAt     time.Time `json:"refreshedAt"`
+	RefreshInterval int       `json:"refreshInterval"`
+	Refreshing      bool      `json:"refreshing"`
+	Stale           bool      `json:"stale"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+
+	Values map[string]interface{} `json:"values,omitempty"`
+}
+
+// valuer is implemented by widgets that expose the structured values behind what they display
+type valuer interface {
+	Values() map[string]interface{}
+}
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// NewAPIServer creates and returns an APIServer for the app, listening on the address or
+// socket defined in the config. Returns nil if the API is not enabled
+func NewAPIServer(wtfApp *WtfApp, config *config.Config) (*APIServer, error) {
+	if !config.UBool("wtf.api.enabled", false) {
+		return nil, nil
+	}
+
+	listener, err := apiListener(config)
+	if err != nil {
+		return nil, err
+	}
+
+	apiServer := APIServer{
+		listener: listener,
+		wtfApp:   wtfApp,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(apiWidgetsPath, apiServer.handleWidgets)
+	mux.HandleFunc(apiWidgetsPath+"/", apiServer.handleWidget)
+
+	apiServer.server = &http.Server{Handler: mux}
+
+	return &apiServer, nil
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Serve accepts incoming API requests until the server is stopped
+func (apiServer *APIServer) Serve() error {
+	err := apiServer.server.Serve(apiServer.listener)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
+}
+
+// Stop shuts down the server and releases its address or socket
+func (apiServer *APIServer) Stop() {
+	_ = apiServer.server.Close()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (apiServer *APIServer) handleWidgets(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	states := []WidgetState{}
+	for _, widget := range apiServer.wtfApp.currentWidgets() {
+		states = append(states, apiServer.widgetState(widget))
+	}
+
+	writeAPIResponse(w, http.StatusOK, states)
+}
+
+func (apiServer *APIServer) handleWidget(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, apiWidgetsPath), "/"), "/")
+
+	widget := apiServer.wtfApp.widgetNamed(parts[0])
+	if widget == nil {
+		writeAPIError(w, http.StatusNotFound, fmt.Sprintf("no widget named %q", parts[0]))
+		return
+	}
+
+	action := ""
+	if len(parts) > 1 {
+		action = strings.Join(parts[1:], "/")
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		writeAPIResponse(w, http.StatusOK, apiServer.widgetState(widget))
+	case action == "refresh" && r.Method == http.MethodPost:
+		apiServer.wtfApp.scheduler.Refresh(widget)
+		writeAPIResponse(w, http.StatusOK, apiServer.widgetState(widget))
+	case action == "focus" && r.Method == http.MethodPost:
+		if !apiServer.wtfApp.FocusWidget(widget) {
+			writeAPIError(w, http.StatusConflict, fmt.Sprintf("widget %q is not focusable", widget.Name()))
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, apiServer.widgetState(widget))
+	case action == "" || action == "refresh" || action == "focus":
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+	default:
+		writeAPIError(w, http.StatusNotFound, fmt.Sprintf("unknown action %q", action))
+	}
+}
+
+func (apiServer *APIServer) widgetState(widget wtf.Wtfable) WidgetState {
+	state := WidgetState{
+		RenderedWidget: captureWidget(apiServer.wtfApp.app, widget, RenderFormatText),
+
+		Enabled:         widget.Enabled(),
+		Error:           widget.RefreshErrorText(),
+		FocusChar:       widget.FocusChar(),
+		RefreshedAt:     widget.RefreshedAt(),
+		RefreshInterval: widget.RefreshInterval(),
+		Refreshing:      widget.Refreshing(),
+		Stale:           widget.Stale(),
+		UpdatedAt:       widget.UpdatedAt(),
+	}
+
+	if valued, ok := widget.(valuer); ok {
+		state.Values = valued.Values()
+	}
+
+	return state
+}
+
+// apiListener returns a listener on the configured unix socket, if there is one, or
+// on the configured loopback address
+func apiListener(config *config.Config) (net.Listener, error) {
+	socketPath := config.UString("wtf.api.socket", "")
+	if socketPath != "" {
+		socketPath, err := utils.ExpandHomeDir(socketPath)
+		if err != nil {
+			return nil, err
+		}
+
+		// Remove a socket left behind by a previous run, but never anything else
+		if info, err := os.Stat(socketPath); err == nil && info.Mode()&os.ModeSocket != 0 {
+			_ = os.Remove(socketPath)
+		}
+
+		return net.Listen("unix", socketPath)
+	}
+
+	address := config.UString("wtf.api.address", defaultAPIAddress)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLoopbackHost(host) {
+		return nil, fmt.Errorf("api address %q must be a loopback address such as localhost or 127.0.0.1", address)
+	}
+
+	return net.Listen("tcp", address)
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeAPIError(w http.ResponseWriter, status int, message string) {
+	writeAPIResponse(w, status, apiError{Error: message})
+}
+
+func writeAPIResponse(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+const apiConfig = `
+wtf:
+  api:
+    enabled: true
+    address: "127.0.0.1:0"
+  grid:
+    columns: [40]
+    rows: [10]
+  mods:
+    clocks:
+      enabled: true
+      focusable: true
+      locations:
+        UTC: "Etc/UTC"
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+      refreshInterval: 30`
+
+func newTestAPIServer(t *testing.T) (*APIServer, func()) {
+	cfg, _ := config.ParseYaml(apiConfig)
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+
+	wtfApp := NewWtfApp(tviewApp, cfg, "")
+	go func() { _ = tviewApp.Run() }()
+
+	apiServer, err := NewAPIServer(wtfApp, cfg)
+	assert.NoError(t, err)
+
+	return apiServer, func() {
+		apiServer.Stop()
+		tviewApp.Stop()
+	}
+}
+
+func Test_NewAPIServer(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		expectedErr string
+		expectNil   bool
+	}{
+		{
+			name:      "when disabled",
+			config:    "wtf:\n  api:\n    enabled: false",
+			expectNil: true,
+		},
+		{
+			name:        "with a non-loopback address",
+			config:      "wtf:\n  api:\n    enabled: true\n    address: \"0.0.0.0:8484\"",
+			expectedErr: `api address "0.0.0.0:8484" must be a loopback address such as localhost or 127.0.0.1`,
+			expectNil:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYaml(tt.config)
+
+			apiServer, err := NewAPIServer(nil, cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectNil, apiServer == nil)
+		})
+	}
+}
+
+func Test_APIServer_endpoints(t *testing.T) {
+	apiServer, stop := newTestAPIServer(t)
+	defer stop()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "list widgets",
+			method:         http.MethodGet,
+			path:           "/widgets",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "get widget",
+			method:         http.MethodGet,
+			path:           "/widgets/clocks",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "get missing widget",
+			method:         http.MethodGet,
+			path:           "/widgets/cats",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "refresh widget",
+			method:         http.MethodPost,
+			path:           "/widgets/clocks/refresh",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "refresh with wrong method",
+			method:         http.MethodGet,
+			path:           "/widgets/clocks/refresh",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "focus widget",
+			method:         http.MethodPost,
+			path:           "/widgets/clocks/focus",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown action",
+			method:         http.MethodPost,
+			path:           "/widgets/clocks/meow",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(tt.method, tt.path, nil)
+
+			apiServer.server.Handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.True(t, json.Valid(recorder.Body.Bytes()))
+		})
+	}
+}
+
+func Test_WtfApp_startAPIServer(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+
+	// The server is listening by the time startAPIServer returns, and restarting it releases
+	// the old one's address
+	wtfApp.startAPIServer(cfg)
+	first := wtfApp.apiServer
+	assert.NotNil(t, first)
+
+	wtfApp.startAPIServer(cfg)
+	assert.NotNil(t, wtfApp.apiServer)
+	assert.True(t, first != wtfApp.apiServer)
+
+	wtfApp.Stop()
+}
@@ -0,0 +1,141 @@
+package app
+
+import (
+	"sort"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// Breakpoint is an alternative arrangement of a layout's widgets that is used whenever the
+// terminal is at least MinWidth columns wide and MinHeight rows high. A layout can define
+// any number of them, and the one with the largest minimum size that fits is used:
+//
+//	wtf:
+//	  grid:                   <- used when no breakpoint fits the terminal
+//	    columns: [40, 40]
+//	    rows: [10, 10]
+//	  breakpoints:
+//	    large:
+//	      minWidth: 160
+//	      minHeight: 40
+//	      grid:               <- optional, defaults to the layout's grid
+//	        columns: [40, 40, 40, 40]
+//	        rows: [10, 10, 10, 10]
+//	      mods:
+//	        jira:
+//	          position:       <- overrides the module's position at this size
+//	            top: 0
+//	            left: 2
+//	            height: 4
+//	            width: 2
+//	    small:                <- no minimum size, so it fits every terminal
+//	      mods:
+//	        pagerduty:
+//	          hidden: true    <- the module is not displayed at this size
+//
+// Named layouts define their breakpoints the same way, under wtf.layouts.<name>.breakpoints
+type Breakpoint struct {
+	Name      string
+	MinHeight int
+	MinWidth  int
+
+	gridPath  string
+	hidden    []string
+	positions map[string]cfg.PositionSettings
+}
+
+// MakeBreakpoints creates and returns the breakpoints defined for the layout whose settings
+// are found at layoutPath in the config, sorted from largest to smallest
+func MakeBreakpoints(config *config.Config, layoutPath string, gridPath string) []*Breakpoint {
+	breakpointsPath := layoutPath + ".breakpoints"
+
+	breakpointsMap, err := config.Map(breakpointsPath)
+	if err != nil {
+		return []*Breakpoint{}
+	}
+
+	breakpoints := []*Breakpoint{}
+
+	for name := range breakpointsMap {
+		breakpointPath := breakpointsPath + "." + name
+
+		breakpoint := Breakpoint{
+			Name:      name,
+			MinHeight: config.UInt(breakpointPath+".minHeight", 0),
+			MinWidth:  config.UInt(breakpointPath+".minWidth", 0),
+
+			gridPath:  gridPath,
+			hidden:    []string{},
+			positions: map[string]cfg.PositionSettings{},
+		}
+
+		if _, err := config.Get(breakpointPath + ".grid"); err == nil {
+			breakpoint.gridPath = breakpointPath + ".grid"
+		}
+
+		modsMap, _ := config.Map(breakpointPath + ".mods")
+		for moduleName := range modsMap {
+			moduleConfig, err := config.Get(breakpointPath + ".mods." + moduleName)
+			if err != nil {
+				continue
+			}
+
+			if moduleConfig.UBool("hidden", false) {
+				breakpoint.hidden = append(breakpoint.hidden, moduleName)
+				continue
+			}
+
+			if _, err := moduleConfig.Get("position"); err == nil {
+				breakpoint.positions[moduleName] = cfg.NewPositionSettingsFromYAML(moduleName, moduleConfig)
+			}
+		}
+
+		breakpoints = append(breakpoints, &breakpoint)
+	}
+
+	sort.Slice(breakpoints, func(i, j int) bool {
+		return breakpoints[i].isLargerThan(breakpoints[j])
+	})
+
+	return breakpoints
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Fits returns TRUE if a terminal of the given size is large enough for the breakpoint
+func (breakpoint *Breakpoint) Fits(width, height int) bool {
+	return width >= breakpoint.MinWidth && height >= breakpoint.MinHeight
+}
+
+// Hides returns TRUE if the module with the given name is not displayed at this breakpoint
+func (breakpoint *Breakpoint) Hides(moduleName string) bool {
+	return utils.Includes(breakpoint.hidden, moduleName)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// breakpointFor returns the largest of the breakpoints that fits a terminal of the given
+// size, or nil if none do. The breakpoints must be sorted from largest to smallest
+func breakpointFor(breakpoints []*Breakpoint, width, height int) *Breakpoint {
+	for _, breakpoint := range breakpoints {
+		if breakpoint.Fits(width, height) {
+			return breakpoint
+		}
+	}
+
+	return nil
+}
+
+func (breakpoint *Breakpoint) isLargerThan(other *Breakpoint) bool {
+	if breakpoint.MinWidth != other.MinWidth {
+		return breakpoint.MinWidth > other.MinWidth
+	}
+
+	if breakpoint.MinHeight != other.MinHeight {
+		return breakpoint.MinHeight > other.MinHeight
+	}
+
+	return breakpoint.Name < other.Name
+}
@@ -0,0 +1,137 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	breakpointsConfig = `
+wtf:
+  grid:
+    columns: [10, 10]
+    rows: [5, 5]
+  breakpoints:
+    medium:
+      minWidth: 80
+      mods:
+        digitalclock:
+          hidden: true
+    large:
+      minWidth: 160
+      minHeight: 40
+      grid:
+        columns: [10, 10, 10]
+        rows: [5]
+      mods:
+        clocks:
+          position:
+            top: 0
+            left: 2
+            height: 1
+            width: 1
+    tall:
+      minWidth: 80
+      minHeight: 60
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 1
+        left: 1
+        height: 1
+        width: 1
+    digitalclock:
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1`
+)
+
+func Test_MakeBreakpoints(t *testing.T) {
+	cfg, _ := config.ParseYaml(breakpointsConfig)
+
+	breakpoints := MakeBreakpoints(cfg, "wtf", "wtf.grid")
+
+	names := []string{}
+	for _, breakpoint := range breakpoints {
+		names = append(names, breakpoint.Name)
+	}
+
+	assert.Equal(t, []string{"large", "tall", "medium"}, names)
+	assert.Equal(t, "wtf.breakpoints.large.grid", breakpoints[0].gridPath)
+	assert.Equal(t, "wtf.grid", breakpoints[2].gridPath)
+	assert.True(t, breakpoints[2].Hides("digitalclock"))
+	assert.False(t, breakpoints[2].Hides("clocks"))
+}
+
+func Test_breakpointFor(t *testing.T) {
+	cfg, _ := config.ParseYaml(breakpointsConfig)
+
+	breakpoints := MakeBreakpoints(cfg, "wtf", "wtf.grid")
+
+	tests := []struct {
+		name     string
+		width    int
+		height   int
+		expected string
+	}{
+		{name: "too small for any", width: 79, height: 100, expected: ""},
+		{name: "wide but short", width: 200, height: 30, expected: "medium"},
+		{name: "tall", width: 100, height: 60, expected: "tall"},
+		{name: "large", width: 160, height: 40, expected: "large"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := ""
+			if breakpoint := breakpointFor(breakpoints, tt.width, tt.height); breakpoint != nil {
+				name = breakpoint.Name
+			}
+
+			assert.Equal(t, tt.expected, name)
+		})
+	}
+}
+
+func Test_Display_Resize(t *testing.T) {
+	cfg, _ := config.ParseYaml(breakpointsConfig)
+
+	widgets := MakeWidgets(nil, nil, cfg)
+	layout := MakeLayouts(nil, widgets, cfg)[0]
+	display := layout.Display
+
+	// MakeWidgets builds the widgets in no particular order
+	var clocks, digitalclock wtf.Wtfable
+	for _, widget := range layout.Widgets {
+		switch widget.Name() {
+		case "clocks":
+			clocks = widget
+		case "digitalclock":
+			digitalclock = widget
+		}
+	}
+	assert.NotNil(t, clocks)
+	assert.NotNil(t, digitalclock)
+
+	assert.False(t, display.Resize(40, 20))
+	assert.Nil(t, display.Breakpoint)
+	assert.Equal(t, 1, display.positionFor(clocks).Left)
+
+	assert.True(t, display.Resize(100, 20))
+	assert.Equal(t, "medium", display.Breakpoint.Name)
+	assert.True(t, display.Hides(digitalclock))
+	assert.Equal(t, 1, display.positionFor(clocks).Left)
+
+	assert.True(t, display.Resize(200, 50))
+	assert.Equal(t, "large", display.Breakpoint.Name)
+	assert.False(t, display.Hides(digitalclock))
+	assert.Equal(t, 2, display.positionFor(clocks).Left)
+
+	assert.False(t, display.Resize(210, 50))
+}
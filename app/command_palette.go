@@ -0,0 +1,146 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	commandPalettePage = "commandPalette"
+)
+
+// keyboardCommander is implemented by widgets that accept keyboard commands
+type keyboardCommander interface {
+	KeyboardCommands() []view.PaletteCommand
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// showCommandPalette opens a modal dialog that fuzzy-searches every global action, every
+// layout, and every widget's keyboard commands, and runs the one chosen
+func (wtfApp *WtfApp) showCommandPalette() {
+	if wtfApp.pages.HasPage(commandPalettePage) {
+		return
+	}
+
+	closeFunc := func() {
+		wtfApp.pages.RemovePage(commandPalettePage)
+		wtfApp.CurrentLayout().FocusTracker.Refocus()
+	}
+
+	modal := view.NewCommandPalette(wtfApp.paletteCommands(), closeFunc)
+
+	wtfApp.pages.AddPage(commandPalettePage, modal, false, true)
+	wtfApp.app.SetFocus(modal)
+}
+
+// paletteCommands returns the commands available in the command palette. Global commands
+// come first, followed by each widget's commands grouped by widget
+func (wtfApp *WtfApp) paletteCommands() []view.PaletteCommand {
+	commands := []view.PaletteCommand{
+		{Name: "Refresh all widgets", Action: wtfApp.refreshAllWidgets},
+		{Name: "Pause/resume refreshing", Action: func() { wtfApp.scheduler.TogglePause() }},
+		{Name: "Show refresh errors", Action: wtfApp.toggleErrorOverlay},
+		{Name: "Zoom focused widget", Action: wtfApp.toggleZoom},
+	}
+
+	if len(wtfApp.layouts) > 1 {
+		commands = append(commands, view.PaletteCommand{Name: "Next layout", Action: wtfApp.NextLayout})
+
+		for idx, layout := range wtfApp.layouts {
+			idx := idx
+			commands = append(
+				commands,
+				view.PaletteCommand{
+					Name:   fmt.Sprintf("Layout: %s", layout.Name),
+					Action: func() { wtfApp.changeLayout(idx) },
+				},
+			)
+		}
+	}
+
+	commands = append(commands, view.PaletteCommand{Name: "Next theme", Action: wtfApp.nextTheme})
+
+	for _, name := range cfg.ThemeNames() {
+		name := name
+		commands = append(
+			commands,
+			view.PaletteCommand{
+				Name:   fmt.Sprintf("Theme: %s", name),
+				Action: func() { wtfApp.switchToTheme(name) },
+			},
+		)
+	}
+
+	widgets := make([]wtf.Wtfable, len(wtfApp.widgets))
+	copy(widgets, wtfApp.widgets)
+
+	sort.SliceStable(widgets, func(i, j int) bool {
+		return paletteLabel(widgets[i]) < paletteLabel(widgets[j])
+	})
+
+	for _, widget := range widgets {
+		if widget.Enabled() {
+			commands = append(commands, wtfApp.widgetPaletteCommands(widget)...)
+		}
+	}
+
+	return commands
+}
+
+// widgetPaletteCommands returns the commands that act on a single widget. Keyboard
+// commands focus the widget before they run, so they act on its selected item
+func (wtfApp *WtfApp) widgetPaletteCommands(widget wtf.Wtfable) []view.PaletteCommand {
+	label := paletteLabel(widget)
+
+	commands := []view.PaletteCommand{
+		{
+			Name:   fmt.Sprintf("%s: refresh", label),
+			Action: func() { go wtfApp.scheduler.Refresh(widget) },
+		},
+	}
+
+	if widget.Focusable() {
+		commands = append(
+			commands,
+			view.PaletteCommand{
+				Name:   fmt.Sprintf("%s: focus", label),
+				Action: func() { wtfApp.focusWidget(widget) },
+			},
+		)
+	}
+
+	commander, ok := widget.(keyboardCommander)
+	if !ok {
+		return commands
+	}
+
+	for _, command := range commander.KeyboardCommands() {
+		action := command.Action
+		commands = append(
+			commands,
+			view.PaletteCommand{
+				Name: fmt.Sprintf("%s: %s", label, command.Name),
+				Action: func() {
+					wtfApp.focusWidget(widget)
+					action()
+				},
+			},
+		)
+	}
+
+	return commands
+}
+
+// paletteLabel returns the name the widget is listed under in the command palette
+func paletteLabel(widget wtf.Wtfable) string {
+	if title := widget.CommonSettings().Title; title != "" {
+		return title
+	}
+
+	return widget.Name()
+}
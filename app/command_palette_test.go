@@ -0,0 +1,46 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_paletteCommands(t *testing.T) {
+	// Keep any user themes out of the list
+	configHome, _ := ioutil.TempDir("", "wtf-config")
+	defer os.RemoveAll(configHome)
+
+	os.Setenv("XDG_CONFIG_HOME", configHome)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	cfg, _ := config.ParseYaml(apiConfig)
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+
+	names := []string{}
+	for _, command := range wtfApp.paletteCommands() {
+		names = append(names, command.Name)
+	}
+
+	assert.Equal(
+		t,
+		[]string{
+			"Refresh all widgets",
+			"Pause/resume refreshing",
+			"Show refresh errors",
+			"Zoom focused widget",
+			"Next theme",
+			"Theme: dark",
+			"Theme: high-contrast",
+			"Theme: light",
+			"Theme: solarized",
+			"Clocks: refresh",
+			"Clocks: focus",
+		},
+		names,
+	)
+}
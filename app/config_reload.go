@@ -0,0 +1,265 @@
+package app
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	configErrorPage = "configError"
+)
+
+// layoutConfigKeys are the global config keys that only affect how widgets are arranged
+// onscreen. Changing them rebuilds the layouts but does not require recreating any widgets
+var layoutConfigKeys = []string{"breakpoints", "grid", "layout", "layouts", "mods", "pauseWhenHidden"}
+
+// appConfigKeys are the global config keys that only affect the app itself. Changing them
+// does not require recreating any widgets
+var appConfigKeys = []string{"api", "configVersion", "keys", "log", "metrics", "notifications", "scheduler"}
+
+// themeConfigKeys are the global config keys that only affect the colors widgets are drawn
+// in. Changing them recolors the existing widgets instead of recreating them
+var themeConfigKeys = []string{"theme"}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// reloadConfig re-reads the config file, and the files it includes, and applies the changes
+// to the running app. Widgets whose configuration has not changed are kept as-is, with all
+// their in-memory state. Only the widgets that were added, removed, or changed are torn down
+// and recreated
+func (wtfApp *WtfApp) reloadConfig() {
+	newConfig, sources, err := cfg.ParseWtfConfigSources(wtfApp.configFilePath)
+	if err != nil {
+		wtfApp.displayConfigError(
+			fmt.Sprintf("Could not load %s\n\n%s", wtfApp.configFilePath, err.Error()),
+		)
+		return
+	}
+
+	wtfApp.watchConfigSources(sources)
+
+	// A theme chosen at runtime lasts until the config file selects a different one
+	wtfApp.themeMutex.Lock()
+	if configTheme := newConfig.UString("wtf.theme", cfg.DefaultThemeName); configTheme != wtfApp.configTheme {
+		wtfApp.configTheme = configTheme
+		wtfApp.themeOverride = ""
+	}
+	wtfApp.themeMutex.Unlock()
+
+	wtfApp.applyConfig(newConfig)
+}
+
+// applyConfig applies the config to the running app. Widgets whose configuration has not
+// changed are kept as-is, and the rest are torn down and recreated. Configs are applied one
+// at a time, each after the last has been fully applied, so that every widget built from the
+// previous config is either kept or stopped
+func (wtfApp *WtfApp) applyConfig(newConfig *config.Config) {
+	wtfApp.configMutex.Lock()
+	defer wtfApp.configMutex.Unlock()
+
+	wtfApp.themeMutex.Lock()
+	if wtfApp.themeOverride != "" {
+		_ = newConfig.Set("wtf.theme", wtfApp.themeOverride)
+	}
+	wtfApp.themeMutex.Unlock()
+
+	// The --profile flag isn't in the config file, so it is applied to every new config
+	if wtfApp.profile {
+		_ = newConfig.Set("wtf.metrics.enabled", true)
+	}
+
+	rebuildAll := globalConfigChanged(wtfApp.config, newConfig)
+	recolor := globalKeyChanged(wtfApp.config, newConfig, "theme")
+
+	existing := map[string]wtf.Wtfable{}
+	for _, widget := range wtfApp.widgets {
+		existing[widget.Name()] = widget
+	}
+
+	widgets := []wtf.Wtfable{}
+	created := []wtf.Wtfable{}
+	kept := []wtf.Wtfable{}
+
+	moduleNames, _ := newConfig.Map("wtf.mods")
+	for moduleName := range moduleNames {
+		widget, found := existing[moduleName]
+		if found && !rebuildAll && !moduleConfigChanged(wtfApp.config, newConfig, moduleName) {
+			widgets = append(widgets, widget)
+			kept = append(kept, widget)
+			delete(existing, moduleName)
+			continue
+		}
+
+		widget = MakeWidget(wtfApp.app, wtfApp.pages, moduleName, newConfig)
+		if widget != nil {
+			widgets = append(widgets, widget)
+			created = append(created, widget)
+		}
+	}
+
+	keymap, passThrough, keyValidations := wtfApp.buildKeymap(newConfig)
+
+	validationErrors := append(validate(created), validateKeys("wtf", keyValidations)...)
+	if len(validationErrors) > 0 {
+		for _, widget := range created {
+			widget.Stop()
+		}
+
+		messages := []string{}
+		for _, validationError := range validationErrors {
+			messages = append(messages, validationError.errorMessages()...)
+		}
+		wtfApp.displayConfigError(tview.TranslateANSI(strings.Join(messages, "\n")))
+
+		return
+	}
+
+	// Anything left over has either been removed from the config or has been replaced
+	for _, widget := range existing {
+		widget.Stop()
+	}
+
+	openURLUtil := utils.ToStrs(newConfig.UList("wtf.openUrlUtil", []interface{}{}))
+	utils.Init(newConfig.UString("wtf.openFileUtil", "open"), openURLUtil)
+
+	logger.SetDefault(logger.NewLogger(newConfig))
+
+	if globalKeyChanged(wtfApp.config, newConfig, "scheduler") {
+		wtfApp.scheduler.Configure(newConfig)
+	}
+
+	if globalKeyChanged(wtfApp.config, newConfig, "api") {
+		wtfApp.startAPIServer(newConfig)
+	}
+
+	if globalKeyChanged(wtfApp.config, newConfig, "metrics") {
+		wtfApp.startMetricsServer(newConfig)
+	}
+
+	// The next config can't be applied until the app has switched to this one
+	applied := make(chan bool)
+
+	wtfApp.app.QueueUpdateDraw(func() {
+		defer close(applied)
+
+		wtfApp.config = newConfig
+		wtfApp.keymap = keymap
+		wtfApp.passThrough = passThrough
+		wtfApp.widgets = widgets
+
+		if recolor {
+			wtfApp.recolorWidgets(kept, newConfig)
+		}
+
+		wtfApp.setUpNotifications()
+		wtfApp.setBackgroundColor()
+		wtfApp.pages.RemovePage(configErrorPage)
+		wtfApp.buildLayouts(wtfApp.CurrentLayout().Name)
+	})
+
+	<-applied
+
+	wtfApp.schedule(created)
+
+	// Widgets draw their content in their colors too, so the recolored widgets are redrawn,
+	// or refreshed if they can't redraw without fetching their data
+	if recolor {
+		for _, widget := range kept {
+			if rendered, ok := widget.(renderer); ok {
+				go rendered.Render()
+				continue
+			}
+
+			go wtfApp.scheduler.Refresh(widget)
+		}
+	}
+}
+
+// displayConfigError shows a modal dialog describing a problem with the config file. The
+// app keeps running with the last good config
+func (wtfApp *WtfApp) displayConfigError(message string) {
+	closeFunc := func() {
+		wtfApp.pages.RemovePage(configErrorPage)
+		wtfApp.CurrentLayout().FocusTracker.Refocus()
+	}
+
+	wtfApp.app.QueueUpdateDraw(func() {
+		colors := wtfApp.themeColors()
+
+		text := fmt.Sprintf(
+			" [%s::b]Configuration error[%s::-]\n\n%s\n\n Press Esc to close",
+			colors.Error,
+			colors.Text,
+			message,
+		)
+		modal := view.NewBillboardModal(text, closeFunc)
+
+		wtfApp.pages.RemovePage(configErrorPage)
+		wtfApp.pages.AddPage(configErrorPage, modal, false, true)
+		wtfApp.app.SetFocus(modal)
+	})
+}
+
+// globalConfigChanged returns TRUE if any of the global settings that widgets are built
+// from (colors, sigils, etc.) differ between the two configs
+func globalConfigChanged(oldConfig, newConfig *config.Config) bool {
+	oldGlobals, _ := oldConfig.Map("wtf")
+	newGlobals, _ := newConfig.Map("wtf")
+
+	keys := map[string]bool{}
+	for key := range oldGlobals {
+		keys[key] = true
+	}
+	for key := range newGlobals {
+		keys[key] = true
+	}
+
+	for key := range keys {
+		if utils.Includes(layoutConfigKeys, key) || utils.Includes(appConfigKeys, key) || utils.Includes(themeConfigKeys, key) {
+			continue
+		}
+
+		if !reflect.DeepEqual(oldGlobals[key], newGlobals[key]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// globalKeyChanged returns TRUE if the value of the global setting under wtf differs between
+// the two configs
+func globalKeyChanged(oldConfig, newConfig *config.Config, key string) bool {
+	oldValue, _ := oldConfig.Get("wtf." + key)
+	newValue, _ := newConfig.Get("wtf." + key)
+
+	if oldValue == nil || newValue == nil {
+		return oldValue != newValue
+	}
+
+	return !reflect.DeepEqual(oldValue.Root, newValue.Root)
+}
+
+// moduleConfigChanged returns TRUE if the configuration for the named module differs
+// between the two configs, including its position in the grid
+func moduleConfigChanged(oldConfig, newConfig *config.Config, moduleName string) bool {
+	path := "wtf.mods." + moduleName
+
+	oldModule, oldErr := oldConfig.Get(path)
+	newModule, newErr := newConfig.Get(path)
+
+	if oldErr != nil || newErr != nil {
+		return true
+	}
+
+	return !reflect.DeepEqual(oldModule.Root, newModule.Root)
+}
@@ -0,0 +1,351 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/radovskyb/watcher"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	reloadBase = `
+wtf:
+  colors:
+    border:
+      focusable: darkslateblue
+  grid:
+    columns: [10, 10]
+    rows: [5, 5]
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+    digitalclock:
+      enabled: true
+      position:
+        top: 0
+        left: 1
+        height: 1
+        width: 1`
+
+	reloadMoved = `
+wtf:
+  colors:
+    border:
+      focusable: darkslateblue
+  grid:
+    columns: [20, 20]
+    rows: [5, 5]
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+    digitalclock:
+      enabled: true
+      position:
+        top: 1
+        left: 1
+        height: 1
+        width: 1`
+
+	reloadRecolored = `
+wtf:
+  colors:
+    border:
+      focusable: red
+  grid:
+    columns: [10, 10]
+    rows: [5, 5]
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1`
+)
+
+func parseConfig(yaml string) *config.Config {
+	cfg, _ := config.ParseYaml(yaml)
+	return cfg
+}
+
+func Test_globalConfigChanged(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldConfig string
+		newConfig string
+		expected  bool
+	}{
+		{
+			name:      "identical configs",
+			oldConfig: reloadBase,
+			newConfig: reloadBase,
+			expected:  false,
+		},
+		{
+			name:      "only grid and module changes",
+			oldConfig: reloadBase,
+			newConfig: reloadMoved,
+			expected:  false,
+		},
+		{
+			name:      "color changes",
+			oldConfig: reloadBase,
+			newConfig: reloadRecolored,
+			expected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := globalConfigChanged(parseConfig(tt.oldConfig), parseConfig(tt.newConfig))
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_moduleConfigChanged(t *testing.T) {
+	tests := []struct {
+		name       string
+		moduleName string
+		newConfig  string
+		expected   bool
+	}{
+		{
+			name:       "unchanged module",
+			moduleName: "clocks",
+			newConfig:  reloadMoved,
+			expected:   false,
+		},
+		{
+			name:       "moved module",
+			moduleName: "digitalclock",
+			newConfig:  reloadMoved,
+			expected:   true,
+		},
+		{
+			name:       "removed module",
+			moduleName: "digitalclock",
+			newConfig:  reloadRecolored,
+			expected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := moduleConfigChanged(parseConfig(reloadBase), parseConfig(tt.newConfig), tt.moduleName)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_globalKeyChanged(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldConfig string
+		newConfig string
+		expected  bool
+	}{
+		{
+			name:      "unchanged",
+			oldConfig: "wtf:\n  metrics:\n    enabled: true",
+			newConfig: "wtf:\n  metrics:\n    enabled: true",
+			expected:  false,
+		},
+		{
+			name:      "changed",
+			oldConfig: "wtf:\n  metrics:\n    enabled: true",
+			newConfig: "wtf:\n  metrics:\n    enabled: false",
+			expected:  true,
+		},
+		{
+			name:      "added",
+			oldConfig: "wtf:\n  grid: {}",
+			newConfig: "wtf:\n  metrics:\n    enabled: true",
+			expected:  true,
+		},
+		{
+			name:      "missing from both",
+			oldConfig: "wtf:\n  grid: {}",
+			newConfig: "wtf:\n  grid: {}",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := globalKeyChanged(parseConfig(tt.oldConfig), parseConfig(tt.newConfig), "metrics")
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_WtfApp_applyConfig(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+
+	wtfApp := NewWtfApp(tviewApp, parseConfig(reloadBase), "")
+	go func() { _ = tviewApp.Run() }()
+	defer tviewApp.Stop()
+
+	original := wtfApp.widgets
+
+	// Configs applied at the same time are applied one after the other, so every widget
+	// that isn't in the end result is stopped
+	wg := sync.WaitGroup{}
+	for _, yaml := range []string{reloadRecolored, reloadMoved} {
+		wg.Add(1)
+		go func(yaml string) {
+			defer wg.Done()
+			wtfApp.applyConfig(parseConfig(yaml))
+		}(yaml)
+	}
+	wg.Wait()
+
+	current := wtfApp.currentWidgets()
+	for _, widget := range current {
+		assert.True(t, widget.Enabled())
+	}
+	for _, widget := range original {
+		kept := false
+		for _, currentWidget := range current {
+			kept = kept || currentWidget == widget
+		}
+
+		assert.True(t, kept || widget.Disabled())
+	}
+
+	// Scheduler settings are applied to the running scheduler, without recreating widgets
+	wtfApp.applyConfig(parseConfig(reloadMoved))
+	current = wtfApp.currentWidgets()
+
+	schedulerConfig := parseConfig(reloadMoved)
+	_ = schedulerConfig.Set("wtf.scheduler.maxBackoff", 10)
+
+	wtfApp.applyConfig(schedulerConfig)
+
+	after := wtfApp.currentWidgets()
+	assert.Equal(t, len(current), len(after))
+	for _, widget := range after {
+		kept := false
+		for _, currentWidget := range current {
+			kept = kept || currentWidget == widget
+		}
+
+		assert.True(t, kept, widget.Name())
+	}
+	assert.Equal(t, 10*time.Second, wtfApp.scheduler.nextDelay(time.Second, 10))
+
+	// Remapped global keys are bound without recreating widgets
+	keysConfig, _ := schedulerConfig.Copy()
+	_ = keysConfig.Set("wtf.keys.refresh-all", "ctrl-g r")
+
+	wtfApp.applyConfig(keysConfig)
+
+	assert.ElementsMatch(t, after, wtfApp.currentWidgets())
+	assert.True(t, wtfApp.keymap.IsPrefix("ctrl-g"))
+
+	// Switching themes recolors the existing widgets instead of recreating them
+	themeConfig, _ := schedulerConfig.Copy()
+	_ = themeConfig.Set("wtf.theme", "light")
+
+	wtfApp.applyConfig(themeConfig)
+
+	recolored := wtfApp.currentWidgets()
+	assert.ElementsMatch(t, after, recolored)
+	for _, widget := range recolored {
+		assert.Equal(t, "black", widget.CommonSettings().Colors.Text, widget.Name())
+	}
+}
+
+func Test_WtfApp_applyConfig_profiling(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+
+	profileConfig := parseConfig(reloadBase)
+	_ = profileConfig.Set("wtf.metrics.address", "127.0.0.1:0")
+
+	wtfApp := NewWtfApp(tviewApp, profileConfig, "")
+	go func() { _ = tviewApp.Run() }()
+	defer tviewApp.Stop()
+	defer wtfApp.Stop()
+
+	wtfApp.EnableProfiling()
+	wtfApp.startMetricsServer(wtfApp.config)
+	assert.NotNil(t, wtfApp.metricsServer)
+
+	// Reloading the config file, which doesn't enable the metrics, keeps them served
+	reloaded := parseConfig(reloadMoved)
+	_ = reloaded.Set("wtf.metrics.address", "127.0.0.1:0")
+
+	wtfApp.applyConfig(reloaded)
+	assert.True(t, wtfApp.config.UBool("wtf.metrics.enabled"))
+	assert.NotNil(t, wtfApp.metricsServer)
+
+	// As does changing the metrics settings
+	moved := parseConfig(reloadMoved)
+	_ = moved.Set("wtf.metrics.address", "localhost:0")
+
+	wtfApp.applyConfig(moved)
+	assert.NotNil(t, wtfApp.metricsServer)
+}
+
+func Test_watchConfigSources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wtf-config")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	configPath := filepath.Join(dir, "config.yml")
+	includePath := filepath.Join(dir, "personal.yml")
+	for _, path := range []string{configPath, includePath} {
+		assert.NoError(t, ioutil.WriteFile(path, []byte("wtf:\n"), 0600))
+	}
+
+	appConfig, _ := config.ParseYaml(apiConfig)
+	wtfApp := NewWtfApp(tview.NewApplication(), appConfig, configPath)
+	wtfApp.configWatch = watcher.New()
+	wtfApp.configWatched = map[string]bool{}
+
+	wtfApp.watchConfigSources(cfg.ConfigSources{
+		Files:       []string{configPath, includePath},
+		IncludeDirs: []string{dir},
+	})
+
+	assert.Equal(t, map[string]bool{configPath: true, includePath: true, dir: true}, wtfApp.configWatched)
+	assert.True(t, wtfApp.includeDirs[dir])
+
+	// Paths that are no longer part of the config are no longer watched
+	wtfApp.watchConfigSources(cfg.ConfigSources{Files: []string{configPath}})
+
+	assert.Equal(t, map[string]bool{configPath: true}, wtfApp.configWatched)
+	assert.Empty(t, wtfApp.includeDirs)
+
+	watched := wtfApp.configWatch.WatchedFiles()
+	assert.Contains(t, watched, configPath)
+	assert.NotContains(t, watched, dir)
+}
@@ -0,0 +1,440 @@
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const jsonSchemaVersion = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchema is the subset of JSON Schema used to describe the config
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 interface{}            `json:"type,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	Const                interface{}            `json:"const,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Minimum              *int                   `json:"minimum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	If                   *jsonSchema            `json:"if,omitempty"`
+	Then                 *jsonSchema            `json:"then,omitempty"`
+	Else                 *jsonSchema            `json:"else,omitempty"`
+	Definitions          map[string]*jsonSchema `json:"definitions,omitempty"`
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// ConfigSchema returns the JSON Schema that describes the config file, generated from the
+// settings of every module type
+func ConfigSchema() ([]byte, error) {
+	return json.MarshalIndent(configSchema(), "", "  ")
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func configSchema() *jsonSchema {
+	definitions := map[string]*jsonSchema{}
+	typeNames := []interface{}{}
+	moduleRules := []*jsonSchema{}
+	namedModules := map[string]*jsonSchema{}
+
+	for _, moduleType := range moduleTypes() {
+		definitions[moduleType] = moduleSchema(moduleType)
+		typeNames = append(typeNames, moduleType)
+
+		ref := &jsonSchema{Ref: "#/definitions/" + moduleType}
+
+		moduleRules = append(
+			moduleRules,
+			&jsonSchema{
+				If: &jsonSchema{
+					Required:   []string{"type"},
+					Properties: map[string]*jsonSchema{"type": {Const: moduleType}},
+				},
+				Then: ref,
+			},
+		)
+
+		// A module named after a module type is that type unless it says otherwise
+		namedModules[moduleType] = &jsonSchema{
+			If:   &jsonSchema{Required: []string{"type"}},
+			Then: &jsonSchema{Ref: "#/definitions/module"},
+			Else: ref,
+		}
+	}
+
+	definitions["module"] = &jsonSchema{
+		Type:       "object",
+		Required:   []string{"type"},
+		Properties: map[string]*jsonSchema{"type": {Enum: typeNames}},
+		AllOf:      moduleRules,
+	}
+
+	return &jsonSchema{
+		Schema:      jsonSchemaVersion,
+		Title:       "WTF config",
+		Type:        "object",
+		Properties:  map[string]*jsonSchema{"wtf": globalSchema(namedModules)},
+		Definitions: definitions,
+	}
+}
+
+// globalSchema describes the settings under the top-level wtf key
+func globalSchema(namedModules map[string]*jsonSchema) *jsonSchema {
+	return &jsonSchema{
+		Type:                 "object",
+		AdditionalProperties: false,
+		Properties: map[string]*jsonSchema{
+			"api": objectSchema("The HTTP API that exposes widget state and actions.", map[string]*jsonSchema{
+				"address": typedSchema("string", "The address the API listens on."),
+				"enabled": typedSchema("boolean", "Whether or not the API is started."),
+				"socket":  typedSchema("string", "A Unix socket to listen on instead of an address."),
+			}),
+			"breakpoints": breakpointsSchema(),
+			"cache": objectSchema("Saving widget data to disk between runs.", map[string]*jsonSchema{
+				"enabled": typedSchema("boolean", "Whether or not modules cache their data by default."),
+				"ttl":     typedSchema("integer", "How old, in seconds, cached data may be and still be displayed."),
+			}),
+			"colors":        typedSchema("object", "The default colors for every module."),
+			"configVersion": typedSchema("integer", "The config file format version, recorded by the migrate-config command."),
+			"exitMessage": objectSchema("The message displayed when WTF exits.", map[string]*jsonSchema{
+				"display":      typedSchema("boolean", "Whether or not the exit message is displayed."),
+				"githubAPIKey": typedSchema("string", "The GitHub API key used to check for sponsorship."),
+			}),
+			"grid":    gridSchema(),
+			"include": &jsonSchema{Type: []string{"string", "array"}, Description: "Config files, or globs of them, to merge into this one."},
+			"keys":    keysSchema("Remaps the global key bindings, by action name."),
+			"layout":  typedSchema("string", "The name of the layout to display on startup."),
+			"layouts": &jsonSchema{
+				Type:        "object",
+				Description: "Named layouts, each displaying a subset of the modules.",
+				AdditionalProperties: objectSchema("", map[string]*jsonSchema{
+					"breakpoints":     breakpointsSchema(),
+					"grid":            gridSchema(),
+					"mods":            &jsonSchema{Type: []string{"array", "object"}, Description: "The modules displayed in the layout."},
+					"pauseWhenHidden": typedSchema("boolean", "Whether or not the layout's modules stop refreshing while it is hidden."),
+				}),
+			},
+			"log": objectSchema("The log file that modules write to.", map[string]*jsonSchema{
+				"level":      &jsonSchema{Type: "string", Description: "The lowest level of entry written to the log file.", Enum: []interface{}{"debug", "info", "warn", "error"}},
+				"maxBackups": typedSchema("integer", "How many rotated log files are kept."),
+				"maxSize":    typedSchema("integer", "The size, in kilobytes, the log file is rotated at. 0 never rotates it."),
+				"path":       typedSchema("string", "The path of the log file."),
+			}),
+			"metrics": objectSchema("The Prometheus metrics about module refreshes and memory usage.", map[string]*jsonSchema{
+				"address": typedSchema("string", "The loopback address the metrics are served on, from /metrics."),
+				"enabled": typedSchema("boolean", "Whether or not the metrics are served."),
+			}),
+			"mods": &jsonSchema{
+				Type:                 "object",
+				Description:          "The modules, by name.",
+				Properties:           namedModules,
+				AdditionalProperties: &jsonSchema{Ref: "#/definitions/module"},
+			},
+			"mouse": typedSchema("boolean", "Whether or not the mouse can be used to focus, scroll and select."),
+			"navigation": objectSchema("Keyboard navigation between modules.", map[string]*jsonSchema{
+				"shortcuts": typedSchema("boolean", "Whether or not the number keys focus modules."),
+			}),
+			"notifications": objectSchema("Notifications about changes that modules notice.", map[string]*jsonSchema{
+				"command":    &jsonSchema{Type: "array", Description: "The command, and its arguments, run by the command sink.", Items: typedSchema("string", "")},
+				"enabled":    typedSchema("boolean", "Whether or not notifications are sent."),
+				"quietHours": quietHoursSchema(),
+				"sinks":      sinksSchema("The sinks notifications are sent to, for modules without their own."),
+				"webhook":    typedSchema("string", "The URL the webhook sink posts notifications to."),
+			}),
+			"openFileUtil":    typedSchema("string", "The command used to open files."),
+			"openUrlUtil":     &jsonSchema{Type: "array", Description: "The command, and its arguments, used to open URLs.", Items: typedSchema("string", "")},
+			"paging":          typedSchema("object", "Deprecated. Use sigils.paging instead, or run the migrate-config command."),
+			"pauseWhenHidden": typedSchema("boolean", "Whether or not the default layout's modules stop refreshing while it is hidden."),
+			"refreshInterval": typedSchema("integer", "How often, in seconds, modules refresh by default."),
+			"scheduler": objectSchema("How module refreshes are scheduled.", map[string]*jsonSchema{
+				"jitter":               typedSchema("number", "How much, as a fraction of the interval, refreshes are randomly spread out."),
+				"maxBackoff":           typedSchema("integer", "The longest time, in seconds, a failing module waits before retrying."),
+				"maxConcurrentPerHost": typedSchema("integer", "How many modules may refresh from the same host at once. 0 is unlimited."),
+				"startupJitter":        typedSchema("integer", "The longest time, in seconds, a module's first refresh is delayed."),
+			}),
+			"secretStore": typedSchema("string", "Where module secrets are stored: file, env, or the name of a credential helper."),
+			"secrets": objectSchema("The settings of the file and env secret stores.", map[string]*jsonSchema{
+				"envPrefix": typedSchema("string", "The prefix of the environment variables the env store reads secrets from."),
+				"file":      typedSchema("string", "The path of the file store's encrypted file."),
+				"keyFile":   typedSchema("string", "A file holding the passphrase the file store's file is encrypted with."),
+			}),
+			"sigils":     typedSchema("object", "The characters used to draw checkboxes and paging."),
+			"staleAfter": typedSchema("integer", "How many refresh intervals may pass before a module's data is flagged as stale."),
+			"term":       typedSchema("string", "The value to set TERM to."),
+			"theme":      typedSchema("string", "The color theme: dark, light, solarized, high-contrast, or the name of a theme file in the themes directory of the config directory."),
+		},
+	}
+}
+
+// moduleSchema describes the settings of the module type: the settings common to every module
+// and those specific to the type
+func moduleSchema(moduleType string) *jsonSchema {
+	properties := commonModuleProperties()
+
+	for key, property := range settingsProperties(reflect.TypeOf(moduleSettings[moduleType])) {
+		if _, ok := properties[key]; !ok {
+			properties[key] = property
+		}
+	}
+
+	for _, key := range moduleConfigAliases[moduleType] {
+		if _, ok := properties[key]; !ok {
+			properties[key] = &jsonSchema{}
+		}
+	}
+
+	return &jsonSchema{
+		Type:                 "object",
+		AdditionalProperties: false,
+		Properties:           properties,
+	}
+}
+
+// commonModuleProperties describes the settings every module has, documented by the struct
+// tags of cfg.Common
+func commonModuleProperties() map[string]*jsonSchema {
+	common := reflect.TypeOf(cfg.Common{})
+
+	documented := func(fieldName string, schema *jsonSchema) *jsonSchema {
+		if field, ok := common.FieldByName(fieldName); ok {
+			applyFieldTags(schema, field)
+		}
+		return schema
+	}
+
+	minimum := func(min int) *jsonSchema {
+		return &jsonSchema{Type: "integer", Minimum: &min}
+	}
+
+	return map[string]*jsonSchema{
+		"border":          documented("Bordered", &jsonSchema{Type: "boolean"}),
+		"cache":           documented("Cached", &jsonSchema{Type: "boolean"}),
+		"cacheTTL":        documented("CacheTTL", &jsonSchema{Type: "integer"}),
+		"colors":          typedSchema("object", "Overrides the global colors for this module."),
+		"enabled":         documented("Enabled", &jsonSchema{Type: "boolean"}),
+		"focusable":       documented("Focusable", &jsonSchema{Type: "boolean"}),
+		"focusChar":       documented("focusChar", &jsonSchema{Type: "integer"}),
+		"graphIcon":       typedSchema("string", "The character bar graphs are drawn with."),
+		"graphStars":      typedSchema("integer", "The width, in characters, of a full bar graph."),
+		"keys":            keysSchema("Remaps this module's key bindings, by action name."),
+		"refreshInterval": documented("RefreshInterval", &jsonSchema{Type: "integer"}),
+		"staleAfter":      documented("StaleAfter", &jsonSchema{Type: "integer"}),
+		"title":           documented("Title", &jsonSchema{Type: "string"}),
+		"type":            typedSchema("string", "The type of module. Defaults to the module's name."),
+		"notify": objectSchema("Which of this module's notifications are sent, and how.", map[string]*jsonSchema{
+			"enabled":          typedSchema("boolean", "Whether or not this module's notifications are sent."),
+			"events":           &jsonSchema{Type: "array", Description: "The kinds of event notifications are sent for. Defaults to all.", Items: typedSchema("string", "")},
+			"ignoreQuietHours": typedSchema("boolean", "Whether or not notifications are sent during quiet hours."),
+			"quietHours":       quietHoursSchema(),
+			"sinks":            sinksSchema("The sinks this module's notifications are sent to."),
+		}),
+		"rules": documented("Rules", &jsonSchema{
+			Type: "array",
+			Items: &jsonSchema{
+				Type:                 "object",
+				AdditionalProperties: false,
+				Required:             []string{"when"},
+				Properties: map[string]*jsonSchema{
+					"badge":   typedSchema("string", "Shown after the module's title while the rule matches."),
+					"border":  typedSchema("string", "The module's border color while the rule matches."),
+					"message": typedSchema("string", "The notification's message. Values can be included by name, in braces."),
+					"name":    typedSchema("string", "The rule's name. Defaults to its condition."),
+					"notify":  typedSchema("boolean", "Whether or not a notification is raised when the rule starts matching."),
+					"when":    typedSchema("string", "The condition, over the module's values, under which the rule matches."),
+				},
+			},
+		}),
+		"position": documented("PositionSettings", &jsonSchema{
+			Type:                 "object",
+			AdditionalProperties: false,
+			Required:             []string{"top", "left", "height", "width"},
+			Properties: map[string]*jsonSchema{
+				"top":    minimum(0),
+				"left":   minimum(0),
+				"height": minimum(1),
+				"width":  minimum(1),
+			},
+		}),
+	}
+}
+
+// settingsProperties describes the config keys of a module's settings struct. Each field is
+// read from the config key of the same name
+func settingsProperties(settingsType reflect.Type) map[string]*jsonSchema {
+	properties := map[string]*jsonSchema{}
+
+	if settingsType == nil || settingsType.Kind() != reflect.Struct {
+		return properties
+	}
+
+	for i := 0; i < settingsType.NumField(); i++ {
+		field := settingsType.Field(i)
+
+		if field.Name == "common" {
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for key, property := range settingsProperties(field.Type) {
+				properties[key] = property
+			}
+			continue
+		}
+
+		schema := &jsonSchema{Type: jsonType(field.Type)}
+		applyFieldTags(schema, field)
+
+		properties[lowercaseFirst(field.Name)] = schema
+	}
+
+	return properties
+}
+
+// applyFieldTags documents the schema with the help:, values: and default: struct tags
+func applyFieldTags(schema *jsonSchema, field reflect.StructField) {
+	description := field.Tag.Get("help")
+	if values := field.Tag.Get("values"); values != "" {
+		description = strings.TrimSpace(description + " Values: " + values)
+	}
+	schema.Description = description
+
+	if value, ok := field.Tag.Lookup("default"); ok {
+		schema.Default = typedDefault(schema.Type, value)
+	}
+}
+
+// typedDefault converts a default: struct tag to the schema's type
+func typedDefault(schemaType interface{}, value string) interface{} {
+	switch schemaType {
+	case "boolean":
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	case "integer":
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	case "number":
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+
+	return value
+}
+
+// jsonType returns the JSON type of a settings field. Only scalars are typed, because lists
+// and maps in the config are often parsed into a different shape
+func jsonType(fieldType reflect.Type) interface{} {
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	default:
+		return nil
+	}
+}
+
+func breakpointsSchema() *jsonSchema {
+	return &jsonSchema{
+		Type:        "object",
+		Description: "Alternative arrangements of the modules, used when the terminal is large enough for them.",
+		AdditionalProperties: objectSchema("", map[string]*jsonSchema{
+			"grid":      gridSchema(),
+			"minHeight": typedSchema("integer", "The fewest rows the terminal can have for this arrangement to be used."),
+			"minWidth":  typedSchema("integer", "The fewest columns the terminal can have for this arrangement to be used."),
+			"mods": &jsonSchema{
+				Type:        "object",
+				Description: "Changes to the modules' positions at this size, by module name.",
+				AdditionalProperties: objectSchema("", map[string]*jsonSchema{
+					"hidden":   typedSchema("boolean", "Whether or not the module is hidden at this size."),
+					"position": commonModuleProperties()["position"],
+				}),
+			},
+		}),
+	}
+}
+
+func gridSchema() *jsonSchema {
+	return objectSchema("The sizes of the grid's columns and rows. Leave it out to size them to fit the terminal.", map[string]*jsonSchema{
+		"columns": &jsonSchema{Type: "array", Description: "The width of each column, in characters.", Items: typedSchema("integer", "")},
+		"rows":    &jsonSchema{Type: "array", Description: "The height of each row, in lines.", Items: typedSchema("integer", "")},
+	})
+}
+
+func keysSchema(description string) *jsonSchema {
+	return &jsonSchema{
+		Type:        "object",
+		Description: description,
+		AdditionalProperties: &jsonSchema{
+			Type:  []string{"string", "array"},
+			Items: typedSchema("string", ""),
+		},
+	}
+}
+
+func quietHoursSchema() *jsonSchema {
+	return objectSchema("A daily period, such as 22:00 to 07:00, during which no notifications are sent.", map[string]*jsonSchema{
+		"end":   typedSchema("string", "The time of day, as HH:MM, quiet hours end."),
+		"start": typedSchema("string", "The time of day, as HH:MM, quiet hours start."),
+	})
+}
+
+func sinksSchema(description string) *jsonSchema {
+	return &jsonSchema{
+		Type:        "array",
+		Description: description,
+		Items:       &jsonSchema{Type: "string", Enum: []interface{}{"bell", "command", "title", "webhook"}},
+	}
+}
+
+func objectSchema(description string, properties map[string]*jsonSchema) *jsonSchema {
+	return &jsonSchema{
+		Type:                 "object",
+		Description:          description,
+		AdditionalProperties: false,
+		Properties:           properties,
+	}
+}
+
+func typedSchema(schemaType string, description string) *jsonSchema {
+	return &jsonSchema{Type: schemaType, Description: description}
+}
+
+// moduleTypes returns the module types that MakeWidget can create, in alphabetical order
+func moduleTypes() []string {
+	types := []string{}
+	for moduleType := range moduleSettings {
+		types = append(types, moduleType)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+func lowercaseFirst(str string) string {
+	r, n := utf8.DecodeRuneInString(str)
+	return string(unicode.ToLower(r)) + str[n:]
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_moduleSettings ensures every module type MakeWidget can create is described by the schema
+func Test_moduleSettings(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "widget_maker.go", nil, 0)
+	assert.NoError(t, err)
+
+	moduleTypes := []string{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		clause, ok := node.(*ast.CaseClause)
+		if !ok {
+			return true
+		}
+
+		for _, expr := range clause.List {
+			if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				moduleType, _ := strconv.Unquote(lit.Value)
+				moduleTypes = append(moduleTypes, moduleType)
+			}
+		}
+
+		return true
+	})
+
+	assert.NotEmpty(t, moduleTypes)
+
+	for _, moduleType := range moduleTypes {
+		_, ok := moduleSettings[moduleType]
+		assert.True(t, ok, "no settings registered for module type %q", moduleType)
+	}
+
+	assert.Equal(t, len(moduleTypes), len(moduleSettings))
+}
+
+// Test_moduleSettingsConstructors ensures every module type described by the schema has its
+// settings built from the same type
+func Test_moduleSettingsConstructors(t *testing.T) {
+	moduleConfig, _ := config.ParseYaml("enabled: true")
+	globalConfig, _ := config.ParseYaml("wtf: {}")
+
+	assert.Equal(t, len(moduleSettings), len(moduleSettingsConstructors))
+
+	for moduleType, settings := range moduleSettings {
+		newSettings, ok := moduleSettingsConstructors[moduleType]
+		if !assert.True(t, ok, "no settings constructor registered for module type %q", moduleType) {
+			continue
+		}
+
+		built := newSettings(moduleType, moduleConfig, globalConfig)
+		assert.Equal(t, reflect.PtrTo(reflect.TypeOf(settings)), reflect.TypeOf(built), moduleType)
+	}
+}
+
+func Test_ConfigSchema(t *testing.T) {
+	raw, err := ConfigSchema()
+	assert.NoError(t, err)
+
+	schema := jsonSchema{}
+	assert.NoError(t, json.Unmarshal(raw, &schema))
+
+	assert.Equal(t, jsonSchemaVersion, schema.Schema)
+	assert.NotNil(t, schema.Properties["wtf"])
+
+	clocks := schema.Definitions["clocks"]
+	assert.NotNil(t, clocks)
+	assert.Equal(t, false, clocks.AdditionalProperties)
+	assert.Equal(t, "string", clocks.Properties["dateFormat"].Type)
+	assert.Equal(t, "boolean", clocks.Properties["enabled"].Type)
+	assert.Equal(t, true, clocks.Properties["border"].Default)
+	assert.Contains(t, clocks.Properties["border"].Description, "Values: true, false")
+	assert.NotNil(t, clocks.Properties["position"].Properties["width"])
+}
+
+func Test_settingsProperties(t *testing.T) {
+	type embedded struct {
+		Inner string `help:"An inner setting."`
+	}
+
+	type settings struct {
+		embedded
+
+		common   *struct{}
+		Count    int               `help:"How many." values:"A positive integer." default:"3"`
+		Enabled  bool              `default:"true"`
+		Ratio    float64           `help:"How much."`
+		Labels   map[string]string `help:"Some labels."`
+		UserName string
+	}
+
+	properties := settingsProperties(reflect.TypeOf(settings{}))
+
+	assert.Equal(t, 6, len(properties))
+	assert.Nil(t, properties["common"])
+	assert.Equal(t, "string", properties["inner"].Type)
+	assert.Equal(t, "integer", properties["count"].Type)
+	assert.Equal(t, "How many. Values: A positive integer.", properties["count"].Description)
+	assert.Equal(t, 3, properties["count"].Default)
+	assert.Equal(t, true, properties["enabled"].Default)
+	assert.Equal(t, "number", properties["ratio"].Type)
+	assert.Nil(t, properties["labels"].Type)
+	assert.NotNil(t, properties["userName"])
+}
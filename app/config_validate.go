@@ -0,0 +1,357 @@
+package app
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// schemaValidator checks config values against the config schema. It understands the subset
+// of JSON Schema that configSchema generates
+type schemaValidator struct {
+	definitions map[string]*jsonSchema
+	problems    []string
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// ValidateConfig checks the config against the config schema and the grid, and returns a
+// description of every problem found, sorted by config path. An empty result means the
+// config is valid
+func ValidateConfig(config *config.Config) []string {
+	schema := configSchema()
+
+	validator := &schemaValidator{definitions: schema.Definitions}
+	validator.validate("", config.Root, schema)
+	validator.validateGrids(config)
+	validator.validateTheme(config)
+
+	sort.Strings(validator.problems)
+
+	return validator.problems
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (validator *schemaValidator) addProblem(path string, format string, args ...interface{}) {
+	if path == "" {
+		path = "(root)"
+	}
+
+	validator.problems = append(validator.problems, path+": "+fmt.Sprintf(format, args...))
+}
+
+// matches returns TRUE if the value is valid against the schema, without recording problems
+func (validator *schemaValidator) matches(value interface{}, schema *jsonSchema) bool {
+	trial := &schemaValidator{definitions: validator.definitions}
+	trial.validate("", value, schema)
+
+	return len(trial.problems) == 0
+}
+
+func (validator *schemaValidator) validate(path string, value interface{}, schema *jsonSchema) {
+	if schema == nil || value == nil {
+		// An empty key, such as "colors:" with nothing under it, is treated as unset
+		return
+	}
+
+	if schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/definitions/")
+		validator.validate(path, value, validator.definitions[name])
+		return
+	}
+
+	if schema.Type != nil && !matchesType(value, schema.Type) {
+		validator.addProblem(path, "expected %s, got %s", typeNames(schema.Type), jsonTypeOf(value))
+		return
+	}
+
+	if schema.Const != nil && fmt.Sprint(value) != fmt.Sprint(schema.Const) {
+		validator.addProblem(path, "expected %v, got %v", schema.Const, value)
+	}
+
+	if len(schema.Enum) > 0 && !enumIncludes(schema.Enum, value) {
+		validator.addProblem(path, "unknown value %q", fmt.Sprint(value))
+	}
+
+	if schema.Minimum != nil {
+		if number, ok := numberValue(value); ok && number < float64(*schema.Minimum) {
+			validator.addProblem(path, "must be at least %d, got %v", *schema.Minimum, value)
+		}
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		validator.validateObject(path, typed, schema)
+	case []interface{}:
+		if schema.Items != nil {
+			for idx, item := range typed {
+				validator.validate(fmt.Sprintf("%s[%d]", path, idx), item, schema.Items)
+			}
+		}
+	}
+
+	for _, subSchema := range schema.AllOf {
+		validator.validate(path, value, subSchema)
+	}
+
+	if schema.If != nil {
+		if validator.matches(value, schema.If) {
+			validator.validate(path, value, schema.Then)
+		} else {
+			validator.validate(path, value, schema.Else)
+		}
+	}
+}
+
+func (validator *schemaValidator) validateObject(path string, object map[string]interface{}, schema *jsonSchema) {
+	for _, key := range schema.Required {
+		if _, ok := object[key]; !ok {
+			validator.addProblem(path, "missing required key %q", key)
+		}
+	}
+
+	for key, value := range object {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+
+		if property, ok := schema.Properties[key]; ok {
+			validator.validate(keyPath, value, property)
+			continue
+		}
+
+		switch additional := schema.AdditionalProperties.(type) {
+		case bool:
+			if !additional {
+				validator.addProblem(keyPath, "unknown key")
+			}
+		case *jsonSchema:
+			validator.validate(keyPath, value, additional)
+		}
+	}
+}
+
+// validateGrids checks that every enabled module fits inside the grid it is displayed in, at
+// every breakpoint. Grids without explicit columns and rows are sized to fit and so cannot be
+// overflowed
+func (validator *schemaValidator) validateGrids(config *config.Config) {
+	if _, err := config.Get("wtf.grid"); err == nil {
+		positionPaths := map[string]string{}
+
+		mods, _ := config.Map("wtf.mods")
+		for name := range mods {
+			modPath := "wtf.mods." + name
+			if !config.UBool(modPath+".enabled", false) {
+				continue
+			}
+
+			positionPaths[name] = modPath + ".position"
+		}
+
+		validator.validateLayout(config, "wtf", positionPaths)
+	}
+
+	for _, layoutName := range layoutNamesFrom(config) {
+		layoutPath := "wtf.layouts." + layoutName
+		positionPaths := map[string]string{}
+
+		moduleNames := utils.ToStrs(config.UList(layoutPath + ".mods"))
+		if modsMap, err := config.Map(layoutPath + ".mods"); err == nil {
+			for moduleName := range modsMap {
+				moduleNames = append(moduleNames, moduleName)
+			}
+		}
+
+		for _, name := range moduleNames {
+			positionPath := layoutPath + ".mods." + name + ".position"
+			if _, err := config.Get(positionPath); err != nil {
+				positionPath = "wtf.mods." + name + ".position"
+			}
+
+			positionPaths[name] = positionPath
+		}
+
+		validator.validateLayout(config, layoutPath, positionPaths)
+	}
+}
+
+// validateLayout checks that the modules, whose positions are found at positionPaths, fit
+// inside the grid of the layout whose settings are found at layoutPath, and inside the grid
+// of each of its breakpoints
+func (validator *schemaValidator) validateLayout(config *config.Config, layoutPath string, positionPaths map[string]string) {
+	gridPath := layoutPath + ".grid"
+
+	for _, positionPath := range positionPaths {
+		validator.validatePosition(config, positionPath, gridPath)
+	}
+
+	for _, breakpoint := range MakeBreakpoints(config, layoutPath, gridPath) {
+		breakpointPath := layoutPath + ".breakpoints." + breakpoint.Name
+
+		for name, positionPath := range positionPaths {
+			if breakpoint.Hides(name) {
+				continue
+			}
+
+			if _, ok := breakpoint.positions[name]; ok {
+				positionPath = breakpointPath + ".mods." + name + ".position"
+			} else if breakpoint.gridPath == gridPath {
+				// Already checked against the layout's own grid
+				continue
+			}
+
+			validator.validatePosition(config, positionPath, breakpoint.gridPath)
+		}
+	}
+}
+
+// validateTheme checks that the selected theme exists and can be loaded
+func (validator *schemaValidator) validateTheme(config *config.Config) {
+	name, err := config.String("wtf.theme")
+	if err != nil {
+		return
+	}
+
+	if _, err := cfg.LoadTheme(name); err != nil {
+		validator.addProblem("wtf.theme", "%s", err.Error())
+	}
+}
+
+func (validator *schemaValidator) validatePosition(config *config.Config, positionPath string, gridPath string) {
+	columns := len(config.UList(gridPath + ".columns"))
+	rows := len(config.UList(gridPath + ".rows"))
+
+	if columns == 0 || rows == 0 {
+		return
+	}
+
+	left := config.UInt(positionPath+".left", 0)
+	width := config.UInt(positionPath+".width", 1)
+	if left+width > columns {
+		validator.addProblem(
+			positionPath,
+			"spans columns %d to %d, but %s only has %d",
+			left, left+width-1, gridPath, columns,
+		)
+	}
+
+	top := config.UInt(positionPath+".top", 0)
+	height := config.UInt(positionPath+".height", 1)
+	if top+height > rows {
+		validator.addProblem(
+			positionPath,
+			"spans rows %d to %d, but %s only has %d",
+			top, top+height-1, gridPath, rows,
+		)
+	}
+}
+
+// matchesType returns TRUE if the value is of the schema type, or one of the schema types.
+// Numbers and booleans written as strings are accepted, as the config parser accepts them
+func matchesType(value interface{}, schemaType interface{}) bool {
+	switch typed := schemaType.(type) {
+	case string:
+		return matchesSingleType(value, typed)
+	case []string:
+		for _, single := range typed {
+			if matchesSingleType(value, single) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func matchesSingleType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "boolean":
+		switch typed := value.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(typed)
+			return err == nil
+		}
+	case "integer":
+		number, ok := numberValue(value)
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := numberValue(value)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "string":
+		switch value.(type) {
+		case string, int, float64, bool:
+			// YAML makes a number of an unquoted string such as 8080, and strings are read with
+			// fmt.Sprint, so any scalar is a valid string
+			return true
+		}
+	}
+
+	return false
+}
+
+func numberValue(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case int:
+		return float64(typed), true
+	case float64:
+		return typed, true
+	case string:
+		number, err := strconv.ParseFloat(typed, 64)
+		return number, err == nil
+	}
+
+	return 0, false
+}
+
+func jsonTypeOf(value interface{}) string {
+	switch typed := value.(type) {
+	case []interface{}:
+		return "array"
+	case bool:
+		return "boolean"
+	case int:
+		return "integer"
+	case float64:
+		return "number"
+	case map[string]interface{}:
+		return "object"
+	case string:
+		return fmt.Sprintf("string %q", typed)
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+func typeNames(schemaType interface{}) string {
+	if names, ok := schemaType.([]string); ok {
+		return strings.Join(names, " or ")
+	}
+
+	return fmt.Sprint(schemaType)
+}
+
+func enumIncludes(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == fmt.Sprint(value) {
+			return true
+		}
+	}
+
+	return false
+}
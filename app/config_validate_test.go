@@ -0,0 +1,188 @@
+package app
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		expected []string
+	}{
+		{
+			name: "valid config",
+			yaml: `
+wtf:
+  colors:
+  grid:
+    columns: [20, 20]
+    rows: [5, 5]
+  refreshInterval: "1"
+  theme: solarized
+  mods:
+    clocks:
+      enabled: true
+      position: {top: 0, left: 0, height: 2, width: 2}
+      refreshInterval: 15
+      timeFormat: 15:04
+    work:
+      type: jira
+      apikey: abc
+      domain: https://example.com
+      position: {top: 1, left: 1, height: 1, width: 1}`,
+			expected: []string{},
+		},
+		{
+			name: "unknown keys",
+			yaml: `
+wtf:
+  colours: {}
+  mods:
+    clocks:
+      bogus: true`,
+			expected: []string{
+				"wtf.colours: unknown key",
+				"wtf.mods.clocks.bogus: unknown key",
+			},
+		},
+		{
+			name: "wrong types",
+			yaml: `
+wtf:
+  refreshInterval: soon
+  mods:
+    clocks:
+      enabled: maybe
+      colors: red`,
+			expected: []string{
+				"wtf.mods.clocks.colors: expected object, got string \"red\"",
+				"wtf.mods.clocks.enabled: expected boolean, got string \"maybe\"",
+				"wtf.refreshInterval: expected integer, got string \"soon\"",
+			},
+		},
+		{
+			name: "unknown module type",
+			yaml: `
+wtf:
+  mods:
+    work:
+      type: nope
+    home:
+      enabled: true`,
+			expected: []string{
+				"wtf.mods.home: missing required key \"type\"",
+				"wtf.mods.work.type: unknown value \"nope\"",
+			},
+		},
+		{
+			name: "bad positions",
+			yaml: `
+wtf:
+  grid:
+    columns: [20, 20]
+    rows: [5]
+  mods:
+    clocks:
+      enabled: true
+      position: {top: 0, left: 1, height: 1, width: 2}
+    digitalclock:
+      position: {top: -1, left: 0, height: 1}`,
+			expected: []string{
+				"wtf.mods.clocks.position: spans columns 1 to 2, but wtf.grid only has 2",
+				"wtf.mods.digitalclock.position.top: must be at least 0, got -1",
+				"wtf.mods.digitalclock.position: missing required key \"width\"",
+			},
+		},
+		{
+			name: "unknown theme",
+			yaml: `
+wtf:
+  theme: nope`,
+			expected: []string{
+				"wtf.theme: no theme named \"nope\"",
+			},
+		},
+		{
+			name: "bad layout positions",
+			yaml: `
+wtf:
+  layouts:
+    small:
+      grid:
+        columns: [20]
+        rows: [5]
+      mods:
+        clocks:
+          position: {top: 1, left: 0, height: 1, width: 1}`,
+			expected: []string{
+				"wtf.layouts.small.mods.clocks.position: spans rows 1 to 1, but wtf.layouts.small.grid only has 1",
+			},
+		},
+		{
+			name: "bad breakpoint positions",
+			yaml: `
+wtf:
+  grid:
+    columns: [20, 20]
+    rows: [5, 5]
+  breakpoints:
+    narrow:
+      grid:
+        columns: [20]
+        rows: [5, 5]
+      mods:
+        digitalclock:
+          hidden: true
+    wide:
+      minWidth: 100
+      mods:
+        clocks:
+          position: {top: 0, left: 2, height: 1, width: 1}
+        digitalclock:
+          bogus: true
+  mods:
+    clocks:
+      enabled: true
+      position: {top: 0, left: 0, height: 1, width: 1}
+    digitalclock:
+      enabled: true
+      position: {top: 1, left: 1, height: 1, width: 1}`,
+			expected: []string{
+				"wtf.breakpoints.wide.mods.clocks.position: spans columns 2 to 2, but wtf.grid only has 2",
+				"wtf.breakpoints.wide.mods.digitalclock.bogus: unknown key",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wtfConfig, err := config.ParseYaml(tt.yaml)
+			assert.NoError(t, err)
+
+			problems := ValidateConfig(wtfConfig)
+			if len(tt.expected) == 0 {
+				assert.Empty(t, problems)
+			} else {
+				assert.Equal(t, tt.expected, problems)
+			}
+		})
+	}
+}
+
+func Test_ValidateConfig_SampleConfigs(t *testing.T) {
+	files, err := filepath.Glob("../_sample_configs/*.yml")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	for _, file := range files {
+		wtfConfig, err := config.ParseYamlFile(file)
+		assert.NoError(t, err)
+
+		assert.Empty(t, ValidateConfig(wtfConfig), file)
+	}
+}
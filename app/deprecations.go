@@ -0,0 +1,64 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/view"
+)
+
+const (
+	deprecationsPage = "deprecations"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// warnOfDeprecations shows a modal dialog listing the deprecated keys and module types in the
+// config files, if there are any, and logs them. They keep working until they're migrated
+func (wtfApp *WtfApp) warnOfDeprecations() {
+	_, files, err := cfg.ParseWtfConfigFiles(wtfApp.configFilePath)
+	if err != nil {
+		return
+	}
+
+	deprecations, err := cfg.FindDeprecations(files)
+	if err != nil || len(deprecations) == 0 {
+		return
+	}
+
+	for _, deprecation := range deprecations {
+		logger.Warnf("", "%s", deprecation)
+	}
+
+	closeFunc := func() {
+		wtfApp.pages.RemovePage(deprecationsPage)
+		wtfApp.CurrentLayout().FocusTracker.Refocus()
+	}
+
+	colors := wtfApp.themeColors()
+
+	text := fmt.Sprintf(
+		" [%s::b]Deprecated configuration[%s::-]\n\n%s\n\n Run `wtfutil migrate-config` to update the config. Press Esc to close",
+		colors.Warning,
+		colors.Text,
+		deprecationsText(deprecations),
+	)
+
+	modal := view.NewBillboardModal(text, closeFunc)
+
+	wtfApp.pages.AddPage(deprecationsPage, modal, false, true)
+	wtfApp.app.SetFocus(modal)
+}
+
+// deprecationsText returns a line describing each deprecation
+func deprecationsText(deprecations []cfg.Deprecation) string {
+	lines := []string{}
+	for _, deprecation := range deprecations {
+		lines = append(lines, " "+tview.Escape(deprecation.String()))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+func Test_deprecationsText(t *testing.T) {
+	deprecations := []cfg.Deprecation{
+		{File: "config.yml", Key: "wtf.paging.pageSigil", Replacement: "wtf.sigils.paging.normal"},
+		{File: "config.yml", Key: "wtf.mods.github.apikey", Replacement: "wtf.mods.github.apiKey"},
+	}
+
+	expected := " config.yml: wtf.paging.pageSigil is deprecated, use wtf.sigils.paging.normal instead\n" +
+		" config.yml: wtf.mods.github.apikey is deprecated, use wtf.mods.github.apiKey instead"
+
+	assert.Equal(t, expected, deprecationsText(deprecations))
+}
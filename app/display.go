@@ -3,65 +3,135 @@ package app
 import (
 	"github.com/olebedev/config"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/wtf"
 )
 
 // Display is the container for the onscreen representation of a WtfApp
 type Display struct {
-	Grid   *tview.Grid
-	config *config.Config
+	Grid        *tview.Grid
+	Breakpoint  *Breakpoint
+	breakpoints []*Breakpoint
+	config      *config.Config
+	gridPath    string
+	positions   map[string]cfg.PositionSettings
+	widgets     []wtf.Wtfable
 }
 
 // NewDisplay creates and returns a Display
 func NewDisplay(widgets []wtf.Wtfable, config *config.Config) *Display {
+	return NewDisplayForGrid(widgets, config, "wtf.grid", nil)
+}
+
+// NewDisplayForGrid creates and returns a Display that uses the grid definition found
+// at gridPath in the config. Any widget with an entry in positions is placed at that
+// position instead of the one defined in its own module configuration
+func NewDisplayForGrid(widgets []wtf.Wtfable, config *config.Config, gridPath string, positions map[string]cfg.PositionSettings) *Display {
 	display := Display{
-		Grid:   tview.NewGrid(),
-		config: config,
+		Grid:        tview.NewGrid(),
+		breakpoints: []*Breakpoint{},
+		config:      config,
+		gridPath:    gridPath,
+		positions:   positions,
+		widgets:     widgets,
 	}
 
-	firstWidget := widgets[0]
-	display.Grid.SetBackgroundColor(
-		wtf.ColorFor(
-			firstWidget.CommonSettings().Colors.WidgetTheme.Background,
-		),
-	)
+	if len(widgets) > 0 {
+		firstWidget := widgets[0]
+		display.Grid.SetBackgroundColor(
+			wtf.ColorFor(
+				firstWidget.CommonSettings().Colors.WidgetTheme.Background,
+			),
+		)
+	}
 
-	display.build(widgets)
+	display.build()
 
 	return &display
 }
 
+/* -------------------- Exported Functions -------------------- */
+
+// Hides returns TRUE if the widget is not displayed at the current breakpoint
+func (display *Display) Hides(widget wtf.Wtfable) bool {
+	return display.Breakpoint != nil && display.Breakpoint.Hides(widget.Name())
+}
+
+// Resize rearranges the widgets using the breakpoint that fits a terminal of the given size.
+// Returns TRUE if the breakpoint changed
+func (display *Display) Resize(width, height int) bool {
+	breakpoint := breakpointFor(display.breakpoints, width, height)
+	if breakpoint == display.Breakpoint {
+		return false
+	}
+
+	display.Breakpoint = breakpoint
+	display.Grid.Clear()
+	display.build()
+
+	return true
+}
+
+// SetBreakpoints sets the alternative arrangements the display chooses between when the
+// terminal is resized
+func (display *Display) SetBreakpoints(breakpoints []*Breakpoint) {
+	display.breakpoints = breakpoints
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 func (display *Display) add(widget wtf.Wtfable) {
-	if widget.Disabled() {
+	if widget.Disabled() || display.Hides(widget) {
 		return
 	}
 
+	position := display.positionFor(widget)
+
 	display.Grid.AddItem(
 		widget.TextView(),
-		widget.CommonSettings().Top,
-		widget.CommonSettings().Left,
-		widget.CommonSettings().Height,
-		widget.CommonSettings().Width,
+		position.Top,
+		position.Left,
+		position.Height,
+		position.Width,
 		0,
 		0,
 		false,
 	)
 }
 
-func (display *Display) build(widgets []wtf.Wtfable) *tview.Grid {
-	cols := utils.ToInts(display.config.UList("wtf.grid.columns"))
-	rows := utils.ToInts(display.config.UList("wtf.grid.rows"))
+func (display *Display) build() *tview.Grid {
+	gridPath := display.gridPath
+	if display.Breakpoint != nil {
+		gridPath = display.Breakpoint.gridPath
+	}
+
+	cols := utils.ToInts(display.config.UList(gridPath + ".columns"))
+	rows := utils.ToInts(display.config.UList(gridPath + ".rows"))
 
 	display.Grid.SetColumns(cols...)
 	display.Grid.SetRows(rows...)
 	display.Grid.SetBorder(false)
 
-	for _, widget := range widgets {
+	for _, widget := range display.widgets {
 		display.add(widget)
 	}
 
 	return display.Grid
 }
+
+// positionFor returns the position at which the widget should be drawn in this display
+// at the current breakpoint
+func (display *Display) positionFor(widget wtf.Wtfable) cfg.PositionSettings {
+	if display.Breakpoint != nil {
+		if position, ok := display.Breakpoint.positions[widget.Name()]; ok {
+			return position
+		}
+	}
+
+	if position, ok := display.positions[widget.Name()]; ok {
+		return position
+	}
+
+	return widget.CommonSettings().PositionSettings
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	errorOverlayPage = "errorOverlay"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// toggleErrorOverlay shows a modal dialog listing every widget whose last refresh failed,
+// or closes it if it is already open
+func (wtfApp *WtfApp) toggleErrorOverlay() {
+	if wtfApp.pages.HasPage(errorOverlayPage) {
+		wtfApp.closeErrorOverlay()
+		return
+	}
+
+	colors := wtfApp.themeColors()
+
+	text := fmt.Sprintf(
+		" [%s::b]Refresh errors[%s::-]\n\n%s\n\n Press Esc to close",
+		colors.Error,
+		colors.Text,
+		errorOverlayText(wtfApp.widgets, colors),
+	)
+
+	modal := view.NewBillboardModal(text, wtfApp.closeErrorOverlay)
+
+	wtfApp.pages.AddPage(errorOverlayPage, modal, false, true)
+	wtfApp.app.SetFocus(modal)
+}
+
+func (wtfApp *WtfApp) closeErrorOverlay() {
+	wtfApp.pages.RemovePage(errorOverlayPage)
+	wtfApp.CurrentLayout().FocusTracker.Refocus()
+}
+
+// errorOverlayText returns a description of each failing widget, sorted by name
+func errorOverlayText(widgets []wtf.Wtfable, colors cfg.ColorTheme) string {
+	failing := []wtf.Wtfable{}
+	for _, widget := range widgets {
+		if widget.Enabled() && widget.RefreshError() != nil {
+			failing = append(failing, widget)
+		}
+	}
+
+	if len(failing) == 0 {
+		return " All widgets refreshed successfully"
+	}
+
+	sort.SliceStable(failing, func(i, j int) bool {
+		return failing[i].Name() < failing[j].Name()
+	})
+
+	lines := []string{}
+	for _, widget := range failing {
+		lines = append(
+			lines,
+			fmt.Sprintf(
+				" [%s]%s[%s]: %s",
+				colors.Warning,
+				widget.Name(),
+				colors.Text,
+				tview.Escape(widget.RefreshErrorText()),
+			),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
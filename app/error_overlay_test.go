@@ -0,0 +1,31 @@
+package app
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+func Test_errorOverlayText(t *testing.T) {
+	widgetConfig, _ := config.ParseYaml(enabled)
+
+	t.Run("with no failing widgets", func(t *testing.T) {
+		widget := MakeWidget(nil, nil, "clocks", widgetConfig)
+
+		assert.Equal(t, " All widgets refreshed successfully", errorOverlayText([]wtf.Wtfable{widget}, cfg.NewDefaultColorTheme()))
+	})
+
+	t.Run("with a failing widget", func(t *testing.T) {
+		widget := MakeWidget(nil, nil, "clocks", widgetConfig)
+		widget.SetRefreshError(errors.New("connection [refused]"))
+
+		text := errorOverlayText([]wtf.Wtfable{widget}, cfg.NewDefaultColorTheme())
+
+		assert.True(t, strings.HasPrefix(text, " [yellow]clocks[white]: last refresh failed: connection [refused[]"))
+	})
+}
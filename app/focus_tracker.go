@@ -6,6 +6,7 @@ import (
 
 	"github.com/olebedev/config"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/wtf"
 )
 
@@ -25,7 +26,9 @@ type FocusTracker struct {
 	IsFocused bool
 	Widgets   []wtf.Wtfable
 
-	config *config.Config
+	config      *config.Config
+	hides       func(wtf.Wtfable) bool
+	positionFor func(wtf.Wtfable) cfg.PositionSettings
 }
 
 func NewFocusTracker(app *tview.Application, widgets []wtf.Wtfable, config *config.Config) FocusTracker {
@@ -71,6 +74,32 @@ func (tracker *FocusTracker) FocusOn(char string) bool {
 	return hasFocusable
 }
 
+// FocusWidget sets the focus on the given widget. Returns FALSE if the widget is not one
+// of the focusable widgets this tracker manages
+func (tracker *FocusTracker) FocusWidget(widget wtf.Wtfable) bool {
+	for idx, focusable := range tracker.focusables() {
+		if focusable == widget {
+			tracker.blur(tracker.Idx)
+			tracker.Idx = idx
+			tracker.focus(tracker.Idx)
+
+			tracker.IsFocused = true
+			return true
+		}
+	}
+
+	return false
+}
+
+// FocusedWidget returns the widget that currently has focus, or nil if none does
+func (tracker *FocusTracker) FocusedWidget() wtf.Wtfable {
+	if !tracker.IsFocused {
+		return nil
+	}
+
+	return tracker.focusableAt(tracker.Idx)
+}
+
 // Next sets the focus on the next widget in the widget list. If the current widget is
 // the last widget, sets focus on the first widget.
 func (tracker *FocusTracker) Next() {
@@ -203,21 +232,21 @@ func (tracker *FocusTracker) focusables() []wtf.Wtfable {
 	focusable := []wtf.Wtfable{}
 
 	for _, widget := range tracker.Widgets {
-		if widget.Focusable() {
+		if widget.Focusable() && !tracker.isHidden(widget) {
 			focusable = append(focusable, widget)
 		}
 	}
 
-	// Sort for deterministic ordering
+	// Sort for deterministic ordering, by where the widgets are displayed in this layout
 	sort.SliceStable(focusable, func(i, j int) bool {
-		iTop := focusable[i].CommonSettings().Top
-		jTop := focusable[j].CommonSettings().Top
+		iPosition := tracker.positionOf(focusable[i])
+		jPosition := tracker.positionOf(focusable[j])
 
-		if iTop < jTop {
+		if iPosition.Top < jPosition.Top {
 			return true
 		}
-		if iTop == jTop {
-			return focusable[i].CommonSettings().Left < focusable[j].CommonSettings().Left
+		if iPosition.Top == jPosition.Top {
+			return iPosition.Left < jPosition.Left
 		}
 		return false
 	})
@@ -225,6 +254,21 @@ func (tracker *FocusTracker) focusables() []wtf.Wtfable {
 	return focusable
 }
 
+// positionOf returns where the widget is displayed, which can differ from the position in
+// its module's config in a layout or at a breakpoint
+func (tracker *FocusTracker) positionOf(widget wtf.Wtfable) cfg.PositionSettings {
+	if tracker.positionFor != nil {
+		return tracker.positionFor(widget)
+	}
+
+	return widget.CommonSettings().PositionSettings
+}
+
+// isHidden returns TRUE if the widget is not currently displayed, and so cannot take focus
+func (tracker *FocusTracker) isHidden(widget wtf.Wtfable) bool {
+	return tracker.hides != nil && tracker.hides(widget)
+}
+
 func (tracker *FocusTracker) focusableAt(idx int) wtf.Wtfable {
 	if idx < 0 || idx >= len(tracker.focusables()) {
 		return nil
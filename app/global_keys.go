@@ -0,0 +1,109 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
+)
+
+// globalKeyAction is an app-wide action triggered from the keyboard. Its keys can be
+// remapped by name in the config:
+//
+//	wtf:
+//	  keys:
+//	    refresh-all: "ctrl-r"
+//	    command-palette: ["ctrl-p", "g p"]
+type globalKeyAction struct {
+	name string
+	keys []string
+	fn   func()
+
+	// passThrough actions let the key press continue on to the focused widget or modal
+	passThrough bool
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (wtfApp *WtfApp) globalKeyActions() []globalKeyAction {
+	return []globalKeyAction{
+		{name: "quit", keys: []string{"ctrl-c"}, fn: wtfApp.quit, passThrough: true},
+		{name: "refresh-all", keys: []string{"ctrl-r"}, fn: wtfApp.refreshAllWidgets},
+		{name: "pause-refreshing", keys: []string{"ctrl-s"}, fn: func() { wtfApp.scheduler.TogglePause() }},
+		{name: "next-layout", keys: []string{"ctrl-n"}, fn: wtfApp.NextLayout},
+		{name: "command-palette", keys: []string{"ctrl-p"}, fn: wtfApp.showCommandPalette},
+		{name: "show-errors", keys: []string{"ctrl-e"}, fn: wtfApp.toggleErrorOverlay},
+		{name: "zoom", keys: []string{"ctrl-f"}, fn: wtfApp.toggleZoom},
+		{name: "next-theme", keys: []string{"ctrl-t"}, fn: wtfApp.nextTheme},
+		{name: "next-widget", keys: []string{"tab"}, fn: func() { wtfApp.CurrentLayout().FocusTracker.Next() }, passThrough: true},
+		{name: "prev-widget", keys: []string{"backtab"}, fn: func() { wtfApp.CurrentLayout().FocusTracker.Prev() }},
+		{name: "unfocus", keys: []string{"esc"}, fn: wtfApp.unfocus, passThrough: true},
+	}
+}
+
+// buildKeymap binds each global action to its default keys, or to the keys it has been
+// remapped to in the config. Returns the keymap, the key sequences whose key presses should
+// continue on to the focused widget, and any problems found with the bindings
+func (wtfApp *WtfApp) buildKeymap(config *config.Config) (*view.Keymap, map[string]bool, []cfg.Validatable) {
+	keymap := view.NewKeymap()
+	passThrough := map[string]bool{}
+	validations := []cfg.Validatable{}
+
+	remappings := view.KeyBindingsFromConfig(config, "wtf.keys")
+
+	for _, action := range wtfApp.globalKeyActions() {
+		sequences := action.keys
+		if remapped, ok := remappings[action.name]; ok {
+			sequences = remapped
+			delete(remappings, action.name)
+		}
+
+		for _, sequence := range sequences {
+			keys, err := view.ParseKeySequence(sequence)
+			if err == nil {
+				err = keymap.Bind(keys, action.fn)
+			}
+
+			if err != nil {
+				validations = append(validations, cfg.NewKeyValidation(action.name, sequence, err))
+				continue
+			}
+
+			if action.passThrough {
+				passThrough[strings.Join(keys, " ")] = true
+			}
+		}
+	}
+
+	// Anything left over does not name a global action
+	for name, sequences := range remappings {
+		validations = append(
+			validations,
+			cfg.NewKeyValidation(name, strings.Join(sequences, ", "), view.ErrNoSuchKeyAction),
+		)
+	}
+
+	return keymap, passThrough, validations
+}
+
+// unfocus restores the layout if a widget is zoomed, and otherwise takes focus away from
+// the focused widget
+func (wtfApp *WtfApp) unfocus() {
+	if wtfApp.zoomed != nil {
+		// Leave the zoom alone when Esc is closing a modal displayed over the zoomed widget
+		if wtfApp.zoomed.TextView().HasFocus() {
+			wtfApp.unzoom()
+		}
+		return
+	}
+
+	wtfApp.CurrentLayout().FocusTracker.None()
+}
+
+// quit stops all the widgets and exits the app
+func (wtfApp *WtfApp) quit() {
+	wtfApp.Stop()
+	wtfApp.app.Stop()
+	wtfApp.DisplayExitMessage()
+}
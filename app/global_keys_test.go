@@ -0,0 +1,98 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildKeymap(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+
+	tests := []struct {
+		name             string
+		config           string
+		expectedErrors   []string
+		unbound          string
+		bound            string
+		expectPassedKeys []string
+	}{
+		{
+			name:             "with the default keys",
+			config:           "wtf:\n  mods: {}",
+			unbound:          "ctrl-o",
+			bound:            "ctrl-r",
+			expectPassedKeys: []string{"ctrl-c", "esc", "tab"},
+		},
+		{
+			name:             "with remapped keys",
+			config:           "wtf:\n  keys:\n    refresh-all: ctrl-o\n    unfocus: [esc, ctrl-u]",
+			unbound:          "ctrl-r",
+			bound:            "ctrl-o",
+			expectPassedKeys: []string{"ctrl-c", "ctrl-u", "esc", "tab"},
+		},
+		{
+			name:             "with conflicting and unknown keys",
+			config:           "wtf:\n  keys:\n    refresh-all: ctrl-n\n    make-coffee: ctrl-k",
+			expectedErrors:   []string{`"ctrl-n" is already bound`, "no such action"},
+			unbound:          "ctrl-k",
+			bound:            "ctrl-n",
+			expectPassedKeys: []string{"ctrl-c", "esc", "tab"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyConfig, _ := config.ParseYaml(tt.config)
+
+			keymap, passThrough, validations := wtfApp.buildKeymap(keyConfig)
+
+			messages := []string{}
+			for _, validation := range validations {
+				messages = append(messages, validation.Error().Error())
+			}
+			assert.ElementsMatch(t, tt.expectedErrors, messages)
+
+			passedKeys := []string{}
+			for sequence := range passThrough {
+				passedKeys = append(passedKeys, sequence)
+			}
+			assert.ElementsMatch(t, tt.expectPassedKeys, passedKeys)
+
+			assert.True(t, keymap.Bound(tt.bound))
+			assert.False(t, keymap.Bound(tt.unbound))
+		})
+	}
+}
+
+func Test_keyboardIntercept_chords(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+
+	keyConfig, _ := config.ParseYaml("wtf:\n  keys:\n    refresh-all: \"g r\"")
+	wtfApp.keymap, wtfApp.passThrough, _ = wtfApp.buildKeymap(keyConfig)
+
+	press := func(char rune) *tcell.EventKey {
+		return wtfApp.keyboardIntercept(tcell.NewEventKey(tcell.KeyRune, char, tcell.ModNone))
+	}
+
+	// The start of a chord is held back while nothing is focused
+	assert.Nil(t, press('g'))
+	assert.True(t, wtfApp.keymap.Pending())
+
+	// An abandoned chord passes on the key that ended it
+	assert.NotNil(t, press('x'))
+	assert.False(t, wtfApp.keymap.Pending())
+
+	// A focused widget receives the keys that chords begin with
+	focusTracker := &wtfApp.CurrentLayout().FocusTracker
+	focusTracker.Next()
+	assert.True(t, focusTracker.IsFocused)
+
+	assert.NotNil(t, press('g'))
+	assert.False(t, wtfApp.keymap.Pending())
+}
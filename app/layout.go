@@ -0,0 +1,169 @@
+package app
+
+import (
+	"sort"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	// defaultLayoutName is the name of the layout built from the top-level wtf.grid
+	// configuration and every module's own position
+	defaultLayoutName = "default"
+)
+
+// Layout is a named arrangement of widgets that are displayed together on a single page.
+// A config can define any number of layouts in addition to the default one:
+//
+//	wtf:
+//	  layout: oncall          <- the layout to display on startup
+//	  pauseWhenHidden: true   <- the default layout's modules stop refreshing while it is hidden
+//	  layouts:
+//	    oncall:
+//	      grid:
+//	        columns: [40, 40]
+//	        rows: [10, 10]
+//	      pauseWhenHidden: true
+//	      mods:
+//	        pagerduty: {}     <- uses the position defined in the module's config
+//	        jira:
+//	          position:       <- overrides the module's position for this layout only
+//	            top: 1
+//	            left: 0
+//	            height: 1
+//	            width: 2
+//	      breakpoints: {}     <- rearranges the layout by terminal size, see Breakpoint
+//
+// The default layout displays every module, so a module that is also in a layout that pauses
+// when hidden only stops refreshing if the default layout pauses too
+type Layout struct {
+	Name            string
+	Display         *Display
+	FocusTracker    FocusTracker
+	PauseWhenHidden bool
+	Widgets         []wtf.Wtfable
+}
+
+// NewLayout creates and returns a Layout that displays the given widgets
+func NewLayout(app *tview.Application, name string, widgets []wtf.Wtfable, config *config.Config, gridPath string, positions map[string]cfg.PositionSettings) *Layout {
+	layout := Layout{
+		Name:    name,
+		Display: NewDisplayForGrid(widgets, config, gridPath, positions),
+		Widgets: widgets,
+	}
+
+	layout.FocusTracker = NewFocusTracker(app, widgets, config)
+	layout.FocusTracker.hides = layout.Display.Hides
+	layout.FocusTracker.positionFor = layout.Display.positionFor
+
+	return &layout
+}
+
+// MakeLayouts creates and returns the default layout, if a top-level grid is defined,
+// followed by every named layout defined in the config, sorted by name
+func MakeLayouts(app *tview.Application, widgets []wtf.Wtfable, config *config.Config) []*Layout {
+	layouts := []*Layout{}
+
+	layoutNames := layoutNamesFrom(config)
+
+	_, err := config.Get("wtf.grid")
+	if err == nil || len(layoutNames) == 0 {
+		layout := NewLayout(app, defaultLayoutName, widgets, config, "wtf.grid", nil)
+		layout.Display.SetBreakpoints(MakeBreakpoints(config, "wtf", "wtf.grid"))
+		layout.PauseWhenHidden = config.UBool("wtf.pauseWhenHidden", false)
+
+		layouts = append(layouts, layout)
+	}
+
+	for _, name := range layoutNames {
+		layouts = append(layouts, makeNamedLayout(app, name, widgets, config))
+	}
+
+	return layouts
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Contains returns TRUE if the widget is displayed in this layout, FALSE if it is not
+func (layout *Layout) Contains(widget wtf.Wtfable) bool {
+	for _, layoutWidget := range layout.Widgets {
+		if layoutWidget == widget {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PageName returns the name of the page this layout is displayed on
+func (layout *Layout) PageName() string {
+	if layout.Name == defaultLayoutName {
+		return "grid"
+	}
+
+	return "layout:" + layout.Name
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func layoutNamesFrom(config *config.Config) []string {
+	layoutsMap, err := config.Map("wtf.layouts")
+	if err != nil {
+		return []string{}
+	}
+
+	names := []string{}
+	for name := range layoutsMap {
+		if name == defaultLayoutName {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func makeNamedLayout(app *tview.Application, name string, widgets []wtf.Wtfable, config *config.Config) *Layout {
+	layoutPath := "wtf.layouts." + name
+
+	layoutWidgets := []wtf.Wtfable{}
+	positions := map[string]cfg.PositionSettings{}
+
+	// The modules in a layout can be defined either as a list of module names, or as a map
+	// of module names to optional per-layout settings
+	moduleNames := utils.ToStrs(config.UList(layoutPath + ".mods"))
+	if modsMap, err := config.Map(layoutPath + ".mods"); err == nil {
+		for moduleName := range modsMap {
+			moduleNames = append(moduleNames, moduleName)
+		}
+	}
+
+	for _, widget := range widgets {
+		if !utils.Includes(moduleNames, widget.Name()) {
+			continue
+		}
+
+		layoutWidgets = append(layoutWidgets, widget)
+
+		moduleConfig, err := config.Get(layoutPath + ".mods." + widget.Name())
+		if err != nil {
+			continue
+		}
+
+		if _, err := moduleConfig.Get("position"); err == nil {
+			positions[widget.Name()] = cfg.NewPositionSettingsFromYAML(widget.Name(), moduleConfig)
+		}
+	}
+
+	layout := NewLayout(app, name, layoutWidgets, config, layoutPath+".grid", positions)
+	layout.Display.SetBreakpoints(MakeBreakpoints(config, layoutPath, layoutPath+".grid"))
+	layout.PauseWhenHidden = config.UBool(layoutPath+".pauseWhenHidden", false)
+
+	return layout
+}
@@ -0,0 +1,230 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	layoutsConfig = `
+wtf:
+  grid:
+    columns: [10, 10]
+    rows: [5, 5]
+  layouts:
+    oncall:
+      grid:
+        columns: [20]
+        rows: [10]
+      pauseWhenHidden: true
+      mods:
+        clocks:
+          position:
+            top: 0
+            left: 0
+            height: 1
+            width: 1
+    personal:
+      mods: [clocks, digitalclock]
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 1
+        left: 1
+        height: 1
+        width: 1
+    digitalclock:
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1`
+
+	noGridConfig = `
+wtf:
+  layouts:
+    oncall:
+      mods: [clocks]
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 1
+        left: 1
+        height: 1
+        width: 1`
+)
+
+func Test_MakeLayouts(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        string
+		expectedNames []string
+		expectedSizes []int
+	}{
+		{
+			name:          "with default grid and named layouts",
+			config:        layoutsConfig,
+			expectedNames: []string{"default", "oncall", "personal"},
+			expectedSizes: []int{2, 1, 2},
+		},
+		{
+			name:          "without default grid",
+			config:        noGridConfig,
+			expectedNames: []string{"oncall"},
+			expectedSizes: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.ParseYaml(tt.config)
+			assert.NoError(t, err)
+
+			widgets := MakeWidgets(nil, nil, cfg)
+			layouts := MakeLayouts(nil, widgets, cfg)
+
+			names := []string{}
+			sizes := []int{}
+			for _, layout := range layouts {
+				names = append(names, layout.Name)
+				sizes = append(sizes, len(layout.Widgets))
+			}
+
+			assert.Equal(t, tt.expectedNames, names)
+			assert.Equal(t, tt.expectedSizes, sizes)
+		})
+	}
+}
+
+func Test_Layout_positionOverride(t *testing.T) {
+	cfg, _ := config.ParseYaml(layoutsConfig)
+
+	widgets := MakeWidgets(nil, nil, cfg)
+	layouts := MakeLayouts(nil, widgets, cfg)
+
+	oncall := layouts[1]
+	assert.True(t, oncall.PauseWhenHidden)
+
+	position := oncall.Display.positionFor(oncall.Widgets[0])
+	assert.Equal(t, 0, position.Top)
+	assert.Equal(t, 0, position.Left)
+
+	position = layouts[0].Display.positionFor(oncall.Widgets[0])
+	assert.Equal(t, 1, position.Top)
+	assert.Equal(t, 1, position.Left)
+}
+
+func Test_isPaused(t *testing.T) {
+	pausingConfig := `
+wtf:
+  grid:
+    columns: [10, 10]
+    rows: [5, 5]
+  pauseWhenHidden: %t
+  layouts:
+    oncall:
+      pauseWhenHidden: true
+      mods: [clocks]
+    status:
+      mods: [digitalclock]
+  mods:
+    clocks:
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+    digitalclock:
+      enabled: true
+      position:
+        top: 1
+        left: 1
+        height: 1
+        width: 1`
+
+	tests := []struct {
+		name            string
+		pauseWhenHidden bool
+		layout          string
+		widget          string
+		expected        bool
+	}{
+		{
+			name:            "on the visible layout",
+			pauseWhenHidden: true,
+			layout:          "oncall",
+			widget:          "clocks",
+			expected:        false,
+		},
+		{
+			name:            "hidden by every layout that pauses",
+			pauseWhenHidden: true,
+			layout:          "status",
+			widget:          "clocks",
+			expected:        true,
+		},
+		{
+			name:            "hidden by a layout that doesn't pause",
+			pauseWhenHidden: true,
+			layout:          "oncall",
+			widget:          "digitalclock",
+			expected:        false,
+		},
+		{
+			name:            "hidden when the default layout doesn't pause",
+			pauseWhenHidden: false,
+			layout:          "status",
+			widget:          "clocks",
+			expected:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := config.ParseYaml(fmt.Sprintf(pausingConfig, tt.pauseWhenHidden))
+			assert.NoError(t, err)
+
+			widgets := MakeWidgets(nil, nil, cfg)
+			wtfApp := WtfApp{layouts: MakeLayouts(nil, widgets, cfg)}
+			wtfApp.layoutIdx = wtfApp.layoutIndexFor(tt.layout)
+
+			for _, widget := range widgets {
+				if widget.Name() == tt.widget {
+					assert.Equal(t, tt.expected, wtfApp.isPaused(widget))
+				}
+			}
+		})
+	}
+}
+
+func Test_Layout_focusOrder(t *testing.T) {
+	focusConfig, _ := config.ParseYaml(strings.Replace(layoutsConfig, "enabled: true", "enabled: true\n      focusable: true", -1))
+
+	widgets := MakeWidgets(nil, nil, focusConfig)
+	layouts := MakeLayouts(nil, widgets, focusConfig)
+
+	names := func(layout *Layout) []string {
+		names := []string{}
+		for _, widget := range layout.FocusTracker.focusables() {
+			names = append(names, widget.Name())
+		}
+		return names
+	}
+
+	// The default layout uses the modules' own positions
+	assert.Equal(t, []string{"digitalclock", "clocks"}, names(layouts[0]))
+
+	// while this layout swaps them
+	layouts[2].Display.positions["clocks"] = cfg.PositionSettings{Top: 0, Left: 0, Height: 1, Width: 1}
+	layouts[2].Display.positions["digitalclock"] = cfg.PositionSettings{Top: 1, Left: 1, Height: 1, Width: 1}
+	assert.Equal(t, []string{"clocks", "digitalclock"}, names(layouts[2]))
+}
@@ -0,0 +1,197 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// refreshDurationBuckets are the upper bounds, in seconds, of the refresh duration histogram
+var refreshDurationBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Metrics collects statistics about each widget's refreshes, so that widgets that have quietly
+// stopped working can be noticed. They are written in the Prometheus text format
+type Metrics struct {
+	mutex   *sync.Mutex
+	widgets map[string]*widgetMetrics
+}
+
+// widgetMetrics are the statistics about one widget's refreshes
+type widgetMetrics struct {
+	buckets     []uint64
+	duration    float64
+	errors      uint64
+	lastSuccess time.Time
+	module      string
+	refreshes   uint64
+}
+
+// NewMetrics creates and returns an empty Metrics
+func NewMetrics() *Metrics {
+	return &Metrics{
+		mutex:   &sync.Mutex{},
+		widgets: map[string]*widgetMetrics{},
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Observe records a refresh of the widget that took the given time, and that failed if the
+// widget now has a refresh error
+func (metrics *Metrics) Observe(widget wtf.Wtfable, duration time.Duration, at time.Time) {
+	module := ""
+	if widget.CommonSettings() != nil {
+		module = widget.CommonSettings().Module.Type
+	}
+
+	failed := widget.RefreshError() != nil
+
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	stats, ok := metrics.widgets[widget.Name()]
+	if !ok {
+		stats = &widgetMetrics{buckets: make([]uint64, len(refreshDurationBuckets))}
+		metrics.widgets[widget.Name()] = stats
+	}
+
+	stats.module = module
+	stats.refreshes++
+	stats.duration += duration.Seconds()
+
+	// Each bucket counts every refresh that took no longer than its bound
+	for idx, bound := range refreshDurationBuckets {
+		if duration.Seconds() <= bound {
+			stats.buckets[idx]++
+		}
+	}
+
+	if failed {
+		stats.errors++
+	} else {
+		stats.lastSuccess = at
+	}
+}
+
+// Write writes the widget statistics, along with the goroutine and memory gauges, to w in
+// the Prometheus text format
+func (metrics *Metrics) Write(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+
+	metrics.writeWidgetMetrics(buf)
+	writeRuntimeMetrics(buf)
+
+	return buf.Flush()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (metrics *Metrics) writeWidgetMetrics(w io.Writer) {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+
+	names := []string{}
+	for name := range metrics.widgets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writeMetricHeader(w, "wtf_widget_refreshes_total", "counter", "How many times the widget has been refreshed.")
+	for _, name := range names {
+		stats := metrics.widgets[name]
+		fmt.Fprintf(w, "wtf_widget_refreshes_total%s %d\n", stats.labels(name), stats.refreshes)
+	}
+
+	writeMetricHeader(w, "wtf_widget_refresh_errors_total", "counter", "How many of the widget's refreshes have failed.")
+	for _, name := range names {
+		stats := metrics.widgets[name]
+		fmt.Fprintf(w, "wtf_widget_refresh_errors_total%s %d\n", stats.labels(name), stats.errors)
+	}
+
+	writeMetricHeader(w, "wtf_widget_refresh_duration_seconds", "histogram", "How long the widget's refreshes take.")
+	for _, name := range names {
+		stats := metrics.widgets[name]
+		labels := stats.labels(name)
+
+		for idx, bound := range refreshDurationBuckets {
+			fmt.Fprintf(
+				w,
+				"wtf_widget_refresh_duration_seconds_bucket%s %d\n",
+				withLabel(labels, "le", formatFloat(bound)),
+				stats.buckets[idx],
+			)
+		}
+		fmt.Fprintf(w, "wtf_widget_refresh_duration_seconds_bucket%s %d\n", withLabel(labels, "le", "+Inf"), stats.refreshes)
+		fmt.Fprintf(w, "wtf_widget_refresh_duration_seconds_sum%s %s\n", labels, formatFloat(stats.duration))
+		fmt.Fprintf(w, "wtf_widget_refresh_duration_seconds_count%s %d\n", labels, stats.refreshes)
+	}
+
+	writeMetricHeader(w, "wtf_widget_last_success_timestamp_seconds", "gauge", "When the widget last refreshed successfully, as a Unix time. 0 if it never has.")
+	for _, name := range names {
+		stats := metrics.widgets[name]
+
+		lastSuccess := 0.0
+		if !stats.lastSuccess.IsZero() {
+			lastSuccess = float64(stats.lastSuccess.UnixNano()) / float64(time.Second)
+		}
+
+		fmt.Fprintf(w, "wtf_widget_last_success_timestamp_seconds%s %s\n", stats.labels(name), formatFloat(lastSuccess))
+	}
+}
+
+func (stats *widgetMetrics) labels(name string) string {
+	return fmt.Sprintf(`{widget="%s",module="%s"}`, escapeLabelValue(name), escapeLabelValue(stats.module))
+}
+
+// writeRuntimeMetrics writes the goroutine and memory gauges, named as the Prometheus Go
+// client names them so that existing dashboards work with them
+func writeRuntimeMetrics(w io.Writer) {
+	memStats := runtime.MemStats{}
+	runtime.ReadMemStats(&memStats)
+
+	gauges := []struct {
+		name  string
+		help  string
+		value uint64
+	}{
+		{"go_goroutines", "Number of goroutines that currently exist.", uint64(runtime.NumGoroutine())},
+		{"go_memstats_alloc_bytes", "Number of bytes allocated and still in use.", memStats.Alloc},
+		{"go_memstats_heap_inuse_bytes", "Number of heap bytes that are in use.", memStats.HeapInuse},
+		{"go_memstats_heap_objects", "Number of allocated objects.", memStats.HeapObjects},
+		{"go_memstats_sys_bytes", "Number of bytes obtained from system.", memStats.Sys},
+	}
+
+	for _, gauge := range gauges {
+		writeMetricHeader(w, gauge.name, "gauge", gauge.help)
+		fmt.Fprintf(w, "%s %d\n", gauge.name, gauge.value)
+	}
+
+	writeMetricHeader(w, "go_memstats_gc_completed_total", "counter", "Number of completed garbage collection cycles.")
+	fmt.Fprintf(w, "go_memstats_gc_completed_total %d\n", memStats.NumGC)
+}
+
+func writeMetricHeader(w io.Writer, name, metricType, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// escapeLabelValue escapes the characters that can't appear as-is in a label value
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// withLabel adds a label to the end of a set of labels
+func withLabel(labels, name, value string) string {
+	return fmt.Sprintf(`%s,%s="%s"}`, strings.TrimSuffix(labels, "}"), name, escapeLabelValue(value))
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/olebedev/config"
+)
+
+const (
+	defaultMetricsAddress = "127.0.0.1:9484"
+	metricsPath           = "/metrics"
+)
+
+// MetricsServer is an optional HTTP server that exposes the scheduler's widget refresh
+// statistics, and the app's goroutine and memory usage, for Prometheus, or anything else that
+// reads its text format, to scrape. It only ever listens on a loopback address:
+//
+//	wtf:
+//	  metrics:
+//	    enabled: true
+//	    address: "127.0.0.1:9484"
+//
+// The metrics are served from /metrics
+type MetricsServer struct {
+	listener net.Listener
+	metrics  *Metrics
+	server   *http.Server
+}
+
+// NewMetricsServer creates and returns a MetricsServer for the metrics, listening on the
+// address defined in the config. Returns nil if metrics are not enabled
+func NewMetricsServer(metrics *Metrics, config *config.Config) (*MetricsServer, error) {
+	if !config.UBool("wtf.metrics.enabled", false) {
+		return nil, nil
+	}
+
+	address := config.UString("wtf.metrics.address", defaultMetricsAddress)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLoopbackHost(host) {
+		return nil, fmt.Errorf("metrics address %q must be a loopback address such as localhost or 127.0.0.1", address)
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsServer := MetricsServer{
+		listener: listener,
+		metrics:  metrics,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(metricsPath, metricsServer.handleMetrics)
+
+	metricsServer.server = &http.Server{Handler: mux}
+
+	return &metricsServer, nil
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Serve accepts incoming scrapes until the server is stopped
+func (metricsServer *MetricsServer) Serve() error {
+	err := metricsServer.server.Serve(metricsServer.listener)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
+}
+
+// Stop shuts down the server and releases its address
+func (metricsServer *MetricsServer) Stop() {
+	_ = metricsServer.server.Close()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (metricsServer *MetricsServer) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = metricsServer.metrics.Write(w)
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Metrics_Write(t *testing.T) {
+	cfg, _ := config.ParseYaml(enabled)
+	widget := MakeWidget(nil, nil, "clocks", cfg)
+
+	metrics := NewMetrics()
+
+	at := time.Unix(1600000000, 0)
+	metrics.Observe(widget, 200*time.Millisecond, at)
+
+	widget.SetRefreshError(errors.New("failed"))
+	metrics.Observe(widget, 3*time.Second, at.Add(time.Minute))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, metrics.Write(buf))
+	output := buf.String()
+
+	expected := []string{
+		"# TYPE wtf_widget_refreshes_total counter",
+		`wtf_widget_refreshes_total{widget="clocks",module="clocks"} 2`,
+		`wtf_widget_refresh_errors_total{widget="clocks",module="clocks"} 1`,
+		"# TYPE wtf_widget_refresh_duration_seconds histogram",
+		`wtf_widget_refresh_duration_seconds_bucket{widget="clocks",module="clocks",le="0.1"} 0`,
+		`wtf_widget_refresh_duration_seconds_bucket{widget="clocks",module="clocks",le="0.25"} 1`,
+		`wtf_widget_refresh_duration_seconds_bucket{widget="clocks",module="clocks",le="5"} 2`,
+		`wtf_widget_refresh_duration_seconds_bucket{widget="clocks",module="clocks",le="+Inf"} 2`,
+		`wtf_widget_refresh_duration_seconds_sum{widget="clocks",module="clocks"} 3.2`,
+		`wtf_widget_refresh_duration_seconds_count{widget="clocks",module="clocks"} 2`,
+		`wtf_widget_last_success_timestamp_seconds{widget="clocks",module="clocks"} 1.6e+09`,
+		"# TYPE go_goroutines gauge",
+		"# TYPE go_memstats_alloc_bytes gauge",
+	}
+
+	for _, line := range expected {
+		assert.Contains(t, strings.Split(output, "\n"), line)
+	}
+}
+
+func Test_escapeLabelValue(t *testing.T) {
+	assert.Equal(t, `my \"widget\"\\\n`, escapeLabelValue("my \"widget\"\\\n"))
+}
+
+func Test_NewMetricsServer(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      string
+		expectedErr string
+		expectNil   bool
+	}{
+		{
+			name:      "when disabled",
+			config:    "wtf:\n  metrics:\n    enabled: false",
+			expectNil: true,
+		},
+		{
+			name:        "with a non-loopback address",
+			config:      "wtf:\n  metrics:\n    enabled: true\n    address: \"0.0.0.0:9484\"",
+			expectedErr: `metrics address "0.0.0.0:9484" must be a loopback address such as localhost or 127.0.0.1`,
+			expectNil:   true,
+		},
+		{
+			name:      "with a loopback address",
+			config:    "wtf:\n  metrics:\n    enabled: true\n    address: \"127.0.0.1:0\"",
+			expectNil: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYaml(tt.config)
+
+			metricsServer, err := NewMetricsServer(NewMetrics(), cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectNil, metricsServer == nil)
+
+			if metricsServer != nil {
+				metricsServer.Stop()
+			}
+		})
+	}
+}
+
+func Test_WtfApp_startMetricsServer(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+	wtfApp := NewWtfApp(tview.NewApplication(), cfg, "")
+
+	enabledConfig, _ := config.ParseYaml("wtf:\n  metrics:\n    enabled: true\n    address: \"127.0.0.1:0\"")
+	disabledConfig, _ := config.ParseYaml("wtf:\n  metrics:\n    enabled: false")
+
+	wtfApp.startMetricsServer(enabledConfig)
+	assert.NotNil(t, wtfApp.metricsServer)
+
+	wtfApp.startMetricsServer(disabledConfig)
+	assert.Nil(t, wtfApp.metricsServer)
+
+	wtfApp.Stop()
+}
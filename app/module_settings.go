@@ -0,0 +1,438 @@
+package app
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/modules/azuredevops"
+	"github.com/wtfutil/wtf/modules/bamboohr"
+	"github.com/wtfutil/wtf/modules/bargraph"
+	"github.com/wtfutil/wtf/modules/buildkite"
+	cdsfavorites "github.com/wtfutil/wtf/modules/cds/favorites"
+	cdsqueue "github.com/wtfutil/wtf/modules/cds/queue"
+	cdsstatus "github.com/wtfutil/wtf/modules/cds/status"
+	"github.com/wtfutil/wtf/modules/circleci"
+	"github.com/wtfutil/wtf/modules/clocks"
+	"github.com/wtfutil/wtf/modules/cmdrunner"
+	"github.com/wtfutil/wtf/modules/cryptoexchanges/bittrex"
+	"github.com/wtfutil/wtf/modules/cryptoexchanges/blockfolio"
+	"github.com/wtfutil/wtf/modules/cryptoexchanges/cryptolive"
+	"github.com/wtfutil/wtf/modules/datadog"
+	"github.com/wtfutil/wtf/modules/devto"
+	"github.com/wtfutil/wtf/modules/digitalclock"
+	"github.com/wtfutil/wtf/modules/digitalocean"
+	"github.com/wtfutil/wtf/modules/docker"
+	"github.com/wtfutil/wtf/modules/exchangerates"
+	"github.com/wtfutil/wtf/modules/feedreader"
+	"github.com/wtfutil/wtf/modules/finnhub"
+	"github.com/wtfutil/wtf/modules/football"
+	"github.com/wtfutil/wtf/modules/gcal"
+	"github.com/wtfutil/wtf/modules/gerrit"
+	"github.com/wtfutil/wtf/modules/git"
+	"github.com/wtfutil/wtf/modules/github"
+	"github.com/wtfutil/wtf/modules/gitlab"
+	"github.com/wtfutil/wtf/modules/gitlabtodo"
+	"github.com/wtfutil/wtf/modules/gitter"
+	"github.com/wtfutil/wtf/modules/gmail"
+	"github.com/wtfutil/wtf/modules/googleanalytics"
+	"github.com/wtfutil/wtf/modules/grafana"
+	"github.com/wtfutil/wtf/modules/gspreadsheets"
+	"github.com/wtfutil/wtf/modules/hackernews"
+	"github.com/wtfutil/wtf/modules/hibp"
+	"github.com/wtfutil/wtf/modules/ipaddresses/ipapi"
+	"github.com/wtfutil/wtf/modules/ipaddresses/ipinfo"
+	"github.com/wtfutil/wtf/modules/jenkins"
+	"github.com/wtfutil/wtf/modules/jira"
+	"github.com/wtfutil/wtf/modules/kubernetes"
+	"github.com/wtfutil/wtf/modules/logger"
+	"github.com/wtfutil/wtf/modules/mercurial"
+	"github.com/wtfutil/wtf/modules/nbascore"
+	"github.com/wtfutil/wtf/modules/newrelic"
+	"github.com/wtfutil/wtf/modules/notes"
+	"github.com/wtfutil/wtf/modules/opsgenie"
+	"github.com/wtfutil/wtf/modules/pagerduty"
+	"github.com/wtfutil/wtf/modules/pihole"
+	"github.com/wtfutil/wtf/modules/plugin"
+	"github.com/wtfutil/wtf/modules/pocket"
+	"github.com/wtfutil/wtf/modules/power"
+	"github.com/wtfutil/wtf/modules/resourceusage"
+	"github.com/wtfutil/wtf/modules/rollbar"
+	"github.com/wtfutil/wtf/modules/security"
+	"github.com/wtfutil/wtf/modules/spacex"
+	"github.com/wtfutil/wtf/modules/spotify"
+	"github.com/wtfutil/wtf/modules/spotifyweb"
+	"github.com/wtfutil/wtf/modules/status"
+	"github.com/wtfutil/wtf/modules/subreddit"
+	"github.com/wtfutil/wtf/modules/textfile"
+	"github.com/wtfutil/wtf/modules/todo"
+	"github.com/wtfutil/wtf/modules/todo_plus"
+	"github.com/wtfutil/wtf/modules/transmission"
+	"github.com/wtfutil/wtf/modules/travisci"
+	"github.com/wtfutil/wtf/modules/twitch"
+	"github.com/wtfutil/wtf/modules/twitter"
+	"github.com/wtfutil/wtf/modules/twitterstats"
+	"github.com/wtfutil/wtf/modules/unknown"
+	"github.com/wtfutil/wtf/modules/uptimerobot"
+	"github.com/wtfutil/wtf/modules/victorops"
+	"github.com/wtfutil/wtf/modules/weatherservices/arpansagovau"
+	"github.com/wtfutil/wtf/modules/weatherservices/prettyweather"
+	"github.com/wtfutil/wtf/modules/weatherservices/weather"
+	"github.com/wtfutil/wtf/modules/zendesk"
+)
+
+// settingsConstructor builds the settings of a module from its config
+type settingsConstructor func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{}
+
+// moduleSettingsConstructors builds the settings for each module type that MakeWidget can create.
+// Everything that needs a module's settings, such as its widget or the services its secrets
+// are loaded from, gets them from here
+var moduleSettingsConstructors = map[string]settingsConstructor{
+	"arpansagovau": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return arpansagovau.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"azuredevops": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return azuredevops.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"bamboohr": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return bamboohr.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"bargraph": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return bargraph.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"bittrex": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return bittrex.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"blockfolio": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return blockfolio.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"buildkite": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return buildkite.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"cdsFavorites": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return cdsfavorites.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"cdsQueue": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return cdsqueue.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"cdsStatus": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return cdsstatus.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"circleci": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return circleci.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"clocks": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return clocks.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"cmdrunner": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return cmdrunner.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"cryptolive": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return cryptolive.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"datadog": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return datadog.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"devto": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return devto.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"digitalclock": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return digitalclock.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"digitalocean": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return digitalocean.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"docker": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return docker.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"exchangerates": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return exchangerates.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"feedreader": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return feedreader.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"finnhub": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return finnhub.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"football": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return football.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gcal": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gcal.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gerrit": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gerrit.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"git": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return git.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"github": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return github.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gitlab": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gitlab.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gitlabtodo": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gitlabtodo.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gitter": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gitter.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gmail": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gmail.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"googleanalytics": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return googleanalytics.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"grafana": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return grafana.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"gspreadsheets": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return gspreadsheets.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"hackernews": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return hackernews.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"hibp": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return hibp.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"ipapi": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return ipapi.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"ipinfo": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return ipinfo.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"jenkins": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return jenkins.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"jira": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return jira.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"kubernetes": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return kubernetes.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"logger": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return logger.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"mercurial": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return mercurial.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"nbascore": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return nbascore.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"newrelic": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return newrelic.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"notes": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return notes.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"opsgenie": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return opsgenie.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"pagerduty": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return pagerduty.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"pihole": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return pihole.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"plugin": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return plugin.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"pocket": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return pocket.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"power": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return power.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"prettyweather": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return prettyweather.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"resourceusage": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return resourceusage.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"rollbar": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return rollbar.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"security": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return security.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"spacex": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return spacex.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"spotify": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return spotify.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"spotifyweb": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return spotifyweb.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"status": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return status.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"subreddit": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return subreddit.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"textfile": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return textfile.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"todo": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return todo.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"todo_plus": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return todo_plus.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"todoist": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return todo_plus.FromTodoist(name, moduleConfig, globalConfig)
+	},
+	"transmission": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return transmission.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"travisci": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return travisci.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"trello": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return todo_plus.FromTrello(name, moduleConfig, globalConfig)
+	},
+	"twitch": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return twitch.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"twitter": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return twitter.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"twitterstats": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return twitterstats.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"uptimerobot": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return uptimerobot.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"victorops": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return victorops.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"weather": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return weather.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+	"zendesk": func(name string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+		return zendesk.NewSettingsFromYAML(name, moduleConfig, globalConfig)
+	},
+}
+
+// makeModuleSettings builds the settings of a module of the given type. Modules of types that
+// MakeWidget can't create get the settings of the unknown module
+func makeModuleSettings(moduleType string, moduleName string, moduleConfig *config.Config, globalConfig *config.Config) interface{} {
+	newSettings, ok := moduleSettingsConstructors[moduleType]
+	if !ok {
+		return unknown.NewSettingsFromYAML(moduleName, moduleConfig, globalConfig)
+	}
+
+	return newSettings(moduleName, moduleConfig, globalConfig)
+}
+
+// moduleSettings holds an empty instance of the settings for each module type that MakeWidget
+// can create. The config schema is generated from their fields and struct tags
+var moduleSettings = map[string]interface{}{
+	"arpansagovau":    arpansagovau.Settings{},
+	"azuredevops":     azuredevops.Settings{},
+	"bamboohr":        bamboohr.Settings{},
+	"bargraph":        bargraph.Settings{},
+	"bittrex":         bittrex.Settings{},
+	"blockfolio":      blockfolio.Settings{},
+	"buildkite":       buildkite.Settings{},
+	"cdsFavorites":    cdsfavorites.Settings{},
+	"cdsQueue":        cdsqueue.Settings{},
+	"cdsStatus":       cdsstatus.Settings{},
+	"circleci":        circleci.Settings{},
+	"clocks":          clocks.Settings{},
+	"cmdrunner":       cmdrunner.Settings{},
+	"cryptolive":      cryptolive.Settings{},
+	"datadog":         datadog.Settings{},
+	"devto":           devto.Settings{},
+	"digitalclock":    digitalclock.Settings{},
+	"digitalocean":    digitalocean.Settings{},
+	"docker":          docker.Settings{},
+	"exchangerates":   exchangerates.Settings{},
+	"feedreader":      feedreader.Settings{},
+	"finnhub":         finnhub.Settings{},
+	"football":        football.Settings{},
+	"gcal":            gcal.Settings{},
+	"gerrit":          gerrit.Settings{},
+	"git":             git.Settings{},
+	"github":          github.Settings{},
+	"gitlab":          gitlab.Settings{},
+	"gitlabtodo":      gitlabtodo.Settings{},
+	"gitter":          gitter.Settings{},
+	"gmail":           gmail.Settings{},
+	"googleanalytics": googleanalytics.Settings{},
+	"grafana":         grafana.Settings{},
+	"gspreadsheets":   gspreadsheets.Settings{},
+	"hackernews":      hackernews.Settings{},
+	"hibp":            hibp.Settings{},
+	"ipapi":           ipapi.Settings{},
+	"ipinfo":          ipinfo.Settings{},
+	"jenkins":         jenkins.Settings{},
+	"jira":            jira.Settings{},
+	"kubernetes":      kubernetes.Settings{},
+	"logger":          logger.Settings{},
+	"mercurial":       mercurial.Settings{},
+	"nbascore":        nbascore.Settings{},
+	"newrelic":        newrelic.Settings{},
+	"notes":           notes.Settings{},
+	"opsgenie":        opsgenie.Settings{},
+	"pagerduty":       pagerduty.Settings{},
+	"pihole":          pihole.Settings{},
+	"plugin":          plugin.Settings{},
+	"pocket":          pocket.Settings{},
+	"power":           power.Settings{},
+	"prettyweather":   prettyweather.Settings{},
+	"resourceusage":   resourceusage.Settings{},
+	"rollbar":         rollbar.Settings{},
+	"security":        security.Settings{},
+	"spacex":          spacex.Settings{},
+	"spotify":         spotify.Settings{},
+	"spotifyweb":      spotifyweb.Settings{},
+	"status":          status.Settings{},
+	"subreddit":       subreddit.Settings{},
+	"textfile":        textfile.Settings{},
+	"todo":            todo.Settings{},
+	"todo_plus":       todo_plus.Settings{},
+	"todoist":         todo_plus.Settings{},
+	"transmission":    transmission.Settings{},
+	"travisci":        travisci.Settings{},
+	"trello":          todo_plus.Settings{},
+	"twitch":          twitch.Settings{},
+	"twitter":         twitter.Settings{},
+	"twitterstats":    twitterstats.Settings{},
+	"uptimerobot":     uptimerobot.Settings{},
+	"victorops":       victorops.Settings{},
+	"weather":         weather.Settings{},
+	"zendesk":         zendesk.Settings{},
+}
+
+// moduleConfigAliases lists the config keys that modules read but that do not correspond to a
+// field in their settings, usually older spellings kept for backwards compatibility
+var moduleConfigAliases = map[string][]string{
+	"arpansagovau":  {"locationid"},
+	"bamboohr":      {"apikey"},
+	"blockfolio":    {"device_token"},
+	"buildkite":     {"organizationSlug"},
+	"circleci":      {"apikey"},
+	"datadog":       {"apikey", "monitors"},
+	"digitalocean":  {"apikey"},
+	"finnhub":       {"apikey"},
+	"football":      {"apikey"},
+	"github":        {"apikey"},
+	"gitlab":        {"apikey"},
+	"gitter":        {"roomUri"},
+	"grafana":       {"baseUri"},
+	"gspreadsheets": {"cells", "sheetId"},
+	"hibp":          {"apikey"},
+	"jenkins":       {"apikey"},
+	"jira":          {"apikey", "project"},
+	"opsgenie":      {"apikey"},
+	"pagerduty":     {"apikey"},
+	"spacex":        {"spacex"},
+	"todo":          {"checkedIcon", "filename", "uncheckedIcon"},
+	"todoist":       {"apiKey", "apikey", "projects"},
+	"travisci":      {"apikey"},
+	"trello":        {"accessToken", "apiKey", "board", "list", "projects", "username"},
+	"twitch":        {"twitch"},
+	"twitter":       {"screenName"},
+	"victorops":     {"apikey"},
+	"weather":       {"apikey", "cityids"},
+	"zendesk":       {"apikey"},
+}
@@ -13,9 +13,15 @@ type ModuleValidator struct{}
 
 type widgetError struct {
 	name             string
+	section          string
 	validationErrors []cfg.Validatable
 }
 
+// keyValidator is implemented by widgets whose key bindings can be remapped in the config
+type keyValidator interface {
+	KeyValidations() []cfg.Validatable
+}
+
 func NewModuleValidator() *ModuleValidator {
 	return &ModuleValidator{}
 }
@@ -23,8 +29,18 @@ func NewModuleValidator() *ModuleValidator {
 // Validate rolls through all the enabled widgets and looks for configuration errors.
 // If it finds any it stringifies them, writes them to the console, and kills the app gracefully
 func (val *ModuleValidator) Validate(widgets []wtf.Wtfable) {
-	validationErrors := validate(widgets)
+	val.exitOnErrors(validate(widgets))
+}
+
+// ValidateGlobalKeys looks for problems with the app-wide key bindings. If it finds any it
+// writes them to the console and kills the app gracefully
+func (val *ModuleValidator) ValidateGlobalKeys(keyValidations []cfg.Validatable) {
+	val.exitOnErrors(validateKeys("wtf", keyValidations))
+}
 
+/* -------------------- Unexported Functions -------------------- */
+
+func (val *ModuleValidator) exitOnErrors(validationErrors []widgetError) {
 	if len(validationErrors) > 0 {
 		fmt.Println()
 		for _, error := range validationErrors {
@@ -40,7 +56,7 @@ func (val *ModuleValidator) Validate(widgets []wtf.Wtfable) {
 
 func validate(widgets []wtf.Wtfable) (widgetErrors []widgetError) {
 	for _, widget := range widgets {
-		error := widgetError{name: widget.Name()}
+		error := widgetError{name: widget.Name(), section: "position"}
 
 		for _, val := range widget.CommonSettings().Validations() {
 			if val.HasError() {
@@ -51,6 +67,64 @@ func validate(widgets []wtf.Wtfable) (widgetErrors []widgetError) {
 		if len(error.validationErrors) > 0 {
 			widgetErrors = append(widgetErrors, error)
 		}
+
+		widgetErrors = append(widgetErrors, validateRules(widget.Name(), widget.CommonSettings().RuleValidations())...)
+		widgetErrors = append(widgetErrors, validateSettings(widget.Name(), widget.CommonSettings().SettingValidations())...)
+
+		if keyed, ok := widget.(keyValidator); ok {
+			widgetErrors = append(widgetErrors, validateKeys(widget.Name(), keyed.KeyValidations())...)
+		}
+	}
+
+	return widgetErrors
+}
+
+// validateKeys returns the errors found in the key bindings configured under name
+func validateKeys(name string, keyValidations []cfg.Validatable) (widgetErrors []widgetError) {
+	error := widgetError{name: name, section: "keys"}
+
+	for _, val := range keyValidations {
+		if val.HasError() {
+			error.validationErrors = append(error.validationErrors, val)
+		}
+	}
+
+	if len(error.validationErrors) > 0 {
+		widgetErrors = append(widgetErrors, error)
+	}
+
+	return widgetErrors
+}
+
+// validateRules returns the errors found in the rules configured for the named module
+func validateRules(name string, ruleValidations []cfg.Validatable) (widgetErrors []widgetError) {
+	error := widgetError{name: name, section: "rules"}
+
+	for _, val := range ruleValidations {
+		if val.HasError() {
+			error.validationErrors = append(error.validationErrors, val)
+		}
+	}
+
+	if len(error.validationErrors) > 0 {
+		widgetErrors = append(widgetErrors, error)
+	}
+
+	return widgetErrors
+}
+
+// validateSettings returns the errors found in the values of the named module's own settings
+func validateSettings(name string, settingValidations []cfg.Validatable) (widgetErrors []widgetError) {
+	error := widgetError{name: name, section: "settings"}
+
+	for _, val := range settingValidations {
+		if val.HasError() {
+			error.validationErrors = append(error.validationErrors, val)
+		}
+	}
+
+	if len(error.validationErrors) > 0 {
+		widgetErrors = append(widgetErrors, error)
 	}
 
 	return widgetErrors
@@ -62,8 +136,9 @@ func (err widgetError) errorMessages() (messages []string) {
 		aurora.Red("Errors"),
 		aurora.Yellow(
 			fmt.Sprintf(
-				"%s.position",
+				"%s.%s",
 				err.name,
+				err.section,
 			),
 		),
 	)
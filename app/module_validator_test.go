@@ -34,6 +34,19 @@ wtf:
         height: 1
         width: 1
       refreshInterval: 30`
+
+	invalidSetting = `
+wtf:
+  mods:
+    resourceusage:
+      chartStyle: bars
+      enabled: true
+      position:
+        top: 0
+        left: 0
+        height: 1
+        width: 1
+      refreshInterval: 30`
 )
 
 func Test_NewModuleValidator(t *testing.T) {
@@ -72,6 +85,22 @@ func Test_validate(t *testing.T) {
 				),
 			},
 		},
+		{
+			name:       "invalid setting",
+			moduleName: "resourceusage",
+			config: func() *config.Config {
+				cfg, _ := config.ParseYaml(invalidSetting)
+				return cfg
+			}(),
+			expected: []string{
+				fmt.Sprintf("%s in %s configuration", aurora.Red("Errors"), aurora.Yellow("resourceusage.settings")),
+				fmt.Sprintf(
+					" - %s	%s unknown chart style \"bars\", must be blocks or braille",
+					aurora.Yellow("chartStyle"),
+					aurora.Red("Error:"),
+				),
+			},
+		},
 	}
 
 	for _, tt := range tests {
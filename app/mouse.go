@@ -0,0 +1,169 @@
+package app
+
+import (
+	"time"
+
+	"github.com/gdamore/tcell"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// doubleClickInterval is the longest time between two clicks on the same row for them to
+// count as a double-click
+const doubleClickInterval = 400 * time.Millisecond
+
+// mouseScreen is a terminal screen that hands mouse events to the app instead of passing them
+// on to tview, which does not support the mouse
+type mouseScreen struct {
+	tcell.Screen
+	onMouse func(event *tcell.EventMouse)
+}
+
+// PollEvent waits for the next event that is not a mouse event and returns it
+func (screen *mouseScreen) PollEvent() tcell.Event {
+	for {
+		event := screen.Screen.PollEvent()
+
+		mouseEvent, ok := event.(*tcell.EventMouse)
+		if !ok {
+			return event
+		}
+
+		screen.onMouse(mouseEvent)
+	}
+}
+
+// mouseClick records where and when a row was last clicked, to detect double-clicks
+type mouseClick struct {
+	widget wtf.Wtfable
+	y      int
+	at     time.Time
+}
+
+// scroller is implemented by widgets whose selection can be moved with the scroll wheel
+type scroller interface {
+	Next()
+	Prev()
+}
+
+// rowSelector is implemented by widgets whose rows can be selected and opened by clicking them
+type rowSelector interface {
+	SelectAt(y int) bool
+	OpenSelected()
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// EnableMouse gives the app a terminal screen that reports mouse events, unless the mouse
+// has been turned off in the config. Must be called before the tview application is run
+func (wtfApp *WtfApp) EnableMouse() error {
+	if !wtfApp.config.UBool("wtf.mouse", true) {
+		return nil
+	}
+
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+
+	if err := screen.Init(); err != nil {
+		return err
+	}
+
+	screen.EnableMouse()
+
+	wtfApp.app.SetScreen(
+		&mouseScreen{
+			Screen: screen,
+			onMouse: func(event *tcell.EventMouse) {
+				wtfApp.app.QueueUpdateDraw(func() { wtfApp.handleMouse(event) })
+			},
+		},
+	)
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// handleMouse focuses the widget that was clicked, selecting and opening the clicked row in
+// widgets that support it, and scrolls the widget under the scroll wheel. Must be called from
+// within the app's event loop
+func (wtfApp *WtfApp) handleMouse(event *tcell.EventMouse) {
+	buttons := event.Buttons()
+
+	// The terminal reports which buttons are held down, so a click is a button that was not
+	// held down in the previous event
+	clicked := buttons&tcell.Button1 != 0 && wtfApp.mouseButtons&tcell.Button1 == 0
+	wtfApp.mouseButtons = buttons
+
+	// Leave the mouse alone while a modal dialog is displayed over the widgets
+	if wtfApp.modalDisplayed() {
+		return
+	}
+
+	x, y := event.Position()
+
+	widget := wtfApp.widgetAt(x, y)
+	if widget == nil {
+		return
+	}
+
+	switch {
+	case buttons&tcell.WheelUp != 0:
+		if scrollable, ok := widget.(scroller); ok {
+			scrollable.Prev()
+		}
+	case buttons&tcell.WheelDown != 0:
+		if scrollable, ok := widget.(scroller); ok {
+			scrollable.Next()
+		}
+	case clicked:
+		wtfApp.click(widget, y, event.When())
+	}
+}
+
+// click focuses the widget and selects the row that was clicked, opening it if it was
+// double-clicked
+func (wtfApp *WtfApp) click(widget wtf.Wtfable, y int, at time.Time) {
+	if widget.Focusable() {
+		wtfApp.focusWidget(widget)
+	}
+
+	selector, ok := widget.(rowSelector)
+	if !ok || !selector.SelectAt(y) {
+		wtfApp.lastClick = mouseClick{}
+		return
+	}
+
+	last := wtfApp.lastClick
+	if last.widget == widget && last.y == y && at.Sub(last.at) <= doubleClickInterval {
+		wtfApp.lastClick = mouseClick{}
+		selector.OpenSelected()
+		return
+	}
+
+	wtfApp.lastClick = mouseClick{widget: widget, y: y, at: at}
+}
+
+// widgetAt returns the widget displayed at the given screen position, or nil if there is none
+func (wtfApp *WtfApp) widgetAt(x, y int) wtf.Wtfable {
+	display := wtfApp.CurrentLayout().Display
+
+	widgets := wtfApp.CurrentLayout().Widgets
+	if wtfApp.zoomed != nil {
+		widgets = []wtf.Wtfable{wtfApp.zoomed}
+	}
+
+	for _, widget := range widgets {
+		if !widget.Enabled() || (widget != wtfApp.zoomed && display.Hides(widget)) {
+			continue
+		}
+
+		left, top, width, height := widget.TextView().GetRect()
+		if x >= left && x < left+width && y >= top && y < top+height {
+			return widget
+		}
+	}
+
+	return nil
+}
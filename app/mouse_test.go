@@ -0,0 +1,73 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_handleMouse(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+	screen.SetSize(80, 25)
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+
+	wtfApp := NewWtfApp(tviewApp, cfg, "")
+	wtfApp.pages.SetRect(0, 0, 80, 25)
+	wtfApp.pages.Draw(screen)
+
+	widget := wtfApp.widgets[0]
+	left, top, width, _ := widget.TextView().GetRect()
+	focusTracker := &wtfApp.CurrentLayout().FocusTracker
+
+	// Clicking outside every widget does nothing
+	wtfApp.handleMouse(tcell.NewEventMouse(left+width+1, top, tcell.Button1, tcell.ModNone))
+	wtfApp.handleMouse(tcell.NewEventMouse(left+width+1, top, tcell.ButtonNone, tcell.ModNone))
+	assert.False(t, focusTracker.IsFocused)
+
+	// The mouse is ignored while a modal is displayed over the widgets
+	wtfApp.showCommandPalette()
+	wtfApp.handleMouse(tcell.NewEventMouse(left+1, top+1, tcell.Button1, tcell.ModNone))
+	wtfApp.handleMouse(tcell.NewEventMouse(left+1, top+1, tcell.ButtonNone, tcell.ModNone))
+	assert.False(t, focusTracker.IsFocused)
+	wtfApp.pages.RemovePage(commandPalettePage)
+
+	// Clicking a widget focuses it
+	wtfApp.handleMouse(tcell.NewEventMouse(left+1, top+1, tcell.Button1, tcell.ModNone))
+	assert.True(t, focusTracker.IsFocused)
+	assert.Equal(t, widget, focusTracker.FocusedWidget())
+
+	// Holding the button down is not another click
+	wtfApp.unfocus()
+	wtfApp.handleMouse(tcell.NewEventMouse(left+2, top+1, tcell.Button1, tcell.ModNone))
+	assert.False(t, focusTracker.IsFocused)
+}
+
+func Test_widgetAt(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+	screen.SetSize(80, 25)
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+
+	wtfApp := NewWtfApp(tviewApp, cfg, "")
+	wtfApp.pages.SetRect(0, 0, 80, 25)
+	wtfApp.pages.Draw(screen)
+
+	left, top, width, height := wtfApp.widgets[0].TextView().GetRect()
+
+	assert.Equal(t, wtfApp.widgets[0], wtfApp.widgetAt(left, top))
+	assert.Equal(t, wtfApp.widgets[0], wtfApp.widgetAt(left+width-1, top+height-1))
+	assert.Nil(t, wtfApp.widgetAt(left+width, top))
+	assert.Nil(t, wtfApp.widgetAt(left, top+height))
+}
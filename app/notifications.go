@@ -0,0 +1,69 @@
+package app
+
+import (
+	"time"
+
+	"github.com/wtfutil/wtf/notify"
+)
+
+const (
+	// titleFlashDuration is how long a widget's title flashes after it raises a notification
+	titleFlashDuration = 5 * time.Second
+
+	// titleFlashRedraw is how often a flashing title is redrawn, often enough to catch every
+	// change between highlighted and normal
+	titleFlashRedraw = 250 * time.Millisecond
+)
+
+// flasher is implemented by widgets whose title can flash to draw attention to them
+type flasher interface {
+	Flash(duration time.Duration)
+	Flashing() bool
+	RedrawTitle()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// setUpNotifications replaces the notifier that widgets raise notifications with by one
+// built from the current config, delivering the sinks that need the app through it
+func (wtfApp *WtfApp) setUpNotifications() {
+	notifier := notify.NewNotifier(wtfApp.config)
+	notifier.AddSink(notify.SinkBell, notify.SinkFunc(wtfApp.ringBell))
+	notifier.AddSink(notify.SinkTitle, notify.SinkFunc(wtfApp.flashTitle))
+
+	notify.SetDefault(notifier)
+}
+
+// flashTitle flashes the title of the widget that raised the notification
+func (wtfApp *WtfApp) flashTitle(event notify.Event) error {
+	widget, ok := wtfApp.widgetNamed(event.Module).(flasher)
+	if !ok {
+		return nil
+	}
+
+	widget.Flash(titleFlashDuration)
+
+	go func() {
+		ticker := time.NewTicker(titleFlashRedraw)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			widget.RedrawTitle()
+
+			if !widget.Flashing() {
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// ringBell rings the terminal bell the next time the app is drawn
+func (wtfApp *WtfApp) ringBell(event notify.Event) error {
+	wtfApp.app.QueueUpdateDraw(func() {
+		wtfApp.bellPending = true
+	})
+
+	return nil
+}
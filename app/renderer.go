@@ -0,0 +1,221 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	// RenderFormatANSI renders widget content with its colors converted to ANSI escape sequences
+	RenderFormatANSI = "ansi"
+	// RenderFormatJSON renders widget content as a JSON array, one object per widget
+	RenderFormatJSON = "json"
+	// RenderFormatText renders widget content as plain text with all colors removed
+	RenderFormatText = "text"
+
+	defaultRenderHeight = 24
+	defaultRenderWidth  = 80
+
+	// renderPollInterval is how often a widget is checked while waiting for its refresh to
+	// finish and its content to settle
+	renderPollInterval = 50 * time.Millisecond
+	// renderSettleTime is how long a widget's content must stay the same, once its refresh
+	// has finished, for it to be captured
+	renderSettleTime = 200 * time.Millisecond
+	// renderTimeout is the longest a widget is waited on before its content is captured as
+	// it is
+	renderTimeout = 30 * time.Second
+)
+
+// RenderedWidget is the onscreen content of a single widget, captured after a refresh
+type RenderedWidget struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// Render instantiates widgets without displaying the app, refreshes each of them once, and
+// writes their content to out in the given format. If moduleNames is empty every enabled
+// module in the config is rendered
+func Render(config *config.Config, format string, moduleNames []string, out io.Writer) error {
+	if !utils.Includes([]string{RenderFormatANSI, RenderFormatJSON, RenderFormatText}, format) {
+		return fmt.Errorf("unsupported render format %q, must be one of: ansi, json, text", format)
+	}
+
+	// Widgets draw through a tview application, so run one against an offscreen screen
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		return err
+	}
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+	pages := tview.NewPages()
+
+	widgets, err := makeRenderWidgets(tviewApp, pages, config, moduleNames)
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error)
+	go func() { done <- tviewApp.Run() }()
+
+	rendered := []RenderedWidget{}
+	for _, widget := range widgets {
+		rendered = append(rendered, renderWidget(tviewApp, widget, config, format))
+		widget.Stop()
+	}
+
+	tviewApp.Stop()
+	if err := <-done; err != nil {
+		return err
+	}
+
+	return writeRendered(out, rendered, format)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func makeRenderWidgets(tviewApp *tview.Application, pages *tview.Pages, config *config.Config, moduleNames []string) ([]wtf.Wtfable, error) {
+	if len(moduleNames) == 0 {
+		widgets := MakeWidgets(tviewApp, pages, config)
+
+		// Render in onscreen order, top-to-bottom and left-to-right
+		sort.SliceStable(widgets, func(i, j int) bool {
+			iSettings, jSettings := widgets[i].CommonSettings(), widgets[j].CommonSettings()
+			if iSettings.Top == jSettings.Top {
+				return iSettings.Left < jSettings.Left
+			}
+			return iSettings.Top < jSettings.Top
+		})
+
+		return widgets, nil
+	}
+
+	widgets := []wtf.Wtfable{}
+	for _, moduleName := range moduleNames {
+		if _, err := config.Get("wtf.mods." + moduleName); err != nil {
+			return nil, fmt.Errorf("module %q is not defined in the config", moduleName)
+		}
+
+		// Explicitly-requested modules are rendered even if they are disabled onscreen
+		err := config.Set("wtf.mods."+moduleName+".enabled", true)
+		if err != nil {
+			return nil, err
+		}
+
+		widgets = append(widgets, MakeWidget(tviewApp, pages, moduleName, config))
+	}
+
+	return widgets, nil
+}
+
+func renderWidget(tviewApp *tview.Application, widget wtf.Wtfable, config *config.Config, format string) RenderedWidget {
+	width, height, err := utils.CalculateDimensions(widget.CommonSettings().Config, config)
+	if err != nil || width == 0 || height == 0 {
+		width, height = defaultRenderWidth, defaultRenderHeight
+	}
+	widget.TextView().SetRect(0, 0, width+2, height+2)
+
+	deadline := time.Now().Add(renderTimeout)
+
+	refreshed := make(chan bool)
+	go func() {
+		RefreshWidget(widget)
+		close(refreshed)
+	}()
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Until(deadline)):
+	}
+
+	// Widgets that fetch their data in the background are refreshing until it arrives
+	awaitRefresh(widget.Refreshing, deadline)
+
+	return settledContent(func() RenderedWidget { return captureWidget(tviewApp, widget, format) }, deadline)
+}
+
+// awaitRefresh blocks until refreshing returns FALSE or the deadline passes
+func awaitRefresh(refreshing func() bool, deadline time.Time) {
+	for refreshing() && time.Now().Before(deadline) {
+		time.Sleep(renderPollInterval)
+	}
+}
+
+// settledContent captures a widget's content until it has stayed the same for the settle
+// time, so that redraws queued by its refresh, and any those queue in turn, have been
+// applied. Returns whatever was last captured if the deadline passes first
+func settledContent(capture func() RenderedWidget, deadline time.Time) RenderedWidget {
+	rendered := capture()
+	settledAt := time.Now().Add(renderSettleTime)
+
+	for time.Now().Before(settledAt) && time.Now().Before(deadline) {
+		time.Sleep(renderPollInterval)
+
+		latest := capture()
+		if latest != rendered {
+			rendered = latest
+			settledAt = time.Now().Add(renderSettleTime)
+		}
+	}
+
+	return rendered
+}
+
+// captureWidget returns the widget's current onscreen content once any redraws it has
+// already queued on the app have been applied. Must not be called from the app's event loop
+func captureWidget(tviewApp *tview.Application, widget wtf.Wtfable, format string) RenderedWidget {
+	result := make(chan RenderedWidget)
+
+	tviewApp.QueueUpdate(func() {
+		view := widget.TextView()
+
+		content := strings.TrimRight(view.GetText(true), "\n")
+		if format == RenderFormatANSI {
+			content = wtf.TviewToANSIColors(strings.TrimRight(view.GetText(false), "\n"))
+		}
+
+		result <- RenderedWidget{
+			Name:    widget.Name(),
+			Type:    widget.CommonSettings().Module.Type,
+			Title:   strings.TrimSpace(utils.StripColorTags(view.GetTitle())),
+			Content: content,
+		}
+	})
+
+	return <-result
+}
+
+func writeRendered(out io.Writer, rendered []RenderedWidget, format string) error {
+	if format == RenderFormatJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(rendered)
+	}
+
+	for idx, widget := range rendered {
+		if idx > 0 {
+			if _, err := fmt.Fprintln(out); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(out, "%s\n%s\n", widget.Title, widget.Content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package app
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Render(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		moduleNames []string
+		expectedErr string
+	}{
+		{
+			name:        "with unsupported format",
+			format:      "xml",
+			expectedErr: `unsupported render format "xml", must be one of: ansi, json, text`,
+		},
+		{
+			name:        "with undefined module",
+			format:      RenderFormatText,
+			moduleNames: []string{"cats"},
+			expectedErr: `module "cats" is not defined in the config`,
+		},
+		{
+			name:        "with defined module",
+			format:      RenderFormatJSON,
+			moduleNames: []string{"clocks"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYaml(disabled)
+			out := &bytes.Buffer{}
+
+			err := Render(cfg, tt.format, tt.moduleNames, out)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Contains(t, out.String(), `"name": "clocks"`)
+		})
+	}
+}
+
+func Test_writeRendered(t *testing.T) {
+	rendered := []RenderedWidget{
+		{Name: "one", Title: "One", Content: "cat"},
+		{Name: "two", Title: "Two", Content: "dog"},
+	}
+
+	out := &bytes.Buffer{}
+	err := writeRendered(out, rendered, RenderFormatText)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "One\ncat\n\nTwo\ndog\n", out.String())
+}
+
+func Test_awaitRefresh(t *testing.T) {
+	checks := 0
+	refreshing := func() bool {
+		checks++
+		return checks < 3
+	}
+
+	awaitRefresh(refreshing, time.Now().Add(time.Minute))
+	assert.Equal(t, 3, checks)
+
+	// A refresh that never finishes is given up on at the deadline
+	start := time.Now()
+	awaitRefresh(func() bool { return true }, start.Add(3*renderPollInterval))
+	assert.True(t, time.Since(start) < time.Minute)
+}
+
+func Test_settledContent(t *testing.T) {
+	captures := 0
+	capture := func() RenderedWidget {
+		captures++
+
+		// The content arrives a few captures after the refresh finished
+		if captures < 4 {
+			return RenderedWidget{Name: "async"}
+		}
+		return RenderedWidget{Name: "async", Content: "done"}
+	}
+
+	rendered := settledContent(capture, time.Now().Add(time.Minute))
+	assert.Equal(t, "done", rendered.Content)
+
+	// Content that never settles is captured as it is at the deadline
+	changing := 0
+	rendered = settledContent(func() RenderedWidget {
+		changing++
+		return RenderedWidget{Content: strings.Repeat("x", changing)}
+	}, time.Now().Add(3*renderPollInterval))
+	assert.NotEqual(t, "", rendered.Content)
+}
@@ -1,38 +1,272 @@
 package app
 
 import (
+	"math"
+	"math/rand"
+	"net/url"
+	"sync"
 	"time"
 
+	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/wtf"
 )
 
-// Schedule kicks off the first refresh of a module's data and then queues the rest of the
-// data refreshes on a timer
-func Schedule(widget wtf.Wtfable) {
-	widget.Refresh()
+// hostConfigKeys are the module settings, in order of preference, that are inspected to
+// figure out which remote host a widget fetches its data from
+var hostConfigKeys = []string{"refreshHost", "baseURL", "apiURL", "domain", "url", "host", "server"}
 
-	interval := time.Duration(widget.RefreshInterval()) * time.Second
+// Scheduler manages the periodic refreshing of widget data. It can be tuned in the config
+// to avoid hammering remote APIs:
+//
+//	wtf:
+//	  scheduler:
+//	    startupJitter: 10         <- spread the first refreshes over this many seconds
+//	    jitter: 0.1               <- randomly vary each interval by up to 10%
+//	    maxBackoff: 3600          <- the longest, in seconds, to wait after repeated failures
+//	    maxConcurrentPerHost: 2   <- how many widgets may refresh against one host at once
+type Scheduler struct {
+	hostLimit     int
+	isPaused      func(wtf.Wtfable) bool
+	jitter        float64
+	maxBackoff    time.Duration
+	startupJitter time.Duration
+
+	allPaused  bool
+	hostSlots  map[string]chan bool
+	metrics    *Metrics
+	mutex      *sync.Mutex
+	randomizer *rand.Rand
+}
+
+// NewScheduler creates and returns a Scheduler configured from the wtf.scheduler settings.
+// Scheduled refreshes are skipped while isPaused returns TRUE for a widget
+func NewScheduler(config *config.Config, isPaused func(wtf.Wtfable) bool) *Scheduler {
+	scheduler := Scheduler{
+		isPaused: isPaused,
+
+		metrics:    NewMetrics(),
+		mutex:      &sync.Mutex{},
+		randomizer: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	scheduler.Configure(config)
+
+	return &scheduler
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Configure applies the wtf.scheduler settings. Widgets that are already scheduled keep
+// their schedules, and use the new settings from their next refresh on
+func (scheduler *Scheduler) Configure(config *config.Config) {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	scheduler.hostLimit = config.UInt("wtf.scheduler.maxConcurrentPerHost", 0)
+	scheduler.jitter = config.UFloat64("wtf.scheduler.jitter", 0)
+	scheduler.maxBackoff = time.Duration(config.UInt("wtf.scheduler.maxBackoff", 3600)) * time.Second
+	scheduler.startupJitter = time.Duration(config.UInt("wtf.scheduler.startupJitter", 0)) * time.Second
+
+	// Refreshes that are under way give their slots back to the old channels
+	scheduler.hostSlots = map[string]chan bool{}
+}
+
+// Metrics returns the statistics gathered about the widgets' refreshes
+func (scheduler *Scheduler) Metrics() *Metrics {
+	return scheduler.metrics
+}
+
+// Paused returns TRUE if all scheduled refreshes are currently paused
+func (scheduler *Scheduler) Paused() bool {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	return scheduler.allPaused
+}
+
+// Refresh refreshes the widget's data, waiting first if too many other widgets are already
+// refreshing against the same host, and records how long the refresh took and whether it
+// failed
+func (scheduler *Scheduler) Refresh(widget wtf.Wtfable) {
+	slots := scheduler.slotsFor(refreshHost(widget))
+	if slots != nil {
+		slots <- true
+		defer func() { <-slots }()
+	}
+
+	start := time.Now()
+	RefreshWidget(widget)
+	scheduler.metrics.Observe(widget, time.Since(start), time.Now())
+}
 
+// Schedule kicks off the first refresh of a widget's data and then queues the rest of the
+// data refreshes on a timer. If a refresh fails, the time until the next one is doubled
+// until it succeeds again. Returns when the widget is stopped or disabled
+func (scheduler *Scheduler) Schedule(widget wtf.Wtfable) {
+	scheduler.mutex.Lock()
+	startupJitter := scheduler.startupJitter
+	scheduler.mutex.Unlock()
+
+	if !scheduler.wait(widget, scheduler.randomDuration(startupJitter)) {
+		return
+	}
+
+	scheduler.Refresh(widget)
+
+	interval := time.Duration(widget.RefreshInterval()) * time.Second
 	if interval <= 0 {
 		return
 	}
 
-	timer := time.NewTicker(interval)
+	failures := 0
+	if widget.RefreshError() != nil {
+		failures++
+	}
 
 	for {
-		select {
-		case <-timer.C:
-			if widget.Enabled() {
-				widget.Refresh()
-			} else {
-				timer.Stop()
-				return
+		if !scheduler.wait(widget, scheduler.nextDelay(interval, failures)) {
+			return
+		}
+
+		if !widget.Enabled() {
+			return
+		}
+
+		if scheduler.Paused() || (scheduler.isPaused != nil && scheduler.isPaused(widget)) {
+			continue
+		}
+
+		scheduler.Refresh(widget)
+
+		if widget.RefreshError() != nil {
+			failures++
+		} else {
+			failures = 0
+		}
+	}
+}
+
+// TogglePause pauses all scheduled refreshes if they are running, and resumes them if they
+// are paused. Returns TRUE if refreshes are now paused
+func (scheduler *Scheduler) TogglePause() bool {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	scheduler.allPaused = !scheduler.allPaused
+
+	return scheduler.allPaused
+}
+
+// RefreshWidget refreshes the widget's data and records when the refresh finished. The
+// widget's title shows that it is refreshing while the refresh is in progress
+func RefreshWidget(widget wtf.Wtfable) {
+	widget.SetRefreshing(true)
+	widget.RedrawTitle()
+
+	widget.Refresh()
+
+	widget.SetRefreshedAt(time.Now())
+	widget.SetRefreshing(false)
+	widget.RedrawTitle()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// nextDelay returns how long to wait before the next refresh, doubling the interval for
+// each consecutive failure and then applying the configured jitter
+func (scheduler *Scheduler) nextDelay(interval time.Duration, failures int) time.Duration {
+	scheduler.mutex.Lock()
+	jitter, maxBackoff := scheduler.jitter, scheduler.maxBackoff
+	scheduler.mutex.Unlock()
+
+	delay := interval
+
+	if failures > 0 {
+		backoff := float64(interval) * math.Pow(2, float64(failures))
+		delay = time.Duration(math.Min(backoff, float64(maxBackoff)))
+
+		// Never wait less than the regular interval because of a small maxBackoff
+		if delay < interval {
+			delay = interval
+		}
+	}
+
+	if jitter > 0 {
+		spread := time.Duration(float64(delay) * jitter)
+		delay = delay - spread + scheduler.randomDuration(2*spread)
+	}
+
+	return delay
+}
+
+func (scheduler *Scheduler) randomDuration(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	return time.Duration(scheduler.randomizer.Int63n(int64(max)))
+}
+
+// slotsFor returns the channel used to limit concurrent refreshes against the host, or
+// nil if refreshes are not limited
+func (scheduler *Scheduler) slotsFor(host string) chan bool {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+
+	if scheduler.hostLimit <= 0 {
+		return nil
+	}
+
+	slots, ok := scheduler.hostSlots[host]
+	if !ok {
+		slots = make(chan bool, scheduler.hostLimit)
+		scheduler.hostSlots[host] = slots
+	}
+
+	return slots
+}
+
+// wait blocks for the given duration. Returns FALSE if the widget was told to quit while
+// waiting
+func (scheduler *Scheduler) wait(widget wtf.Wtfable, delay time.Duration) bool {
+	if delay <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case quit := <-widget.QuitChan():
+		return !quit
+	}
+}
+
+// refreshHost returns the name of the host the widget fetches its data from. Widgets that
+// do not define a remote host are grouped by their module type
+func refreshHost(widget wtf.Wtfable) string {
+	moduleConfig := widget.CommonSettings().Config
+
+	if moduleConfig != nil {
+		for _, key := range hostConfigKeys {
+			value := moduleConfig.UString(key, "")
+			if value == "" {
+				continue
 			}
-		case quit := <-widget.QuitChan():
-			if quit {
-				timer.Stop()
-				return
+
+			parsed, err := url.Parse(value)
+			if err == nil && parsed.Host != "" {
+				return parsed.Host
 			}
+
+			return value
 		}
 	}
+
+	return widget.CommonSettings().Module.Type
 }
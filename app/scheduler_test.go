@@ -0,0 +1,106 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Scheduler_nextDelay(t *testing.T) {
+	cfg, _ := config.ParseYaml("wtf:\n  scheduler:\n    maxBackoff: 100")
+	scheduler := NewScheduler(cfg, nil)
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		failures int
+		expected time.Duration
+	}{
+		{
+			name:     "with no failures",
+			interval: 10 * time.Second,
+			failures: 0,
+			expected: 10 * time.Second,
+		},
+		{
+			name:     "with two failures",
+			interval: 10 * time.Second,
+			failures: 2,
+			expected: 40 * time.Second,
+		},
+		{
+			name:     "with backoff past the maximum",
+			interval: 10 * time.Second,
+			failures: 6,
+			expected: 100 * time.Second,
+		},
+		{
+			name:     "with an interval longer than the maximum",
+			interval: 200 * time.Second,
+			failures: 1,
+			expected: 200 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, scheduler.nextDelay(tt.interval, tt.failures))
+		})
+	}
+}
+
+func Test_Scheduler_nextDelayWithJitter(t *testing.T) {
+	cfg, _ := config.ParseYaml("wtf:\n  scheduler:\n    jitter: 0.5")
+	scheduler := NewScheduler(cfg, nil)
+
+	for i := 0; i < 100; i++ {
+		delay := scheduler.nextDelay(10*time.Second, 0)
+
+		assert.True(t, delay >= 5*time.Second)
+		assert.True(t, delay < 15*time.Second)
+	}
+}
+
+func Test_Scheduler_TogglePause(t *testing.T) {
+	scheduler := NewScheduler(&config.Config{}, nil)
+
+	assert.False(t, scheduler.Paused())
+	assert.True(t, scheduler.TogglePause())
+	assert.True(t, scheduler.Paused())
+	assert.False(t, scheduler.TogglePause())
+}
+
+func Test_refreshHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		expected string
+	}{
+		{
+			name:     "with no host settings",
+			config:   enabled,
+			expected: "clocks",
+		},
+		{
+			name:     "with a URL setting",
+			config:   enabled + "\n      baseURL: \"https://github.example.com/api/v3\"",
+			expected: "github.example.com",
+		},
+		{
+			name:     "with an explicit refresh host",
+			config:   enabled + "\n      refreshHost: \"shared\"\n      domain: \"https://example.atlassian.net\"",
+			expected: "shared",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.ParseYaml(tt.config)
+			widget := MakeWidget(nil, nil, "clocks", cfg)
+
+			assert.Equal(t, tt.expected, refreshHost(widget))
+		})
+	}
+}
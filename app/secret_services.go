@@ -0,0 +1,29 @@
+package app
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+// SecretServices returns the services that the modules in the config load their secrets
+// from, whether or not they are enabled. Only the modules' settings are built, so no widgets
+// are created and no secrets are loaded
+func SecretServices(wtfConfig *config.Config) ([]cfg.SecretService, error) {
+	moduleNames, _ := wtfConfig.Map("wtf.mods")
+
+	services := cfg.CollectSecretServices(func() {
+		for moduleName := range moduleNames {
+			moduleConfig, err := wtfConfig.Get("wtf.mods." + moduleName)
+			if err != nil {
+				continue
+			}
+
+			moduleType := moduleConfig.UString("type", moduleName)
+			if newSettings, ok := moduleSettingsConstructors[moduleType]; ok {
+				newSettings(moduleName, moduleConfig, wtfConfig)
+			}
+		}
+	})
+
+	return services, nil
+}
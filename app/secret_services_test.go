@@ -0,0 +1,59 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+func Test_SecretServices(t *testing.T) {
+	wtfConfig, err := config.ParseYaml(`
+wtf:
+  mods:
+    circleci:
+      enabled: false
+      position: { top: 0, left: 0, height: 1, width: 1 }
+    clocks:
+      enabled: true
+      position: { top: 0, left: 1, height: 1, width: 1 }
+    github:
+      enabled: true
+      position: { top: 2, left: 0, height: 1, width: 1 }
+    pagerduty:
+      apiKey: def456
+      enabled: true
+      position: { top: 2, left: 1, height: 1, width: 1 }
+    tasks:
+      type: todoist
+      enabled: false
+      position: { top: 3, left: 0, height: 1, width: 1 }
+    trello:
+      enabled: true
+      position: { top: 3, left: 1, height: 1, width: 1 }
+    work_ci:
+      type: circleci
+      apiKey: abc123
+      enabled: true
+      position: { top: 1, left: 0, height: 1, width: 1 }
+`)
+	assert.NoError(t, err)
+
+	services, err := SecretServices(wtfConfig)
+	assert.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]cfg.SecretService{
+			{Module: "circleci", Service: "circleci"},
+			{Module: "github", Service: "github"},
+			{Configured: true, Module: "pagerduty", Service: "pagerduty"},
+			{Module: "tasks", Service: "tasks"},
+			{Module: "trello", Service: "trello"},
+			{Configured: true, Module: "work_ci", Service: "work_ci"},
+		},
+		services,
+	)
+	assert.False(t, wtfConfig.UBool("wtf.mods.circleci.enabled", true))
+}
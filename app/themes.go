@@ -0,0 +1,82 @@
+package app
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// nextTheme switches to the theme after the current one, in alphabetical order, wrapping
+// around after the last
+func (wtfApp *WtfApp) nextTheme() {
+	names := cfg.ThemeNames()
+	if len(names) == 0 {
+		return
+	}
+
+	current := wtfApp.config.UString("wtf.theme", cfg.DefaultThemeName)
+
+	next := names[0]
+	for idx, name := range names {
+		if name == current {
+			next = names[(idx+1)%len(names)]
+			break
+		}
+	}
+
+	wtfApp.switchToTheme(next)
+}
+
+// switchToTheme redraws every widget in the named theme. The choice lasts until the app exits
+// or the config file selects a different theme
+func (wtfApp *WtfApp) switchToTheme(name string) {
+	newConfig, err := wtfApp.config.Copy()
+	if err != nil {
+		return
+	}
+
+	wtfApp.themeMutex.Lock()
+	wtfApp.themeOverride = name
+	wtfApp.themeMutex.Unlock()
+
+	// Applying a config queues a redraw, which has to happen off the event loop
+	go wtfApp.applyConfig(newConfig)
+}
+
+// renderer is implemented by widgets that can redraw the data they last fetched, so that they
+// are redrawn in new colors without fetching their data, or adding samples to their charts,
+// again
+type renderer interface {
+	Render()
+}
+
+// recolorWidgets redraws the widgets, which were built from an earlier config, in the colors
+// of the config's theme. Must be called from within the app's event loop
+
+func (wtfApp *WtfApp) recolorWidgets(widgets []wtf.Wtfable, config *config.Config) {
+	for _, widget := range widgets {
+		settings := widget.CommonSettings()
+		settings.Colors = cfg.ColorsFromConfig(settings.Config, config)
+
+		widget.ApplyColors()
+	}
+}
+
+// themeColors returns the colors of the app's own dialogs. Must be called from within the
+// app's event loop
+func (wtfApp *WtfApp) themeColors() cfg.ColorTheme {
+	return cfg.ColorsFromConfig(nil, wtfApp.config)
+}
+
+// setBackgroundColor fills the space around the widgets with the widget background color
+func (wtfApp *WtfApp) setBackgroundColor() {
+	if len(wtfApp.widgets) == 0 {
+		return
+	}
+
+	wtfApp.pages.Box.SetBackgroundColor(
+		wtf.ColorFor(wtfApp.widgets[0].CommonSettings().Colors.WidgetTheme.Background),
+	)
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/wtfutil/wtf/modules/opsgenie"
 	"github.com/wtfutil/wtf/modules/pagerduty"
 	"github.com/wtfutil/wtf/modules/pihole"
+	"github.com/wtfutil/wtf/modules/plugin"
 	"github.com/wtfutil/wtf/modules/pocket"
 	"github.com/wtfutil/wtf/modules/power"
 	"github.com/wtfutil/wtf/modules/resourceusage"
@@ -100,233 +101,163 @@ func MakeWidget(
 		return nil
 	}
 
+	moduleType := moduleConfig.UString("type", moduleName)
+	settings := makeModuleSettings(moduleType, moduleName, moduleConfig, config)
+
 	// Always in alphabetical order
-	switch moduleConfig.UString("type", moduleName) {
+	switch moduleType {
 	case "arpansagovau":
-		settings := arpansagovau.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = arpansagovau.NewWidget(app, settings)
+		widget = arpansagovau.NewWidget(app, settings.(*arpansagovau.Settings))
 	case "azuredevops":
-		settings := azuredevops.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = azuredevops.NewWidget(app, pages, settings)
+		widget = azuredevops.NewWidget(app, pages, settings.(*azuredevops.Settings))
 	case "bamboohr":
-		settings := bamboohr.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = bamboohr.NewWidget(app, settings)
+		widget = bamboohr.NewWidget(app, settings.(*bamboohr.Settings))
 	case "bargraph":
-		settings := bargraph.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = bargraph.NewWidget(app, settings)
+		widget = bargraph.NewWidget(app, settings.(*bargraph.Settings))
 	case "bittrex":
-		settings := bittrex.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = bittrex.NewWidget(app, settings)
+		widget = bittrex.NewWidget(app, settings.(*bittrex.Settings))
 	case "blockfolio":
-		settings := blockfolio.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = blockfolio.NewWidget(app, settings)
+		widget = blockfolio.NewWidget(app, settings.(*blockfolio.Settings))
 	case "buildkite":
-		settings := buildkite.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = buildkite.NewWidget(app, pages, settings)
+		widget = buildkite.NewWidget(app, pages, settings.(*buildkite.Settings))
 	case "cdsFavorites":
-		settings := cdsfavorites.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = cdsfavorites.NewWidget(app, pages, settings)
+		widget = cdsfavorites.NewWidget(app, pages, settings.(*cdsfavorites.Settings))
 	case "cdsQueue":
-		settings := cdsqueue.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = cdsqueue.NewWidget(app, pages, settings)
+		widget = cdsqueue.NewWidget(app, pages, settings.(*cdsqueue.Settings))
 	case "cdsStatus":
-		settings := cdsstatus.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = cdsstatus.NewWidget(app, pages, settings)
+		widget = cdsstatus.NewWidget(app, pages, settings.(*cdsstatus.Settings))
 	case "circleci":
-		settings := circleci.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = circleci.NewWidget(app, settings)
+		widget = circleci.NewWidget(app, settings.(*circleci.Settings))
 	case "clocks":
-		settings := clocks.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = clocks.NewWidget(app, settings)
+		widget = clocks.NewWidget(app, settings.(*clocks.Settings))
 	case "cmdrunner":
-		settings := cmdrunner.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = cmdrunner.NewWidget(app, settings)
+		widget = cmdrunner.NewWidget(app, settings.(*cmdrunner.Settings))
 	case "cryptolive":
-		settings := cryptolive.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = cryptolive.NewWidget(app, settings)
+		widget = cryptolive.NewWidget(app, settings.(*cryptolive.Settings))
 	case "datadog":
-		settings := datadog.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = datadog.NewWidget(app, pages, settings)
+		widget = datadog.NewWidget(app, pages, settings.(*datadog.Settings))
 	case "devto":
-		settings := devto.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = devto.NewWidget(app, pages, settings)
+		widget = devto.NewWidget(app, pages, settings.(*devto.Settings))
 	case "digitalclock":
-		settings := digitalclock.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = digitalclock.NewWidget(app, settings)
+		widget = digitalclock.NewWidget(app, settings.(*digitalclock.Settings))
 	case "digitalocean":
-		settings := digitalocean.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = digitalocean.NewWidget(app, pages, settings)
+		widget = digitalocean.NewWidget(app, pages, settings.(*digitalocean.Settings))
 	case "docker":
-		settings := docker.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = docker.NewWidget(app, pages, settings)
+		widget = docker.NewWidget(app, pages, settings.(*docker.Settings))
 	case "feedreader":
-		settings := feedreader.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = feedreader.NewWidget(app, pages, settings)
+		widget = feedreader.NewWidget(app, pages, settings.(*feedreader.Settings))
 	case "football":
-		settings := football.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = football.NewWidget(app, pages, settings)
+		widget = football.NewWidget(app, pages, settings.(*football.Settings))
 	case "gcal":
-		settings := gcal.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gcal.NewWidget(app, pages, settings)
+		widget = gcal.NewWidget(app, pages, settings.(*gcal.Settings))
 	case "gerrit":
-		settings := gerrit.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gerrit.NewWidget(app, pages, settings)
+		widget = gerrit.NewWidget(app, pages, settings.(*gerrit.Settings))
 	case "git":
-		settings := git.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = git.NewWidget(app, pages, settings)
+		widget = git.NewWidget(app, pages, settings.(*git.Settings))
 	case "github":
-		settings := github.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = github.NewWidget(app, pages, settings)
+		widget = github.NewWidget(app, pages, settings.(*github.Settings))
 	case "gitlab":
-		settings := gitlab.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gitlab.NewWidget(app, pages, settings)
+		widget = gitlab.NewWidget(app, pages, settings.(*gitlab.Settings))
 	case "gitlabtodo":
-		settings := gitlabtodo.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gitlabtodo.NewWidget(app, pages, settings)
+		widget = gitlabtodo.NewWidget(app, pages, settings.(*gitlabtodo.Settings))
 	case "gitter":
-		settings := gitter.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gitter.NewWidget(app, pages, settings)
+		widget = gitter.NewWidget(app, pages, settings.(*gitter.Settings))
 	case "gmail":
-		settings := gmail.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gmail.NewWidget(app, pages, settings)
+		widget = gmail.NewWidget(app, pages, settings.(*gmail.Settings))
 	case "googleanalytics":
-		settings := googleanalytics.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = googleanalytics.NewWidget(app, settings)
+		widget = googleanalytics.NewWidget(app, settings.(*googleanalytics.Settings))
 	case "gspreadsheets":
-		settings := gspreadsheets.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = gspreadsheets.NewWidget(app, settings)
+		widget = gspreadsheets.NewWidget(app, settings.(*gspreadsheets.Settings))
 	case "grafana":
-		settings := grafana.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = grafana.NewWidget(app, pages, settings)
+		widget = grafana.NewWidget(app, pages, settings.(*grafana.Settings))
 	case "hackernews":
-		settings := hackernews.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = hackernews.NewWidget(app, pages, settings)
+		widget = hackernews.NewWidget(app, pages, settings.(*hackernews.Settings))
 	case "hibp":
-		settings := hibp.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = hibp.NewWidget(app, settings)
+		widget = hibp.NewWidget(app, settings.(*hibp.Settings))
 	case "ipapi":
-		settings := ipapi.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = ipapi.NewWidget(app, settings)
+		widget = ipapi.NewWidget(app, settings.(*ipapi.Settings))
 	case "ipinfo":
-		settings := ipinfo.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = ipinfo.NewWidget(app, settings)
+		widget = ipinfo.NewWidget(app, settings.(*ipinfo.Settings))
 	case "jenkins":
-		settings := jenkins.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = jenkins.NewWidget(app, pages, settings)
+		widget = jenkins.NewWidget(app, pages, settings.(*jenkins.Settings))
 	case "jira":
-		settings := jira.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = jira.NewWidget(app, pages, settings)
+		widget = jira.NewWidget(app, pages, settings.(*jira.Settings))
 	case "kubernetes":
-		settings := kubernetes.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = kubernetes.NewWidget(app, settings)
+		widget = kubernetes.NewWidget(app, pages, settings.(*kubernetes.Settings))
 	case "logger":
-		settings := logger.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = logger.NewWidget(app, settings)
+		widget = logger.NewWidget(app, pages, settings.(*logger.Settings))
 	case "mercurial":
-		settings := mercurial.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = mercurial.NewWidget(app, pages, settings)
+		widget = mercurial.NewWidget(app, pages, settings.(*mercurial.Settings))
 	case "nbascore":
-		settings := nbascore.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = nbascore.NewWidget(app, pages, settings)
+		widget = nbascore.NewWidget(app, pages, settings.(*nbascore.Settings))
 	case "newrelic":
-		settings := newrelic.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = newrelic.NewWidget(app, pages, settings)
+		widget = newrelic.NewWidget(app, pages, settings.(*newrelic.Settings))
 	case "notes":
-		settings := notes.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = notes.NewWidget(app, pages, settings)
+		widget = notes.NewWidget(app, pages, settings.(*notes.Settings))
 	case "opsgenie":
-		settings := opsgenie.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = opsgenie.NewWidget(app, settings)
+		widget = opsgenie.NewWidget(app, settings.(*opsgenie.Settings))
 	case "pagerduty":
-		settings := pagerduty.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = pagerduty.NewWidget(app, settings)
+		widget = pagerduty.NewWidget(app, pages, settings.(*pagerduty.Settings))
 	case "pihole":
-		settings := pihole.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = pihole.NewWidget(app, pages, settings)
+		widget = pihole.NewWidget(app, pages, settings.(*pihole.Settings))
+	case "plugin":
+		widget = plugin.NewWidget(app, pages, settings.(*plugin.Settings))
 	case "power":
-		settings := power.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = power.NewWidget(app, settings)
+		widget = power.NewWidget(app, settings.(*power.Settings))
 	case "prettyweather":
-		settings := prettyweather.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = prettyweather.NewWidget(app, settings)
+		widget = prettyweather.NewWidget(app, settings.(*prettyweather.Settings))
 	case "pocket":
-		settings := pocket.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = pocket.NewWidget(app, pages, settings)
+		widget = pocket.NewWidget(app, pages, settings.(*pocket.Settings))
 	case "resourceusage":
-		settings := resourceusage.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = resourceusage.NewWidget(app, settings)
+		widget = resourceusage.NewWidget(app, settings.(*resourceusage.Settings))
 	case "rollbar":
-		settings := rollbar.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = rollbar.NewWidget(app, pages, settings)
+		widget = rollbar.NewWidget(app, pages, settings.(*rollbar.Settings))
 	case "security":
-		settings := security.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = security.NewWidget(app, settings)
+		widget = security.NewWidget(app, settings.(*security.Settings))
 	case "spacex":
-		settings := spacex.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = spacex.NewWidget(app, settings)
+		widget = spacex.NewWidget(app, settings.(*spacex.Settings))
 	case "spotify":
-		settings := spotify.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = spotify.NewWidget(app, pages, settings)
+		widget = spotify.NewWidget(app, pages, settings.(*spotify.Settings))
 	case "spotifyweb":
-		settings := spotifyweb.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = spotifyweb.NewWidget(app, pages, settings)
+		widget = spotifyweb.NewWidget(app, pages, settings.(*spotifyweb.Settings))
 	case "status":
-		settings := status.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = status.NewWidget(app, settings)
+		widget = status.NewWidget(app, settings.(*status.Settings))
 	case "subreddit":
-		settings := subreddit.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = subreddit.NewWidget(app, pages, settings)
+		widget = subreddit.NewWidget(app, pages, settings.(*subreddit.Settings))
 	case "textfile":
-		settings := textfile.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = textfile.NewWidget(app, pages, settings)
+		widget = textfile.NewWidget(app, pages, settings.(*textfile.Settings))
 	case "todo":
-		settings := todo.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = todo.NewWidget(app, pages, settings)
+		widget = todo.NewWidget(app, pages, settings.(*todo.Settings))
 	case "todo_plus":
-		settings := todo_plus.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = todo_plus.NewWidget(app, pages, settings)
+		widget = todo_plus.NewWidget(app, pages, settings.(*todo_plus.Settings))
 	case "todoist":
-		settings := todo_plus.FromTodoist(moduleName, moduleConfig, config)
-		widget = todo_plus.NewWidget(app, pages, settings)
+		widget = todo_plus.NewWidget(app, pages, settings.(*todo_plus.Settings))
 	case "transmission":
-		settings := transmission.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = transmission.NewWidget(app, pages, settings)
+		widget = transmission.NewWidget(app, pages, settings.(*transmission.Settings))
 	case "travisci":
-		settings := travisci.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = travisci.NewWidget(app, pages, settings)
+		widget = travisci.NewWidget(app, pages, settings.(*travisci.Settings))
 	case "trello":
-		settings := todo_plus.FromTrello(moduleName, moduleConfig, config)
-		widget = todo_plus.NewWidget(app, pages, settings)
+		widget = todo_plus.NewWidget(app, pages, settings.(*todo_plus.Settings))
 	case "twitch":
-		settings := twitch.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = twitch.NewWidget(app, pages, settings)
+		widget = twitch.NewWidget(app, pages, settings.(*twitch.Settings))
 	case "twitter":
-		settings := twitter.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = twitter.NewWidget(app, pages, settings)
+		widget = twitter.NewWidget(app, pages, settings.(*twitter.Settings))
 	case "twitterstats":
-		settings := twitterstats.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = twitterstats.NewWidget(app, pages, settings)
+		widget = twitterstats.NewWidget(app, pages, settings.(*twitterstats.Settings))
 	case "uptimerobot":
-		settings := uptimerobot.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = uptimerobot.NewWidget(app, pages, settings)
+		widget = uptimerobot.NewWidget(app, pages, settings.(*uptimerobot.Settings))
 	case "victorops":
-		settings := victorops.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = victorops.NewWidget(app, settings)
+		widget = victorops.NewWidget(app, settings.(*victorops.Settings))
 	case "weather":
-		settings := weather.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = weather.NewWidget(app, pages, settings)
+		widget = weather.NewWidget(app, pages, settings.(*weather.Settings))
 	case "zendesk":
-		settings := zendesk.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = zendesk.NewWidget(app, pages, settings)
+		widget = zendesk.NewWidget(app, pages, settings.(*zendesk.Settings))
 	case "exchangerates":
-		settings := exchangerates.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = exchangerates.NewWidget(app, pages, settings)
+		widget = exchangerates.NewWidget(app, pages, settings.(*exchangerates.Settings))
 	case "finnhub":
-		settings := finnhub.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = finnhub.NewWidget(app, settings)
+		widget = finnhub.NewWidget(app, settings.(*finnhub.Settings))
 	default:
-		settings := unknown.NewSettingsFromYAML(moduleName, moduleConfig, config)
-		widget = unknown.NewWidget(app, settings)
+		widget = unknown.NewWidget(app, settings.(*unknown.Settings))
 	}
 
 	return widget
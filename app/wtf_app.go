@@ -1,7 +1,9 @@
 package app
 
 import (
-	"log"
+	"fmt"
+	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/gdamore/tcell"
@@ -12,21 +14,45 @@ import (
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/support"
 	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
 	"github.com/wtfutil/wtf/wtf"
 )
 
+// staleCheckInterval is how often the widgets are checked for stale data
+const staleCheckInterval = 5 * time.Second
+
 // WtfApp is the container for a collection of widgets that are all constructed from a single
 // configuration file and displayed together
 type WtfApp struct {
+	apiServer      *APIServer
 	app            *tview.Application
+	bellPending    bool
 	config         *config.Config
 	configFilePath string
-	display        *Display
+	configMutex    *sync.Mutex
+	configTheme    string
+	configWatch    *watcher.Watcher
+	configWatched  map[string]bool
+	includeDirs    map[string]bool
 	ghUser         *support.GitHubUser
-	focusTracker   FocusTracker
+	keymap         *view.Keymap
+	lastClick      mouseClick
+	layoutIdx      int
+	layouts        []*Layout
+	metricsServer  *MetricsServer
+	mouseButtons   tcell.ButtonMask
 	pages          *tview.Pages
+	passThrough    map[string]bool
+	profile        bool
+	scheduler      *Scheduler
+	serversMutex   *sync.Mutex
+	termHeight     int
+	termWidth      int
+	themeMutex     *sync.Mutex
+	themeOverride  string
 	validator      *ModuleValidator
 	widgets        []wtf.Wtfable
+	zoomed         wtf.Wtfable
 }
 
 // NewWtfApp creates and returns an instance of WtfApp
@@ -35,58 +61,341 @@ func NewWtfApp(app *tview.Application, config *config.Config, configFilePath str
 		app:            app,
 		config:         config,
 		configFilePath: configFilePath,
+		configMutex:    &sync.Mutex{},
+		configTheme:    config.UString("wtf.theme", cfg.DefaultThemeName),
 		pages:          tview.NewPages(),
+		serversMutex:   &sync.Mutex{},
+		themeMutex:     &sync.Mutex{},
 	}
 
 	wtfApp.app.SetBeforeDrawFunc(func(s tcell.Screen) bool {
 		s.Clear()
+
+		if wtfApp.bellPending {
+			wtfApp.bellPending = false
+			_ = s.Beep()
+		}
+
+		// Layouts can't be rearranged while the app is drawing, so that happens afterwards
+		if width, height := s.Size(); width != wtfApp.termWidth || height != wtfApp.termHeight {
+			wtfApp.termWidth, wtfApp.termHeight = width, height
+			go wtfApp.app.QueueUpdateDraw(func() { wtfApp.resize(width, height) })
+		}
+
 		return false
 	})
 
 	wtfApp.app.SetInputCapture(wtfApp.keyboardIntercept)
 
 	wtfApp.widgets = MakeWidgets(wtfApp.app, wtfApp.pages, wtfApp.config)
-	wtfApp.display = NewDisplay(wtfApp.widgets, wtfApp.config)
-	wtfApp.focusTracker = NewFocusTracker(wtfApp.app, wtfApp.widgets, wtfApp.config)
+	wtfApp.scheduler = NewScheduler(wtfApp.config, wtfApp.isPaused)
 
 	githubAPIKey := readGitHubAPIKey(wtfApp.config)
 	wtfApp.ghUser = support.NewGitHubUser(githubAPIKey)
 
 	wtfApp.validator = NewModuleValidator()
 
-	wtfApp.pages.AddPage("grid", wtfApp.display.Grid, true, true)
+	wtfApp.setUpNotifications()
+
+	wtfApp.buildLayouts(wtfApp.config.UString("wtf.layout", defaultLayoutName))
+
 	wtfApp.app.SetRoot(wtfApp.pages, true)
 
 	wtfApp.validator.Validate(wtfApp.widgets)
 
-	firstWidget := wtfApp.widgets[0]
-	wtfApp.pages.Box.SetBackgroundColor(
-		wtf.ColorFor(
-			firstWidget.CommonSettings().Colors.WidgetTheme.Background,
-		),
-	)
+	keymap, passThrough, keyValidations := wtfApp.buildKeymap(wtfApp.config)
+	wtfApp.validator.ValidateGlobalKeys(keyValidations)
+	wtfApp.keymap = keymap
+	wtfApp.passThrough = passThrough
+
+	wtfApp.setBackgroundColor()
 
 	return &wtfApp
 }
 
 /* -------------------- Exported Functions -------------------- */
 
+// EnableProfiling serves the memory usage metrics, as if wtf.metrics.enabled were set, for as
+// long as the app runs, whatever the config file is changed to. It must be called before Start
+func (wtfApp *WtfApp) EnableProfiling() {
+	wtfApp.profile = true
+	_ = wtfApp.config.Set("wtf.metrics.enabled", true)
+}
+
 // Start initializes the app
 func (wtfApp *WtfApp) Start() {
+	wtfApp.warnOfDeprecations()
+
 	go wtfApp.scheduleWidgets()
 
 	go wtfApp.watchForConfigChanges()
 
+	go wtfApp.watchForStaleWidgets()
+
 	go func() { _ = wtfApp.ghUser.Load() }()
+
+	wtfApp.startAPIServer(wtfApp.config)
+
+	wtfApp.startMetricsServer(wtfApp.config)
 }
 
 // Stop kills all the currently-running widgets in this app
 func (wtfApp *WtfApp) Stop() {
 	wtfApp.stopAllWidgets()
+
+	wtfApp.serversMutex.Lock()
+	defer wtfApp.serversMutex.Unlock()
+
+	if wtfApp.apiServer != nil {
+		wtfApp.apiServer.Stop()
+	}
+
+	if wtfApp.metricsServer != nil {
+		wtfApp.metricsServer.Stop()
+	}
+}
+
+// FocusWidget gives onscreen focus to the widget, switching to a layout that displays it
+// if the current one does not. Returns FALSE if the widget cannot be focused
+func (wtfApp *WtfApp) FocusWidget(widget wtf.Wtfable) bool {
+	result := make(chan bool)
+
+	wtfApp.app.QueueUpdateDraw(func() {
+		result <- wtfApp.focusWidget(widget)
+	})
+
+	return <-result
+}
+
+// CurrentLayout returns the layout that is currently being displayed
+func (wtfApp *WtfApp) CurrentLayout() *Layout {
+	return wtfApp.layouts[wtfApp.layoutIdx]
+}
+
+// NextLayout displays the next layout in the layout list. If the current layout is the
+// last layout it wraps around to the first layout
+func (wtfApp *WtfApp) NextLayout() {
+	wtfApp.changeLayout((wtfApp.layoutIdx + 1) % len(wtfApp.layouts))
+}
+
+// SwitchToLayout displays the layout with the given name. Returns FALSE if no layout
+// with that name exists
+func (wtfApp *WtfApp) SwitchToLayout(name string) bool {
+	idx := wtfApp.layoutIndexFor(name)
+	if wtfApp.layouts[idx].Name != name {
+		return false
+	}
+
+	wtfApp.changeLayout(idx)
+
+	return true
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
+// buildLayouts replaces any existing layouts with new ones built from the current widgets
+// and config, and displays the layout with the given name
+func (wtfApp *WtfApp) buildLayouts(layoutName string) {
+	wtfApp.zoomed = nil
+	wtfApp.pages.RemovePage(zoomPage)
+
+	for _, layout := range wtfApp.layouts {
+		layout.FocusTracker.None()
+		wtfApp.pages.RemovePage(layout.PageName())
+	}
+
+	wtfApp.layouts = MakeLayouts(wtfApp.app, wtfApp.widgets, wtfApp.config)
+
+	for _, layout := range wtfApp.layouts {
+		wtfApp.pages.AddPage(layout.PageName(), layout.Display.Grid, true, false)
+	}
+
+	wtfApp.layoutIdx = wtfApp.layoutIndexFor(layoutName)
+	wtfApp.pages.SwitchToPage(wtfApp.CurrentLayout().PageName())
+
+	// Until the app is first drawn the terminal size isn't known
+	if wtfApp.termWidth > 0 {
+		wtfApp.resize(wtfApp.termWidth, wtfApp.termHeight)
+	}
+}
+
+// changeLayout displays the layout at idx and refreshes any of its widgets that were
+// paused while they were hidden
+func (wtfApp *WtfApp) changeLayout(idx int) {
+	paused := []wtf.Wtfable{}
+	for _, widget := range wtfApp.layouts[idx].Widgets {
+		if wtfApp.isPaused(widget) {
+			paused = append(paused, widget)
+		}
+	}
+
+	wtfApp.switchToLayout(idx)
+
+	for _, widget := range paused {
+		if widget.Enabled() {
+			go wtfApp.scheduler.Refresh(widget)
+		}
+	}
+}
+
+// currentWidgets returns the app's widgets. Safe to call from outside the app's event loop
+func (wtfApp *WtfApp) currentWidgets() []wtf.Wtfable {
+	result := make(chan []wtf.Wtfable)
+
+	wtfApp.app.QueueUpdate(func() {
+		result <- wtfApp.widgets
+	})
+
+	return <-result
+}
+
+// focusWidget gives onscreen focus to the widget. Must be called from within the app's
+// event loop
+func (wtfApp *WtfApp) focusWidget(widget wtf.Wtfable) bool {
+	if !wtfApp.CurrentLayout().Contains(widget) {
+		for idx, layout := range wtfApp.layouts {
+			if layout.Contains(widget) {
+				wtfApp.changeLayout(idx)
+				break
+			}
+		}
+	}
+
+	focused := wtfApp.CurrentLayout().FocusTracker.FocusWidget(widget)
+	wtfApp.followZoom()
+
+	return focused
+}
+
+// isPaused returns TRUE if the widget should not be refreshed because it is not on the
+// visible layout and every layout that displays it pauses its widgets when hidden
+func (wtfApp *WtfApp) isPaused(widget wtf.Wtfable) bool {
+	if wtfApp.CurrentLayout().Contains(widget) {
+		return false
+	}
+
+	for _, layout := range wtfApp.layouts {
+		if layout.Contains(widget) && !layout.PauseWhenHidden {
+			return false
+		}
+	}
+
+	return true
+}
+
+// layoutIndexFor returns the index of the layout with the given name, or zero if there
+// is no layout with that name
+func (wtfApp *WtfApp) layoutIndexFor(name string) int {
+	for idx, layout := range wtfApp.layouts {
+		if layout.Name == name {
+			return idx
+		}
+	}
+
+	return 0
+}
+
+// resize rearranges every layout using the breakpoint that fits a terminal of the given size.
+// Focus stays on the focused widget unless it is no longer displayed. Must be called from
+// within the app's event loop
+func (wtfApp *WtfApp) resize(width, height int) {
+	for _, layout := range wtfApp.layouts {
+		focused := layout.FocusTracker.FocusedWidget()
+		layout.FocusTracker.None()
+
+		layout.Display.Resize(width, height)
+
+		if focused != nil {
+			layout.FocusTracker.FocusWidget(focused)
+		}
+	}
+
+	wtfApp.followZoom()
+}
+
+// widgetNamed returns the widget with the given name, or nil if there is none
+func (wtfApp *WtfApp) widgetNamed(name string) wtf.Wtfable {
+	for _, widget := range wtfApp.currentWidgets() {
+		if widget.Name() == name {
+			return widget
+		}
+	}
+
+	return nil
+}
+
+func (wtfApp *WtfApp) switchToLayout(idx int) {
+	wtfApp.zoomed = nil
+	wtfApp.pages.RemovePage(zoomPage)
+
+	wtfApp.CurrentLayout().FocusTracker.None()
+
+	wtfApp.layoutIdx = idx
+	wtfApp.pages.SwitchToPage(wtfApp.CurrentLayout().PageName())
+}
+
+// startAPIServer starts the API server, if the config enables it, in place of any that is
+// already running. Its address is taken before this returns, and requests are served in
+// the background
+func (wtfApp *WtfApp) startAPIServer(config *config.Config) {
+	wtfApp.serversMutex.Lock()
+	defer wtfApp.serversMutex.Unlock()
+
+	if wtfApp.apiServer != nil {
+		wtfApp.apiServer.Stop()
+		wtfApp.apiServer = nil
+	}
+
+	apiServer, err := NewAPIServer(wtfApp, config)
+	if err != nil {
+		wtfApp.displayConfigError(fmt.Sprintf("Could not start the API server\n\n%s", err.Error()))
+		return
+	}
+
+	if apiServer == nil {
+		return
+	}
+
+	wtfApp.apiServer = apiServer
+
+	go func() {
+		if err := apiServer.Serve(); err != nil {
+			wtfApp.displayConfigError(fmt.Sprintf("The API server stopped unexpectedly\n\n%s", err.Error()))
+		}
+	}()
+}
+
+// startMetricsServer starts the metrics server, if the config enables it, in place of any
+// that is already running. Its address is taken before this returns, and scrapes are served
+// in the background
+func (wtfApp *WtfApp) startMetricsServer(config *config.Config) {
+	wtfApp.serversMutex.Lock()
+	defer wtfApp.serversMutex.Unlock()
+
+	if wtfApp.metricsServer != nil {
+		wtfApp.metricsServer.Stop()
+		wtfApp.metricsServer = nil
+	}
+
+	metricsServer, err := NewMetricsServer(wtfApp.scheduler.Metrics(), config)
+	if err != nil {
+		wtfApp.displayConfigError(fmt.Sprintf("Could not start the metrics server\n\n%s", err.Error()))
+		return
+	}
+
+	if metricsServer == nil {
+		return
+	}
+
+	wtfApp.metricsServer = metricsServer
+
+	go func() {
+		if err := metricsServer.Serve(); err != nil {
+			wtfApp.displayConfigError(fmt.Sprintf("The metrics server stopped unexpectedly\n\n%s", err.Error()))
+		}
+	}()
+}
+
 func (wtfApp *WtfApp) stopAllWidgets() {
 	for _, widget := range wtfApp.widgets {
 		widget.Stop()
@@ -94,34 +403,58 @@ func (wtfApp *WtfApp) stopAllWidgets() {
 }
 
 func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
+	// The command palette takes all typed input while it is open
+	if wtfApp.pages.HasPage(commandPalettePage) && event.Key() != tcell.KeyCtrlC {
+		return event
+	}
+
+	focusTracker := &wtfApp.CurrentLayout().FocusTracker
+
+	// Chords are only started from the board itself, so that a focused widget or a modal's
+	// input fields still receive the keys that chords begin with
+	if focusTracker.IsFocused || wtfApp.modalDisplayed() {
+		if !wtfApp.keymap.Pending() && wtfApp.keymap.IsPrefix(view.EventKeyName(event)) {
+			return wtfApp.dispatchKey(event)
+		}
+	}
+
 	// These keys are global keys used by the app. Widgets should not implement these keys
-	switch event.Key() {
-	case tcell.KeyCtrlC:
-		wtfApp.Stop()
-		wtfApp.app.Stop()
-		wtfApp.DisplayExitMessage()
-	case tcell.KeyCtrlR:
-		wtfApp.refreshAllWidgets()
-		return nil
-	case tcell.KeyTab:
-		wtfApp.focusTracker.Next()
-	case tcell.KeyBacktab:
-		wtfApp.focusTracker.Prev()
-		return nil
-	case tcell.KeyEsc:
-		wtfApp.focusTracker.None()
+	sequence, handled, abandoned := wtfApp.keymap.Handle(event)
+
+	for _, held := range abandoned {
+		wtfApp.replayKey(held)
 	}
 
+	if handled {
+		wtfApp.followZoom()
+
+		if !wtfApp.passThrough[sequence] {
+			return nil
+		}
+	}
+
+	return wtfApp.dispatchKey(event)
+}
+
+// dispatchKey handles the key presses that are not global key bindings, returning the event
+// if it should continue on to the focused widget or modal
+func (wtfApp *WtfApp) dispatchKey(event *tcell.EventKey) *tcell.EventKey {
+	focusTracker := &wtfApp.CurrentLayout().FocusTracker
+
 	// Checks to see if any widget has been assigned the pressed key as its focus key
-	if wtfApp.focusTracker.FocusOn(string(event.Rune())) {
+	if focusTracker.FocusOn(string(event.Rune())) {
+		wtfApp.followZoom()
 		return nil
 	}
 
 	// If no specific widget has focus, then allow the key presses to fall through to the app
-	if !wtfApp.focusTracker.IsFocused {
+	if !focusTracker.IsFocused {
 		switch string(event.Rune()) {
 		case "/":
 			return nil
+		case ":":
+			wtfApp.showCommandPalette()
+			return nil
 		default:
 		}
 	}
@@ -129,56 +462,148 @@ func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
 	return event
 }
 
+// replayKey passes on a key press that was held back as the start of a chord which was then
+// abandoned, as if the chord had never been started
+func (wtfApp *WtfApp) replayKey(event *tcell.EventKey) {
+	if event = wtfApp.dispatchKey(event); event == nil {
+		return
+	}
+
+	focused := wtfApp.app.GetFocus()
+	if focused == nil {
+		return
+	}
+
+	if handler := focused.InputHandler(); handler != nil {
+		handler(event, func(primitive tview.Primitive) { wtfApp.app.SetFocus(primitive) })
+	}
+}
+
+// modalDisplayed returns TRUE if a modal dialog is displayed over the widgets
+func (wtfApp *WtfApp) modalDisplayed() bool {
+	frontPage, _ := wtfApp.pages.GetFrontPage()
+	return frontPage != wtfApp.CurrentLayout().PageName() && frontPage != zoomPage
+}
+
 func (wtfApp *WtfApp) refreshAllWidgets() {
 	for _, widget := range wtfApp.widgets {
-		go widget.Refresh()
+		go wtfApp.scheduler.Refresh(widget)
 	}
 }
 
 func (wtfApp *WtfApp) scheduleWidgets() {
-	for _, widget := range wtfApp.widgets {
-		go Schedule(widget)
+	wtfApp.schedule(wtfApp.widgets)
+}
+
+func (wtfApp *WtfApp) schedule(widgets []wtf.Wtfable) {
+	for _, widget := range widgets {
+		go wtfApp.scheduler.Schedule(widget)
 	}
 }
 
 func (wtfApp *WtfApp) watchForConfigChanges() {
 	watch := watcher.New()
 
-	// Notify write events
-	watch.FilterOps(watcher.Write)
+	// Notify write events, and files being added to or removed from the include dirs
+	watch.FilterOps(watcher.Write, watcher.Create, watcher.Remove, watcher.Rename, watcher.Move)
 
 	go func() {
 		for {
 			select {
-			case <-watch.Event:
-				wtfApp.Stop()
-
-				config := cfg.LoadWtfConfigFile(wtfApp.configFilePath)
-				newApp := NewWtfApp(wtfApp.app, config, wtfApp.configFilePath)
-				openURLUtil := utils.ToStrs(config.UList("wtf.openUrlUtil", []interface{}{}))
-				utils.Init(config.UString("wtf.openFileUtil", "open"), openURLUtil)
-
-				newApp.Start()
+			case event := <-watch.Event:
+				if event.Op != watcher.Write && !wtfApp.includeDirs[filepath.Dir(event.Path)] {
+					continue
+				}
+				wtfApp.reloadConfig()
 			case err := <-watch.Error:
 				if err == watcher.ErrWatchedFileDeleted {
 					// Usually happens because the watcher looks for the file as the OS is updating it
 					continue
 				}
-				log.Fatalln(err)
+				wtfApp.displayConfigError(err.Error())
 			case <-watch.Closed:
 				return
 			}
 		}
 	}()
 
-	// Watch config file for changes.
+	// Watch the config file, and every file it includes, for changes
 	absPath, _ := utils.ExpandHomeDir(wtfApp.configFilePath)
 	if err := watch.Add(absPath); err != nil {
-		log.Fatalln(err)
+		wtfApp.displayConfigError(err.Error())
+		return
+	}
+
+	wtfApp.configWatch = watch
+	wtfApp.configWatched = map[string]bool{absPath: true}
+
+	if _, sources, err := cfg.ParseWtfConfigSources(wtfApp.configFilePath); err == nil {
+		wtfApp.watchConfigSources(sources)
 	}
 
 	// Start the watching process - it'll check for changes every 100ms.
 	if err := watch.Start(time.Millisecond * 100); err != nil {
-		log.Fatalln(err)
+		wtfApp.displayConfigError(err.Error())
+	}
+}
+
+// watchConfigSources changes the paths watched for config changes to the config's sources, so
+// that files newly included by the config are watched and those no longer included are not.
+// The directories searched by include globs are watched too, so that adding a file to one of
+// them reloads the config
+func (wtfApp *WtfApp) watchConfigSources(sources cfg.ConfigSources) {
+	if wtfApp.configWatch == nil {
+		return
+	}
+
+	wtfApp.includeDirs = map[string]bool{}
+	for _, dir := range sources.IncludeDirs {
+		wtfApp.includeDirs[dir] = true
+	}
+
+	paths := append(append([]string{}, sources.Files...), sources.IncludeDirs...)
+
+	watched := map[string]bool{}
+	for _, path := range paths {
+		watched[path] = true
+	}
+
+	// Anything that is no longer part of the config is removed first, since removing a
+	// directory also stops watching the files in it
+	for path := range wtfApp.configWatched {
+		if !watched[path] {
+			_ = wtfApp.configWatch.Remove(path)
+		}
+	}
+
+	for _, path := range paths {
+		if err := wtfApp.configWatch.Add(path); err != nil {
+			wtfApp.displayConfigError(err.Error())
+		}
+	}
+
+	wtfApp.configWatched = watched
+}
+
+// watchForStaleWidgets periodically checks whether any widget's data has gone stale, or has
+// been updated since it went stale, and redraws its title to reflect the change
+func (wtfApp *WtfApp) watchForStaleWidgets() {
+	wasStale := map[wtf.Wtfable]bool{}
+
+	ticker := time.NewTicker(staleCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		isStale := map[wtf.Wtfable]bool{}
+
+		for _, widget := range wtfApp.currentWidgets() {
+			isStale[widget] = widget.Enabled() && widget.Stale()
+
+			if isStale[widget] != wasStale[widget] {
+				widget.RedrawTitle()
+			}
+		}
+
+		wasStale = isStale
 	}
 }
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	zoomPage = "zoom"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// toggleZoom displays the focused widget full-screen, or restores the layout if a widget
+// is already zoomed. The zoomed widget keeps focus, so its keyboard controls still work
+func (wtfApp *WtfApp) toggleZoom() {
+	if wtfApp.zoomed != nil {
+		wtfApp.unzoom()
+		return
+	}
+
+	wtfApp.zoom(wtfApp.CurrentLayout().FocusTracker.FocusedWidget())
+}
+
+func (wtfApp *WtfApp) zoom(widget wtf.Wtfable) {
+	if widget == nil {
+		return
+	}
+
+	wtfApp.pages.RemovePage(zoomPage)
+	wtfApp.pages.AddPage(zoomPage, widget.TextView(), true, false)
+	wtfApp.pages.SwitchToPage(zoomPage)
+
+	wtfApp.zoomed = widget
+	wtfApp.app.SetFocus(widget.TextView())
+}
+
+// unzoom puts the zoomed widget back into the layout it came from
+func (wtfApp *WtfApp) unzoom() {
+	if wtfApp.zoomed == nil {
+		return
+	}
+
+	wtfApp.zoomed = nil
+
+	wtfApp.pages.RemovePage(zoomPage)
+	wtfApp.pages.SwitchToPage(wtfApp.CurrentLayout().PageName())
+	wtfApp.CurrentLayout().FocusTracker.Refocus()
+}
+
+// followZoom keeps the zoom on whichever widget has focus when the focus moves while a
+// widget is zoomed
+func (wtfApp *WtfApp) followZoom() {
+	if wtfApp.zoomed == nil {
+		return
+	}
+
+	focused := wtfApp.CurrentLayout().FocusTracker.FocusedWidget()
+	if focused == nil {
+		wtfApp.unzoom()
+		return
+	}
+
+	if focused != wtfApp.zoomed {
+		wtfApp.zoom(focused)
+	}
+}
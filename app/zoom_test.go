@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toggleZoom(t *testing.T) {
+	cfg, _ := config.ParseYaml(apiConfig)
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	assert.NoError(t, screen.Init())
+
+	tviewApp := tview.NewApplication()
+	tviewApp.SetScreen(screen)
+
+	wtfApp := NewWtfApp(tviewApp, cfg, "")
+	widget := wtfApp.widgets[0]
+
+	// Nothing is zoomed when no widget has focus
+	wtfApp.toggleZoom()
+	assert.Nil(t, wtfApp.zoomed)
+
+	wtfApp.focusWidget(widget)
+	wtfApp.toggleZoom()
+
+	frontPage, _ := wtfApp.pages.GetFrontPage()
+	assert.Equal(t, zoomPage, frontPage)
+	assert.Equal(t, widget, wtfApp.zoomed)
+	assert.True(t, widget.TextView().HasFocus())
+
+	wtfApp.unfocus()
+
+	frontPage, _ = wtfApp.pages.GetFrontPage()
+	assert.Equal(t, wtfApp.CurrentLayout().PageName(), frontPage)
+	assert.Nil(t, wtfApp.zoomed)
+	assert.True(t, widget.TextView().HasFocus())
+}
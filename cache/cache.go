@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	cacheDirName  = "cache"
+	cacheFileMode = 0600
+)
+
+var unsafeKeyChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// Cache persists data fetched by widgets to disk so that it can be displayed immediately the
+// next time the app starts, before the widgets have refreshed. Each entry is stored as a
+// JSON file named after its key
+type Cache struct {
+	dir string
+}
+
+type entry struct {
+	SavedAt time.Time       `json:"savedAt"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// NewCache creates and returns a Cache that stores its entries in the given directory
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// NewDefaultCache creates and returns a Cache that stores its entries in the cache directory
+// inside the WTF config directory
+func NewDefaultCache() (*Cache, error) {
+	configDir, err := cfg.WtfConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCache(filepath.Join(configDir, cacheDirName)), nil
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Load reads the entry for key into data. Entries older than ttl are ignored. A ttl of zero
+// or less never expires. Returns the time the entry was saved and TRUE if it was loaded
+func (cache *Cache) Load(key string, ttl time.Duration, data interface{}) (time.Time, bool) {
+	contents, err := ioutil.ReadFile(cache.filePath(key))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	cached := entry{}
+	if err := json.Unmarshal(contents, &cached); err != nil {
+		return time.Time{}, false
+	}
+
+	if ttl > 0 && time.Since(cached.SavedAt) > ttl {
+		return time.Time{}, false
+	}
+
+	if err := json.Unmarshal(cached.Data, data); err != nil {
+		return time.Time{}, false
+	}
+
+	return cached.SavedAt, true
+}
+
+// Save writes data to the entry for key, replacing whatever was there before
+func (cache *Cache) Save(key string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.Marshal(entry{SavedAt: time.Now(), Data: encoded})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(cache.dir, 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so a crash never leaves a half-written entry behind
+	tmpPath := cache.filePath(key) + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, contents, cacheFileMode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, cache.filePath(key))
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (cache *Cache) filePath(key string) string {
+	return filepath.Join(cache.dir, unsafeKeyChars.ReplaceAllString(key, "_")+".json")
+}
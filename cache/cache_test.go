@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testData struct {
+	Name  string
+	Count int
+}
+
+func Test_Cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wtf-cache")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cache := NewCache(dir)
+
+	tests := []struct {
+		name      string
+		key       string
+		save      bool
+		ttl       time.Duration
+		expectHit bool
+	}{
+		{
+			name:      "with no entry",
+			key:       "missing",
+			save:      false,
+			expectHit: false,
+		},
+		{
+			name:      "with a fresh entry",
+			key:       "jira",
+			save:      true,
+			ttl:       time.Hour,
+			expectHit: true,
+		},
+		{
+			name:      "with an expired entry",
+			key:       "pagerduty",
+			save:      true,
+			ttl:       time.Nanosecond,
+			expectHit: false,
+		},
+		{
+			name:      "with no ttl",
+			key:       "unsafe/key",
+			save:      true,
+			ttl:       0,
+			expectHit: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.save {
+				assert.NoError(t, cache.Save(tt.key, testData{Name: tt.key, Count: 3}))
+				time.Sleep(time.Millisecond)
+			}
+
+			loaded := testData{}
+			savedAt, hit := cache.Load(tt.key, tt.ttl, &loaded)
+
+			assert.Equal(t, tt.expectHit, hit)
+
+			if tt.expectHit {
+				assert.Equal(t, testData{Name: tt.key, Count: 3}, loaded)
+				assert.False(t, savedAt.IsZero())
+			}
+		})
+	}
+}
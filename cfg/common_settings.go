@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/rules"
 )
 
 type Module struct {
@@ -30,54 +31,27 @@ type Common struct {
 
 	Colors          ColorTheme
 	Bordered        bool   `help:"Whether or not the module should be displayed with a border." values:"true, false" optional:"true" default:"true"`
+	Cached          bool   `help:"Whether or not this module's data is saved to disk and displayed immediately on startup. Defaults to the global cache.enabled setting." values:"true, false" optional:"true" default:"false"`
+	CacheTTL        int    `help:"How old, in seconds, this module's saved data may be and still be displayed on startup. Defaults to the global cache.ttl setting." values:"A positive integer, 0..n." optional:"true" default:"86400"`
 	Enabled         bool   `help:"Whether or not this module is executed and if its data displayed onscreen." values:"true, false" optional:"true" default:"false"`
 	Focusable       bool   `help:"Whether or  not this module is focusable." values:"true, false" optional:"true" default:"false"`
 	RefreshInterval int    `help:"How often, in seconds, this module will update its data." values:"A positive integer, 0..n." optional:"true"`
+	StaleAfter      int    `help:"How many refresh intervals may pass without a successful refresh before this module's data is flagged as stale. 0 disables the check." values:"A positive integer, 0..n." optional:"true" default:"3"`
 	Title           string `help:"The title string to show when displaying this module" optional:"true"`
 	Config          *config.Config
 
+	Rules []*rules.Rule `help:"Conditions over the values this module exposes that change its border color or title badge, or raise a notification." optional:"true"`
+
 	focusChar int `help:"Define one of the number keys as a short cut key to access the widget." optional:"true"`
+
+	ruleValidations    []Validatable
+	settingValidations []Validatable
 }
 
 // NewCommonSettingsFromModule returns a common settings configuration tailed to the given module
 func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable bool, moduleConfig *config.Config, globalSettings *config.Config) *Common {
-	baseColors := NewDefaultColorTheme()
-
-	colorsConfig, err := globalSettings.Get("wtf.colors")
-	if err != nil && strings.Contains(err.Error(), "Nonexistent map") {
-		// Create a default colors config to fill in for the missing one
-		// This comes into play when the configuration file does not contain a `colors:` key, i.e:
-		//
-		//     wtf:
-		//       # colors:                <- missing
-		//       refreshInterval: 1
-		//       openFileUtil: "open"
-		//
-		colorsConfig, _ = NewDefaultColorConfig()
-	}
-
-	// And finally create a third instance to be the final default fallback in case there are empty or nil values in
-	// the colors extracted from the config file (aka colorsConfig)
-	defaultColorTheme := NewDefaultColorTheme()
-
-	baseColors.BorderTheme.Focusable = moduleConfig.UString("colors.border.focusable", colorsConfig.UString("border.focusable", defaultColorTheme.BorderTheme.Focusable))
-	baseColors.BorderTheme.Focused = moduleConfig.UString("colors.border.focused", colorsConfig.UString("border.focused", defaultColorTheme.BorderTheme.Focused))
-	baseColors.BorderTheme.Unfocusable = moduleConfig.UString("colors.border.normal", colorsConfig.UString("border.normal", defaultColorTheme.BorderTheme.Unfocusable))
-
-	baseColors.CheckboxTheme.Checked = moduleConfig.UString("colors.checked", colorsConfig.UString("checked", defaultColorTheme.CheckboxTheme.Checked))
-
-	baseColors.RowTheme.EvenForeground = moduleConfig.UString("colors.rows.even", colorsConfig.UString("rows.even", defaultColorTheme.RowTheme.EvenForeground))
-	baseColors.RowTheme.OddForeground = moduleConfig.UString("colors.rows.odd", colorsConfig.UString("rows.odd", defaultColorTheme.RowTheme.OddForeground))
-
-	baseColors.TextTheme.Label = moduleConfig.UString("colors.label", colorsConfig.UString("label", defaultColorTheme.TextTheme.Label))
-	baseColors.TextTheme.Subheading = moduleConfig.UString("colors.subheading", colorsConfig.UString("subheading", defaultColorTheme.TextTheme.Subheading))
-	baseColors.TextTheme.Text = moduleConfig.UString("colors.text", colorsConfig.UString("text", defaultColorTheme.TextTheme.Text))
-	baseColors.TextTheme.Title = moduleConfig.UString("colors.title", colorsConfig.UString("title", defaultColorTheme.TextTheme.Title))
-
-	baseColors.WidgetTheme.Background = moduleConfig.UString("colors.background", colorsConfig.UString("background", defaultColorTheme.WidgetTheme.Background))
-
 	common := Common{
-		Colors: baseColors,
+		Colors: ColorsFromConfig(moduleConfig, globalSettings),
 
 		Module: Module{
 			Name: name,
@@ -87,15 +61,24 @@ func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable boo
 		PositionSettings: NewPositionSettingsFromYAML(name, moduleConfig),
 
 		Bordered:        moduleConfig.UBool("border", true),
+		Cached:          moduleConfig.UBool("cache", globalSettings.UBool("wtf.cache.enabled", false)),
+		CacheTTL:        moduleConfig.UInt("cacheTTL", globalSettings.UInt("wtf.cache.ttl", 86400)),
 		Config:          moduleConfig,
 		Enabled:         moduleConfig.UBool("enabled", false),
 		Focusable:       moduleConfig.UBool("focusable", defaultFocusable),
 		RefreshInterval: moduleConfig.UInt("refreshInterval", 300),
+		StaleAfter:      moduleConfig.UInt("staleAfter", globalSettings.UInt("wtf.staleAfter", 3)),
 		Title:           moduleConfig.UString("title", defaultTitle),
 
 		focusChar: moduleConfig.UInt("focusChar", -1),
 	}
 
+	moduleRules, ruleErrs := rules.NewRulesFromYAML(moduleConfig)
+	common.Rules = moduleRules
+	for _, err := range ruleErrs {
+		common.ruleValidations = append(common.ruleValidations, newRuleValidation(err))
+	}
+
 	sigilsPath := "wtf.sigils"
 
 	common.Sigils.Checkbox.Checked = globalSettings.UString(sigilsPath+".checkbox.checked", "x")
@@ -159,7 +142,12 @@ func (common *Common) SigilStr(len, pos, width int) string {
 		sigils += common.Sigils.Paging.Selected
 		sigils += strings.Repeat(common.Sigils.Paging.Normal, len-1-pos)
 
-		sigils = "[lightblue]" + fmt.Sprintf(common.RightAlignFormat(width), sigils) + "[white]"
+		sigils = fmt.Sprintf(
+			"[%s]%s[%s]",
+			common.Colors.Label,
+			fmt.Sprintf(common.RightAlignFormat(width), sigils),
+			common.Colors.Text,
+		)
 	}
 
 	return sigils
@@ -176,3 +164,23 @@ func (common *Common) Validations() []Validatable {
 
 	return validatables
 }
+
+// RuleValidations returns the problems found with the module's rules
+func (common *Common) RuleValidations() []Validatable {
+	return common.ruleValidations
+}
+
+// SettingValidations returns the problems found with the values of the module's own settings
+func (common *Common) SettingValidations() []Validatable {
+	return common.settingValidations
+}
+
+// ValidateSetting records the problem with the value of the module's setting, if there is one,
+// so that it is reported along with the module's other configuration errors
+func (common *Common) ValidateSetting(key string, err error) {
+	if err == nil {
+		return
+	}
+
+	common.settingValidations = append(common.settingValidations, newSettingValidation(key, err))
+}
@@ -0,0 +1,388 @@
+package cfg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/logger"
+)
+
+// interpolationPattern matches the ${ENV_VAR} and ${file:path} references in config values.
+// $${...} escapes a reference so that it is left as-is, as is a reference to an unset env var
+var interpolationPattern = regexp.MustCompile(`\$?\$\{([^}]*)\}`)
+
+// configLoader builds a single config from a config file, the files it includes, and the
+// files and env vars its values refer to
+type configLoader struct {
+	dirs    []string
+	expand  bool
+	files   []string
+	loading map[string]bool
+	seen    map[string]bool
+}
+
+// ConfigSources are the paths a config was built from
+type ConfigSources struct {
+	// Files are the absolute paths of the config file, the files it includes, and the files
+	// its values refer to
+	Files []string
+
+	// IncludeDirs are the directories searched by the config's include globs. Files added to
+	// them can change the config
+	IncludeDirs []string
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// ParseWtfConfigFiles loads the specified config file the same way ParseWtfConfigFile does,
+// and also returns the absolute paths of every file the config was built from, starting with
+// the config file itself
+//
+// A config file can include other config files, which are merged into it. Values in the
+// including file take precedence. Paths are relative to the including file and can be globs:
+//
+//	wtf:
+//	  include:
+//	    - ~/src/team-dashboards/modules/*.yml
+//	    - personal.yml
+//
+// Any value can refer to an env var or to the contents of a file, whose path is relative to
+// the config file the value is in:
+//
+//	apiKey: "${JIRA_API_KEY}"
+//	password: "${file:~/.secrets/jira}"
+func ParseWtfConfigFiles(filePath string) (*config.Config, []string, error) {
+	config, sources, err := ParseWtfConfigSources(filePath)
+	return config, sources.Files, err
+}
+
+// ParseWtfConfigSources loads the specified config file the same way ParseWtfConfigFiles does,
+// and also returns every path the config was built from
+func ParseWtfConfigSources(filePath string) (*config.Config, ConfigSources, error) {
+	loader := newConfigLoader(true)
+
+	root, err := loader.loadFile(filePath)
+	if err != nil {
+		return nil, ConfigSources{}, err
+	}
+
+	sources := ConfigSources{
+		Files:       loader.files,
+		IncludeDirs: loader.dirs,
+	}
+
+	return &config.Config{Root: root}, sources, nil
+}
+
+// MergedWtfConfig loads the specified config file and merges in the files it includes. Unless
+// expand is TRUE, the env var and file references in its values are left as they are written,
+// so that the secrets they refer to are not revealed when the config is displayed
+func MergedWtfConfig(filePath string, expand bool) (*config.Config, error) {
+	root, err := newConfigLoader(expand).loadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config.Config{Root: root}, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func newConfigLoader(expand bool) *configLoader {
+	return &configLoader{
+		expand:  expand,
+		loading: map[string]bool{},
+		seen:    map[string]bool{},
+	}
+}
+
+// loadFile loads the config file, whose path may start with the home dir
+func (loader *configLoader) loadFile(filePath string) (map[string]interface{}, error) {
+	absPath, err := expandHomeDir(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return loader.load(absPath)
+}
+
+// load parses the config file, interpolates its values, and merges it over the files it
+// includes
+func (loader *configLoader) load(filePath string) (map[string]interface{}, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if loader.loading[absPath] {
+		return nil, fmt.Errorf("%s includes itself", absPath)
+	}
+	loader.loading[absPath] = true
+	defer delete(loader.loading, absPath)
+
+	loader.addFile(absPath)
+
+	parsed, err := config.ParseYamlFile(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%s: %w", absPath, err)
+	}
+
+	root, ok := parsed.Root.(map[string]interface{})
+	if !ok {
+		if parsed.Root != nil {
+			return nil, fmt.Errorf("%s: expected a map at the top level", absPath)
+		}
+		root = map[string]interface{}{}
+	}
+
+	dir := filepath.Dir(absPath)
+
+	if err := loader.interpolateConfig(root, dir); err != nil {
+		return nil, fmt.Errorf("%s: %w", absPath, err)
+	}
+
+	includes, err := loader.includePaths(root, dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", absPath, err)
+	}
+
+	if wtf, ok := root["wtf"].(map[string]interface{}); ok {
+		delete(wtf, "include")
+	}
+
+	merged := map[string]interface{}{}
+	for _, include := range includes {
+		included, err := loader.load(include)
+		if err != nil {
+			return nil, err
+		}
+		merged = mergeConfigTrees(merged, included)
+	}
+
+	return mergeConfigTrees(merged, root), nil
+}
+
+// addFile records that the config was built from the file
+func (loader *configLoader) addFile(absPath string) {
+	if loader.seen[absPath] {
+		return
+	}
+
+	loader.seen[absPath] = true
+	loader.files = append(loader.files, absPath)
+}
+
+// addDir records that files added to the dir can change the config
+func (loader *configLoader) addDir(absPath string) {
+	for _, dir := range loader.dirs {
+		if dir == absPath {
+			return
+		}
+	}
+
+	loader.dirs = append(loader.dirs, absPath)
+}
+
+// interpolateConfig replaces the env var and file references in the config's values. When
+// the loader is not expanding references, only those in wtf.include are replaced, since the
+// included files cannot be found without them
+func (loader *configLoader) interpolateConfig(root map[string]interface{}, dir string) error {
+	if loader.expand {
+		_, err := loader.interpolate(root, dir)
+		return err
+	}
+
+	wtf, ok := root["wtf"].(map[string]interface{})
+	if !ok || wtf["include"] == nil {
+		return nil
+	}
+
+	include, err := loader.interpolate(wtf["include"], dir)
+	if err != nil {
+		return err
+	}
+	wtf["include"] = include
+
+	return nil
+}
+
+// interpolate replaces the env var and file references in every string in the config tree
+func (loader *configLoader) interpolate(value interface{}, dir string) (interface{}, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			interpolated, err := loader.interpolate(item, dir)
+			if err != nil {
+				return nil, err
+			}
+			value[key] = interpolated
+		}
+		return value, nil
+	case []interface{}:
+		for idx, item := range value {
+			interpolated, err := loader.interpolate(item, dir)
+			if err != nil {
+				return nil, err
+			}
+			value[idx] = interpolated
+		}
+		return value, nil
+	case string:
+		return loader.interpolateString(value, dir)
+	default:
+		return value, nil
+	}
+}
+
+func (loader *configLoader) interpolateString(str string, dir string) (string, error) {
+	var err error
+
+	result := interpolationPattern.ReplaceAllStringFunc(str, func(match string) string {
+		if err != nil {
+			return match
+		}
+
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		reference := interpolationPattern.FindStringSubmatch(match)[1]
+
+		if strings.HasPrefix(reference, "file:") {
+			var contents string
+			contents, err = loader.readReferencedFile(strings.TrimPrefix(reference, "file:"), dir)
+			return contents
+		}
+
+		// Values such as shell snippets use ${...} for their own purposes, so a reference that
+		// isn't a set env var is left as it is written
+		value, ok := os.LookupEnv(reference)
+		if !ok {
+			logger.Warnf("", "Config value refers to %s, which is not a set env var, leaving it as is", match)
+			return match
+		}
+		return value
+	})
+
+	return result, err
+}
+
+// readReferencedFile returns the contents of the file, without a trailing newline
+func (loader *configLoader) readReferencedFile(path string, dir string) (string, error) {
+	absPath, err := resolveConfigPath(path, dir)
+	if err != nil {
+		return "", err
+	}
+
+	loader.addFile(absPath)
+
+	data, err := ioutil.ReadFile(filepath.Clean(absPath))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// includePaths returns the files matched by the config's wtf.include setting, in order, and
+// records the directories its globs search
+func (loader *configLoader) includePaths(root map[string]interface{}, dir string) ([]string, error) {
+	patterns := []string{}
+
+	wtf, _ := root["wtf"].(map[string]interface{})
+	switch include := wtf["include"].(type) {
+	case nil:
+	case string:
+		patterns = append(patterns, include)
+	case []interface{}:
+		for _, pattern := range include {
+			patterns = append(patterns, fmt.Sprint(pattern))
+		}
+	default:
+		return nil, fmt.Errorf("wtf.include must be a file or a list of files")
+	}
+
+	paths := []string{}
+
+	for _, pattern := range patterns {
+		absPattern, err := resolveConfigPath(pattern, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		matches, err := filepath.Glob(absPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid include %q: %w", pattern, err)
+		}
+
+		// A missing file is an error, but a glob is allowed to match nothing
+		isGlob := absPattern != globEscape(absPattern)
+		if len(matches) == 0 && !isGlob {
+			return nil, fmt.Errorf("included file %s does not exist", absPattern)
+		}
+
+		if isGlob {
+			dirs, _ := filepath.Glob(filepath.Dir(absPattern))
+			for _, globDir := range dirs {
+				loader.addDir(globDir)
+			}
+		}
+
+		sort.Strings(matches)
+		paths = append(paths, matches...)
+	}
+
+	return paths, nil
+}
+
+// resolveConfigPath expands the home dir in the path and makes it relative to dir
+func resolveConfigPath(path string, dir string) (string, error) {
+	absPath, err := expandHomeDir(path)
+	if err != nil {
+		return "", err
+	}
+
+	if !filepath.IsAbs(absPath) {
+		absPath = filepath.Join(dir, absPath)
+	}
+
+	return absPath, nil
+}
+
+// globEscape returns the path with no glob special characters. If it matches the path, the
+// path is not a glob
+func globEscape(path string) string {
+	return strings.NewReplacer("*", "", "?", "", "[", "").Replace(path)
+}
+
+// mergeConfigTrees returns the base config tree with the overlay's values merged on top.
+// Maps are merged key by key, while lists and other values in the overlay replace those in
+// the base
+func mergeConfigTrees(base, overlay map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base)+len(overlay))
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, value := range overlay {
+		baseMap, baseIsMap := merged[key].(map[string]interface{})
+		overlayMap, overlayIsMap := value.(map[string]interface{})
+
+		if baseIsMap && overlayIsMap {
+			merged[key] = mergeConfigTrees(baseMap, overlayMap)
+		} else {
+			merged[key] = value
+		}
+	}
+
+	return merged
+}
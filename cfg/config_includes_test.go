@@ -0,0 +1,217 @@
+package cfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfigFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "wtf-config")
+	assert.NoError(t, err)
+
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	}
+
+	return dir
+}
+
+func Test_ParseWtfConfigFiles(t *testing.T) {
+	os.Setenv("WTF_TEST_TOKEN", "abc123")
+	defer os.Unsetenv("WTF_TEST_TOKEN")
+
+	dir := writeConfigFiles(t, map[string]string{
+		"config.yml": `
+wtf:
+  include:
+    - team/*.yml
+    - personal.yml
+  refreshInterval: 5
+  mods:
+    jira:
+      refreshInterval: 60
+`,
+		"team/jira.yml": `
+wtf:
+  mods:
+    jira:
+      apiKey: "${WTF_TEST_TOKEN}"
+      password: "${file:../secret}"
+      refreshInterval: 30
+      projects: ["A", "B"]
+`,
+		"team/pagerduty.yml": `
+wtf:
+  mods:
+    pagerduty:
+      enabled: true
+`,
+		"personal.yml": `
+wtf:
+  mods:
+    clocks:
+      format: "$${literal}"
+`,
+		"secret": "hunter2\n",
+	})
+	defer os.RemoveAll(dir)
+
+	config, files, err := ParseWtfConfigFiles(filepath.Join(dir, "config.yml"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, 5, config.UInt("wtf.refreshInterval"))
+	assert.Equal(t, 60, config.UInt("wtf.mods.jira.refreshInterval"))
+	assert.Equal(t, "abc123", config.UString("wtf.mods.jira.apiKey"))
+	assert.Equal(t, "hunter2", config.UString("wtf.mods.jira.password"))
+	assert.Equal(t, []interface{}{"A", "B"}, config.UList("wtf.mods.jira.projects"))
+	assert.True(t, config.UBool("wtf.mods.pagerduty.enabled"))
+	assert.Equal(t, "${literal}", config.UString("wtf.mods.clocks.format"))
+
+	_, err = config.Get("wtf.include")
+	assert.Error(t, err)
+
+	assert.Equal(
+		t,
+		[]string{
+			filepath.Join(dir, "config.yml"),
+			filepath.Join(dir, "team/jira.yml"),
+			filepath.Join(dir, "secret"),
+			filepath.Join(dir, "team/pagerduty.yml"),
+			filepath.Join(dir, "personal.yml"),
+		},
+		files,
+	)
+}
+
+func Test_MergedWtfConfig(t *testing.T) {
+	os.Setenv("WTF_TEST_TOKEN", "abc123")
+	defer os.Unsetenv("WTF_TEST_TOKEN")
+
+	dir := writeConfigFiles(t, map[string]string{
+		"config.yml": `
+wtf:
+  include: "${WTF_TEST_DIR}/mods/*.yml"
+`,
+		"mods/jira.yml": `
+wtf:
+  mods:
+    jira:
+      apiKey: "${WTF_TEST_TOKEN}"
+      password: "${file:../secret}"
+`,
+		"secret": "hunter2\n",
+	})
+	defer os.RemoveAll(dir)
+
+	os.Setenv("WTF_TEST_DIR", dir)
+	defer os.Unsetenv("WTF_TEST_DIR")
+
+	// References are left as written, except in the include paths
+	config, err := MergedWtfConfig(filepath.Join(dir, "config.yml"), false)
+	assert.NoError(t, err)
+	assert.Equal(t, "${WTF_TEST_TOKEN}", config.UString("wtf.mods.jira.apiKey"))
+	assert.Equal(t, "${file:../secret}", config.UString("wtf.mods.jira.password"))
+
+	config, err = MergedWtfConfig(filepath.Join(dir, "config.yml"), true)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", config.UString("wtf.mods.jira.apiKey"))
+	assert.Equal(t, "hunter2", config.UString("wtf.mods.jira.password"))
+
+	_, sources, err := ParseWtfConfigSources(filepath.Join(dir, "config.yml"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "mods")}, sources.IncludeDirs)
+	assert.Equal(
+		t,
+		[]string{
+			filepath.Join(dir, "config.yml"),
+			filepath.Join(dir, "mods/jira.yml"),
+			filepath.Join(dir, "secret"),
+		},
+		sources.Files,
+	)
+}
+
+func Test_ParseWtfConfigFiles_unsetEnvVars(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"config.yml": `
+wtf:
+  title: ${WTF_TEST_UNSET}
+  mods:
+    cmdrunner:
+      args: ["-c", "echo ${FOO:-bar}"]
+      cmd: sh
+`,
+	})
+	defer os.RemoveAll(dir)
+
+	config, _, err := ParseWtfConfigFiles(filepath.Join(dir, "config.yml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "${WTF_TEST_UNSET}", config.UString("wtf.title"))
+	assert.Equal(t, "echo ${FOO:-bar}", config.UString("wtf.mods.cmdrunner.args.1"))
+}
+
+func Test_ParseWtfConfigFilesErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		expectErr string
+	}{
+		{
+			name:      "missing include",
+			files:     map[string]string{"config.yml": "wtf:\n  include: missing.yml\n"},
+			expectErr: "included file {dir}/missing.yml does not exist",
+		},
+		{
+			name:      "missing referenced file",
+			files:     map[string]string{"config.yml": "wtf:\n  title: ${file:missing}\n"},
+			expectErr: "{dir}/missing",
+		},
+		{
+			name: "include cycle",
+			files: map[string]string{
+				"config.yml": "wtf:\n  include: other.yml\n",
+				"other.yml":  "wtf:\n  include: config.yml\n",
+			},
+			expectErr: "{dir}/config.yml includes itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeConfigFiles(t, tt.files)
+			defer os.RemoveAll(dir)
+
+			_, _, err := ParseWtfConfigFiles(filepath.Join(dir, "config.yml"))
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), strings.ReplaceAll(tt.expectErr, "{dir}", dir))
+		})
+	}
+}
+
+func Test_mergeConfigTrees(t *testing.T) {
+	base := map[string]interface{}{
+		"a": map[string]interface{}{"x": 1, "y": 2},
+		"b": []interface{}{1, 2},
+	}
+	overlay := map[string]interface{}{
+		"a": map[string]interface{}{"y": 3},
+		"b": []interface{}{3},
+		"c": "new",
+	}
+
+	expected := map[string]interface{}{
+		"a": map[string]interface{}{"x": 1, "y": 3},
+		"b": []interface{}{3},
+		"c": "new",
+	}
+
+	assert.Equal(t, expected, mergeConfigTrees(base, overlay))
+	assert.Equal(t, map[string]interface{}{"x": 1, "y": 2}, base["a"])
+}
@@ -0,0 +1,418 @@
+package cfg
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigVersion is the version of the config file format. A config file records the version it
+// was last migrated to in wtf.configVersion. Files without one are version 0
+//
+//	wtf:
+//	  configVersion: 1
+const ConfigVersion = 1
+
+// apiKeyModuleTypes are the module types that read their API key from apiKey, having once
+// read it from apikey
+var apiKeyModuleTypes = []string{
+	"bamboohr", "circleci", "datadog", "digitalocean", "finnhub", "football", "github", "gitlab",
+	"hibp", "jenkins", "jira", "opsgenie", "pagerduty", "todoist", "travisci", "victorops",
+	"weather", "zendesk",
+}
+
+// configMigrations are the changes made to the config file format, oldest first. Each one
+// upgrades a config file to its version from the version before it. Add a migration, and bump
+// ConfigVersion, whenever a key or module type is deprecated
+var configMigrations = []configMigration{
+	{
+		version: 1,
+		keys: []keyRename{
+			{from: "wtf.paging.pageSigil", to: "wtf.sigils.paging.normal"},
+			{from: "wtf.paging.selectedSigil", to: "wtf.sigils.paging.select"},
+		},
+		moduleKeys: []moduleKeyRename{
+			{types: apiKeyModuleTypes, from: "apikey", to: "apiKey"},
+			{types: []string{"trello"}, from: "apikey", to: "accessToken"},
+		},
+	},
+}
+
+// Deprecation is a deprecated key, or module type, found in a config file
+type Deprecation struct {
+	File        string
+	Key         string
+	Replacement string
+}
+
+// configMigration renames the keys and module types deprecated in a version of the config
+// file format. Module types are renamed before module keys, which are matched by the new type
+type configMigration struct {
+	version     int
+	keys        []keyRename
+	moduleKeys  []moduleKeyRename
+	moduleTypes map[string]string
+}
+
+// keyRename moves the value at one dotted path from the top of the config file to another
+type keyRename struct {
+	from string
+	to   string
+}
+
+// moduleKeyRename renames a key in the config of every module of one of the types
+type moduleKeyRename struct {
+	types []string
+	from  string
+	to    string
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// String returns a description of the deprecation
+func (dep Deprecation) String() string {
+	msg := fmt.Sprintf("%s is deprecated, use %s instead", dep.Key, dep.Replacement)
+	if dep.File == "" {
+		return msg
+	}
+
+	return fmt.Sprintf("%s: %s", dep.File, msg)
+}
+
+// FindDeprecations returns the deprecated keys and module types in the config files, without
+// changing them
+func FindDeprecations(files []string) ([]Deprecation, error) {
+	deprecations := []Deprecation{}
+
+	for _, file := range files {
+		doc, _, err := readConfigNode(file)
+		if err != nil {
+			return nil, err
+		}
+
+		deprecations = append(deprecations, migrateConfigNode(doc, file)...)
+	}
+
+	return deprecations, nil
+}
+
+// MigrateConfigFile rewrites the deprecated keys and module types in the config file with
+// their replacements, and records the version it was migrated to. The original file is kept
+// alongside it, and its path returned. Files that need no changes are left untouched, and an
+// empty backup path returned
+func MigrateConfigFile(filePath string) ([]Deprecation, string, error) {
+	doc, original, err := readConfigNode(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	changes := migrateConfigNode(doc, filePath)
+	if len(changes) == 0 {
+		return changes, "", nil
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(doc); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	backup := fmt.Sprintf("%s.%s.bak", filePath, time.Now().Format("20060102-150405"))
+	if err := ioutil.WriteFile(backup, original, info.Mode().Perm()); err != nil {
+		return nil, "", err
+	}
+
+	if err := ioutil.WriteFile(filePath, buf.Bytes(), info.Mode().Perm()); err != nil {
+		return nil, backup, err
+	}
+
+	return changes, backup, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// readConfigNode parses the config file into a document node, which keeps its comments and
+// the order of its keys, and returns it along with the file's contents
+func readConfigNode(filePath string) (*yaml.Node, []byte, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(contents, doc); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	return doc, contents, nil
+}
+
+// migrateConfigNode applies the migrations newer than the document's version to it, and
+// returns the changes made. The version is only recorded if something changed
+func migrateConfigNode(doc *yaml.Node, file string) []Deprecation {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return []Deprecation{}
+	}
+	root := doc.Content[0]
+
+	changes := []Deprecation{}
+
+	version := configNodeVersion(root)
+	for _, migration := range configMigrations {
+		if migration.version <= version {
+			continue
+		}
+
+		changes = append(changes, migration.apply(root, file)...)
+	}
+
+	if len(changes) > 0 {
+		setScalar(ensureMapping(root, []string{"wtf"}), "configVersion", "!!int", strconv.Itoa(ConfigVersion))
+	}
+
+	return changes
+}
+
+func (migration configMigration) apply(root *yaml.Node, file string) []Deprecation {
+	changes := []Deprecation{}
+
+	for _, rename := range migration.keys {
+		if renameKey(root, strings.Split(rename.from, "."), strings.Split(rename.to, ".")) {
+			changes = append(changes, Deprecation{File: file, Key: rename.from, Replacement: rename.to})
+		}
+	}
+
+	mods := lookupNode(root, []string{"wtf", "mods"})
+	if mods == nil || mods.Kind != yaml.MappingNode {
+		return changes
+	}
+
+	for idx := 0; idx+1 < len(mods.Content); idx += 2 {
+		name := mods.Content[idx].Value
+		module := resolveAlias(mods.Content[idx+1])
+		if module.Kind != yaml.MappingNode {
+			continue
+		}
+
+		path := "wtf.mods." + name
+
+		moduleType := name
+		if typeNode := lookupNode(module, []string{"type"}); typeNode != nil {
+			moduleType = typeNode.Value
+		}
+
+		if newType, ok := migration.moduleTypes[moduleType]; ok {
+			setScalar(module, "type", "!!str", newType)
+			changes = append(changes, Deprecation{
+				File:        file,
+				Key:         fmt.Sprintf("%s.type %s", path, moduleType),
+				Replacement: fmt.Sprintf("%s.type %s", path, newType),
+			})
+			moduleType = newType
+		}
+
+		for _, rename := range migration.moduleKeys {
+			if !includes(rename.types, moduleType) {
+				continue
+			}
+
+			if renameKey(module, []string{rename.from}, []string{rename.to}) {
+				changes = append(changes, Deprecation{
+					File:        file,
+					Key:         path + "." + rename.from,
+					Replacement: path + "." + rename.to,
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// configNodeVersion returns the config format version recorded in the config file
+func configNodeVersion(root *yaml.Node) int {
+	node := lookupNode(root, []string{"wtf", "configVersion"})
+	if node == nil {
+		return 0
+	}
+
+	version, err := strconv.Atoi(node.Value)
+	if err != nil {
+		return 0
+	}
+
+	return version
+}
+
+// renameKey moves the value at the from path to the to path, keeping the comments on its key.
+// If a value is already set at the to path, it is the one that's used, so the deprecated one
+// is dropped instead. Mappings left empty by the move are removed. Returns FALSE if there is
+// no value at the from path
+func renameKey(root *yaml.Node, from []string, to []string) bool {
+	parent := lookupNode(root, from[:len(from)-1])
+	if parent == nil || parent.Kind != yaml.MappingNode {
+		return false
+	}
+
+	idx := mappingIndex(parent, from[len(from)-1])
+	if idx < 0 {
+		return false
+	}
+
+	key, value := parent.Content[idx], parent.Content[idx+1]
+
+	if lookupNode(root, to) == nil {
+		dest := ensureMapping(root, to[:len(to)-1])
+		if dest == nil {
+			return false
+		}
+
+		key.Value = to[len(to)-1]
+		dest.Content = append(dest.Content, key, value)
+	}
+
+	parent.Content = append(parent.Content[:idx], parent.Content[idx+2:]...)
+
+	pruneEmptyMappings(root, from[:len(from)-1])
+
+	return true
+}
+
+// ensureMapping returns the mapping at the path, creating any that are missing. Returns nil if
+// something other than a mapping is already at the path
+func ensureMapping(root *yaml.Node, path []string) *yaml.Node {
+	node := root
+
+	for _, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		idx := mappingIndex(node, key)
+		if idx < 0 {
+			child := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+			node = child
+			continue
+		}
+
+		node = resolveAlias(node.Content[idx+1])
+
+		// An empty key, such as "sigils:" on its own, is null rather than an empty mapping
+		if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+			*node = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	return node
+}
+
+// lookupNode returns the node at the path, or nil if there isn't one
+func lookupNode(root *yaml.Node, path []string) *yaml.Node {
+	node := root
+
+	for _, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		idx := mappingIndex(node, key)
+		if idx < 0 {
+			return nil
+		}
+
+		node = resolveAlias(node.Content[idx+1])
+	}
+
+	return node
+}
+
+// mappingIndex returns the index of the key's node in the mapping's content, or -1 if the
+// mapping doesn't have the key. Its value is at the next index
+func mappingIndex(mapping *yaml.Node, key string) int {
+	for idx := 0; idx+1 < len(mapping.Content); idx += 2 {
+		if mapping.Content[idx].Value == key {
+			return idx
+		}
+	}
+
+	return -1
+}
+
+// pruneEmptyMappings removes the mappings along the path that have nothing left in them,
+// deepest first
+func pruneEmptyMappings(root *yaml.Node, path []string) {
+	for depth := len(path); depth > 0; depth-- {
+		node := lookupNode(root, path[:depth])
+		if node == nil || node.Kind != yaml.MappingNode || len(node.Content) > 0 {
+			return
+		}
+
+		parent := lookupNode(root, path[:depth-1])
+		idx := mappingIndex(parent, path[depth-1])
+		parent.Content = append(parent.Content[:idx], parent.Content[idx+2:]...)
+	}
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return node.Alias
+	}
+
+	return node
+}
+
+// setScalar sets the key in the mapping to the scalar value, adding the key if it's missing
+func setScalar(mapping *yaml.Node, key string, tag string, value string) {
+	if mapping == nil {
+		return
+	}
+
+	idx := mappingIndex(mapping, key)
+	if idx < 0 {
+		mapping.Content = append(
+			mapping.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value},
+		)
+		return
+	}
+
+	// Update the existing node, rather than replacing it, to keep its comments
+	node := mapping.Content[idx+1]
+	node.Kind = yaml.ScalarNode
+	node.Tag = tag
+	node.Value = value
+	node.Content = nil
+}
+
+func includes(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+
+	return false
+}
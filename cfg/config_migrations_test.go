@@ -0,0 +1,202 @@
+package cfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FindDeprecations(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		expected []Deprecation
+	}{
+		{
+			name:     "with an empty file",
+			contents: "",
+			expected: []Deprecation{},
+		},
+		{
+			name: "with nothing deprecated",
+			contents: `
+wtf:
+  sigils:
+    paging:
+      normal: "*"
+  mods:
+    github:
+      apiKey: abc
+`,
+			expected: []Deprecation{},
+		},
+		{
+			name: "with deprecated keys",
+			contents: `
+wtf:
+  paging:
+    pageSigil: "*"
+  mods:
+    ghe:
+      type: github
+      apikey: abc
+    cards:
+      type: trello
+      apikey: def
+    clocks:
+      apikey: ghi
+`,
+			expected: []Deprecation{
+				{Key: "wtf.paging.pageSigil", Replacement: "wtf.sigils.paging.normal"},
+				{Key: "wtf.mods.ghe.apikey", Replacement: "wtf.mods.ghe.apiKey"},
+				{Key: "wtf.mods.cards.apikey", Replacement: "wtf.mods.cards.accessToken"},
+			},
+		},
+		{
+			name: "with a migrated config",
+			contents: `
+wtf:
+  configVersion: 1
+  paging:
+    pageSigil: "*"
+`,
+			expected: []Deprecation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeConfigFiles(t, map[string]string{"config.yml": tt.contents})
+			defer func() { _ = os.RemoveAll(dir) }()
+
+			path := filepath.Join(dir, "config.yml")
+			for idx := range tt.expected {
+				tt.expected[idx].File = path
+			}
+
+			actual, err := FindDeprecations([]string{path})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_MigrateConfigFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		contents   string
+		expected   string
+		changes    int
+		wantBackup bool
+	}{
+		{
+			name: "with nothing deprecated",
+			contents: `wtf:
+  mods:
+    github:
+      apiKey: abc
+`,
+			expected: `wtf:
+  mods:
+    github:
+      apiKey: abc
+`,
+			changes:    0,
+			wantBackup: false,
+		},
+		{
+			name: "with deprecated keys",
+			contents: `# My config
+wtf:
+  paging:
+    pageSigil: "*" # the page marker
+    selectedSigil: "_"
+  mods:
+    github:
+      # From the settings page
+      apikey: abc
+      enabled: true
+`,
+			expected: `# My config
+wtf:
+  mods:
+    github:
+      enabled: true
+      # From the settings page
+      apiKey: abc
+  sigils:
+    paging:
+      normal: "*" # the page marker
+      select: "_"
+  configVersion: 1
+`,
+			changes:    3,
+			wantBackup: true,
+		},
+		{
+			name: "with the replacement already set",
+			contents: `wtf:
+  sigils:
+    paging:
+      normal: "+"
+  paging:
+    pageSigil: "*"
+    other: true
+`,
+			expected: `wtf:
+  sigils:
+    paging:
+      normal: "+"
+  paging:
+    other: true
+  configVersion: 1
+`,
+			changes:    1,
+			wantBackup: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeConfigFiles(t, map[string]string{"config.yml": tt.contents})
+			defer func() { _ = os.RemoveAll(dir) }()
+
+			path := filepath.Join(dir, "config.yml")
+
+			changes, backup, err := MigrateConfigFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.changes, len(changes))
+
+			actual, err := ioutil.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+
+			if !tt.wantBackup {
+				assert.Equal(t, "", backup)
+				return
+			}
+
+			original, err := ioutil.ReadFile(backup)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.contents, string(original))
+
+			// Migrating again changes nothing
+			changes, backup, err = MigrateConfigFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(changes))
+			assert.Equal(t, "", backup)
+		})
+	}
+}
+
+func Test_Deprecation_String(t *testing.T) {
+	deprecation := Deprecation{Key: "wtf.paging.pageSigil", Replacement: "wtf.sigils.paging.normal"}
+	assert.Equal(t, "wtf.paging.pageSigil is deprecated, use wtf.sigils.paging.normal instead", deprecation.String())
+
+	deprecation.File = "config.yml"
+	assert.Equal(t, "config.yml: wtf.paging.pageSigil is deprecated, use wtf.sigils.paging.normal instead", deprecation.String())
+}
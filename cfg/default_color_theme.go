@@ -7,8 +7,10 @@ import (
 
 // BorderTheme defines the default color scheme for drawing widget borders
 type BorderTheme struct {
+	Errored     string
 	Focusable   string
 	Focused     string
+	Stale       string
 	Unfocusable string
 }
 
@@ -29,8 +31,18 @@ type RowTheme struct {
 	HighlightedForeground string
 }
 
+// StatusTheme defines the default color scheme for text that conveys a state, such as a
+// passing build or a failed check
+type StatusTheme struct {
+	Error   string
+	Muted   string
+	Success string
+	Warning string
+}
+
 // TextTheme defines the default color scheme for text rendering
 type TextTheme struct {
+	Accent     string
 	Label      string
 	Subheading string
 	Text       string
@@ -47,6 +59,7 @@ type ColorTheme struct {
 	BorderTheme
 	CheckboxTheme
 	RowTheme
+	StatusTheme
 	TextTheme
 	WidgetTheme
 }
@@ -55,8 +68,10 @@ type ColorTheme struct {
 func NewDefaultColorTheme() ColorTheme {
 	defaultTheme := ColorTheme{
 		BorderTheme: BorderTheme{
+			Errored:     "red",
 			Focusable:   "blue",
 			Focused:     "orange",
+			Stale:       "yellow",
 			Unfocusable: "gray",
 		},
 
@@ -75,7 +90,15 @@ func NewDefaultColorTheme() ColorTheme {
 			HighlightedBackground: "green",
 		},
 
+		StatusTheme: StatusTheme{
+			Error:   "red",
+			Muted:   "gray",
+			Success: "green",
+			Warning: "yellow",
+		},
+
 		TextTheme: TextTheme{
+			Accent:     "yellow",
 			Label:      "lightblue",
 			Subheading: "red",
 			Text:       "white",
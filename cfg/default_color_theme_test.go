@@ -9,6 +9,7 @@ import (
 func Test_NewDefaultColorTheme(t *testing.T) {
 	theme := NewDefaultColorTheme()
 
+	assert.Equal(t, "red", theme.BorderTheme.Errored)
 	assert.Equal(t, "orange", theme.BorderTheme.Focused)
 	assert.Equal(t, "red", theme.TextTheme.Subheading)
 	assert.Equal(t, "transparent", theme.WidgetTheme.Background)
@@ -39,10 +39,11 @@ func displayWtfConfigDirCreateError(err error) {
 func displayWtfConfigFileLoadError(path string, err error) {
 	fmt.Printf("\n%s Could not load '%s'.\n", aurora.Red("ERROR"), aurora.Yellow(path))
 	fmt.Println()
-	fmt.Println("This could mean one of two things:")
+	fmt.Println("This could mean one of three things:")
 	fmt.Println()
 	fmt.Println("    1. That file doesn't exist.")
 	fmt.Println("    2. That file has a YAML syntax error. Try running it through http://www.yamllint.com to check for errors.")
+	fmt.Println("    3. A file it includes, or a file or env var it refers to, is missing or invalid.")
 	fmt.Println()
 	displayError(err)
 }
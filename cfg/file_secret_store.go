@@ -0,0 +1,268 @@
+package cfg
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	secretFileVersion = 1
+
+	// The scrypt parameters recommended for interactive use
+	scryptN      = 32768
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	scryptSalt   = 16
+)
+
+// FileSecretStore keeps secrets in a file encrypted with AES-256-GCM, using a key derived
+// from a passphrase with scrypt. It needs nothing but the file, so it works on machines
+// without a keychain
+type FileSecretStore struct {
+	key        []byte
+	keySalt    []byte
+	mutex      sync.Mutex
+	passphrase string
+	path       string
+}
+
+// secretFile is the encrypted file's contents
+type secretFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// fileSecret is a secret as it is kept in the encrypted file
+type fileSecret struct {
+	Secret   string `json:"secret"`
+	Username string `json:"username,omitempty"`
+}
+
+// NewFileSecretStore creates and returns a FileSecretStore that keeps its secrets in the
+// file at path, encrypted with the passphrase. The file is created when the first secret is
+// stored
+func NewFileSecretStore(path string, passphrase string) *FileSecretStore {
+	return &FileSecretStore{
+		passphrase: passphrase,
+		path:       path,
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Name returns the name of the store
+func (store *FileSecretStore) Name() string {
+	return SecretStoreFile
+}
+
+// Delete removes the secret for the service from the file
+func (store *FileSecretStore) Delete(service string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	secrets, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := secrets[service]; !ok {
+		return fmt.Errorf("no secret for %s in %s", service, store.path)
+	}
+
+	delete(secrets, service)
+
+	return store.write(secrets)
+}
+
+// Get returns the secret for the service from the file
+func (store *FileSecretStore) Get(service string) (*Secret, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	secrets, err := store.read()
+	if err != nil {
+		return nil, err
+	}
+
+	secret, ok := secrets[service]
+	if !ok {
+		return nil, nil
+	}
+
+	return &Secret{
+		Service:  service,
+		Secret:   secret.Secret,
+		Username: secret.Username,
+		Store:    SecretStoreFile,
+	}, nil
+}
+
+// List returns the services the file has secrets for, sorted
+func (store *FileSecretStore) List() ([]string, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	secrets, err := store.read()
+	if err != nil {
+		return nil, err
+	}
+
+	services := []string{}
+	for service := range secrets {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	return services, nil
+}
+
+// Store saves the secret to the file, replacing any secret the service already had
+func (store *FileSecretStore) Store(secret *Secret) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	secrets, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	secrets[secret.Service] = fileSecret{Secret: secret.Secret, Username: secret.Username}
+
+	return store.write(secrets)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// read decrypts and returns the secrets in the file. A file that doesn't exist yet has none
+func (store *FileSecretStore) read() (map[string]fileSecret, error) {
+	secrets := map[string]fileSecret{}
+
+	contents, err := ioutil.ReadFile(store.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secrets, nil
+		}
+		return nil, err
+	}
+
+	file := secretFile{}
+	if err := json.Unmarshal(contents, &file); err != nil {
+		return nil, fmt.Errorf("%s is not a secrets file: %w", store.path, err)
+	}
+
+	if file.Version != secretFileVersion {
+		return nil, fmt.Errorf("%s has unsupported version %d", store.path, file.Version)
+	}
+
+	gcm, err := store.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt %s: the passphrase is wrong or the file is damaged", store.path)
+	}
+
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("%s: %w", store.path, err)
+	}
+
+	return secrets, nil
+}
+
+// write encrypts the secrets, with a new salt and nonce, and replaces the file with them
+func (store *FileSecretStore) write(secrets map[string]fileSecret) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	file := secretFile{
+		Version: secretFileVersion,
+		Salt:    make([]byte, scryptSalt),
+	}
+
+	if _, err := io.ReadFull(rand.Reader, file.Salt); err != nil {
+		return err
+	}
+
+	gcm, err := store.cipher(file.Salt)
+	if err != nil {
+		return err
+	}
+
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, file.Nonce); err != nil {
+		return err
+	}
+
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, plaintext, nil)
+
+	contents, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(store.path), 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that a failed write can't lose every secret
+	tmp, err := ioutil.TempFile(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(contents); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), store.path)
+}
+
+func (store *FileSecretStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if len(salt) != scryptSalt {
+		return nil, errors.New("the secrets file has an invalid salt")
+	}
+
+	// Deriving the key is deliberately slow, so it's only done again when the salt changes
+	if !bytes.Equal(salt, store.keySalt) {
+		key, err := scrypt.Key([]byte(store.passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+		if err != nil {
+			return nil, err
+		}
+
+		store.key = key
+		store.keySalt = salt
+	}
+
+	block, err := aes.NewCipher(store.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
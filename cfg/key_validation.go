@@ -0,0 +1,42 @@
+package cfg
+
+import (
+	"fmt"
+
+	"github.com/logrusorgru/aurora"
+)
+
+// keyValidation describes a problem with a key binding, such as a key that is mapped to
+// more than one action
+type keyValidation struct {
+	action   string
+	err      error
+	sequence string
+}
+
+// NewKeyValidation creates and returns a validation for the key sequence bound to the
+// named action
+func NewKeyValidation(action, sequence string, err error) Validatable {
+	return &keyValidation{
+		action:   action,
+		err:      err,
+		sequence: sequence,
+	}
+}
+
+func (keyVal *keyValidation) Error() error {
+	return keyVal.err
+}
+
+func (keyVal *keyValidation) HasError() bool {
+	return keyVal.err != nil
+}
+
+func (keyVal *keyValidation) IntValue() int {
+	return 0
+}
+
+// String returns the Stringer representation of the keyValidation
+func (keyVal *keyValidation) String() string {
+	return fmt.Sprintf("Invalid key for %s:\t%s", aurora.Yellow(keyVal.action), keyVal.sequence)
+}
@@ -0,0 +1,32 @@
+package cfg
+
+import (
+	"github.com/logrusorgru/aurora"
+)
+
+// ruleValidation describes a rule that could not be parsed, such as one whose condition is
+// not a valid expression
+type ruleValidation struct {
+	err error
+}
+
+func newRuleValidation(err error) Validatable {
+	return &ruleValidation{err: err}
+}
+
+func (ruleVal *ruleValidation) Error() error {
+	return ruleVal.err
+}
+
+func (ruleVal *ruleValidation) HasError() bool {
+	return ruleVal.err != nil
+}
+
+func (ruleVal *ruleValidation) IntValue() int {
+	return 0
+}
+
+// String returns the Stringer representation of the ruleValidation
+func (ruleVal *ruleValidation) String() string {
+	return aurora.Yellow("Invalid rule").String()
+}
@@ -0,0 +1,72 @@
+package cfg
+
+import (
+	"sort"
+	"sync"
+)
+
+// SecretService is a service that a module loads its secret from
+type SecretService struct {
+	// Configured is TRUE if the secret is set in the module's config, so it isn't loaded
+	// from the secret store
+	Configured bool
+	Module     string
+	Service    string
+}
+
+var (
+	collectMutex   = &sync.Mutex{}
+	secretServices *[]SecretService
+	servicesMutex  = &sync.Mutex{}
+)
+
+// CollectSecretServices runs fn and returns the services that modules loaded their secrets
+// from while it ran, sorted by module. The secrets themselves aren't loaded
+func CollectSecretServices(fn func()) []SecretService {
+	collectMutex.Lock()
+	defer collectMutex.Unlock()
+
+	collected := []SecretService{}
+
+	servicesMutex.Lock()
+	secretServices = &collected
+	servicesMutex.Unlock()
+
+	defer func() {
+		servicesMutex.Lock()
+		secretServices = nil
+		servicesMutex.Unlock()
+	}()
+
+	fn()
+
+	servicesMutex.Lock()
+	defer servicesMutex.Unlock()
+
+	sort.SliceStable(collected, func(i, j int) bool {
+		return collected[i].Module < collected[j].Module
+	})
+
+	return collected
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// collectSecretService records the service the secret would be loaded from, if services are
+// being collected. Returns FALSE if they aren't, and the secret should be loaded
+func collectSecretService(slp *SecretLoadParams) bool {
+	servicesMutex.Lock()
+	defer servicesMutex.Unlock()
+
+	if secretServices == nil {
+		return false
+	}
+
+	*secretServices = append(*secretServices, SecretService{
+		Configured: slp.secret != nil && *slp.secret != "",
+		Module:     slp.name,
+		Service:    slp.service,
+	})
+
+	return true
+}
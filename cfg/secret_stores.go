@@ -0,0 +1,311 @@
+package cfg
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/docker/docker-credential-helpers/client"
+	"github.com/docker/docker-credential-helpers/credentials"
+	"github.com/olebedev/config"
+)
+
+const (
+	// SecretStoreEnv reads secrets from environment variables
+	SecretStoreEnv = "env"
+	// SecretStoreFile keeps secrets in a file encrypted with a passphrase
+	SecretStoreFile = "file"
+
+	defaultSecretEnvPrefix = "WTF_SECRET_"
+	defaultSecretFile      = "secrets.enc"
+
+	// secretPassphraseEnv is the environment variable the encrypted file's passphrase is read
+	// from when no key file is configured
+	secretPassphraseEnv = "WTF_SECRETS_PASSPHRASE"
+)
+
+var (
+	unsafeEnvChars = regexp.MustCompile(`[^A-Z0-9_]+`)
+
+	// fileSecretStores are the file stores in use, by path, so that every module loading its
+	// secret from the same file shares the key derived from its passphrase
+	fileSecretStores      = map[string]*FileSecretStore{}
+	fileSecretStoresMutex = &sync.Mutex{}
+)
+
+// SecretStore keeps the secrets that modules load by service name. Get returns nil if there
+// is no secret for the service
+type SecretStore interface {
+	Name() string
+
+	Delete(service string) error
+	Get(service string) (*Secret, error)
+	List() ([]string, error)
+	Store(secret *Secret) error
+}
+
+// PassphrasePrompt asks the user for the passphrase the secrets file is encrypted with
+type PassphrasePrompt func() (string, error)
+
+// NewSecretStore creates and returns the secret store selected by wtf.secretStore, or nil
+// if there isn't one:
+//
+//	wtf:
+//	  secretStore: file        <- file, env, or the name of a docker-credential-helpers program,
+//	                              such as osxkeychain. Leave it empty for the OS's default helper
+//	  secrets:
+//	    file: "~/.config/wtf/secrets.enc"
+//	    keyFile: "~/.config/wtf/secrets.key"
+//	    envPrefix: "WTF_SECRET_"
+//
+// The file store's passphrase is read from the key file or, without one, from the
+// WTF_SECRETS_PASSPHRASE environment variable. If neither is set, prompt is used to ask
+// for it, if it isn't nil
+func NewSecretStore(globalConfig *config.Config, prompt PassphrasePrompt) (SecretStore, error) {
+	secretStore := globalConfig.UString("wtf.secretStore", "(none)")
+
+	switch secretStore {
+	case "(none)":
+		return nil, nil
+	case SecretStoreEnv:
+		return NewEnvSecretStore(globalConfig.UString("wtf.secrets.envPrefix", defaultSecretEnvPrefix)), nil
+	case SecretStoreFile:
+		return newFileSecretStoreFromYAML(globalConfig, prompt)
+	case "":
+		switch runtime.GOOS {
+		case "windows":
+			secretStore = "winrt"
+		case "darwin":
+			secretStore = "osxkeychain"
+		default:
+			secretStore = "secretservice"
+		}
+	}
+
+	return NewHelperSecretStore(secretStore), nil
+}
+
+/* -------------------- Credential Helper -------------------- */
+
+// HelperSecretStore keeps secrets with a docker-credential-helpers program, such as
+// docker-credential-osxkeychain
+type HelperSecretStore struct {
+	runner client.ProgramFunc
+	store  string
+}
+
+// NewHelperSecretStore creates and returns a HelperSecretStore that runs
+// docker-credential-<store>
+func NewHelperSecretStore(store string) *HelperSecretStore {
+	return &HelperSecretStore{
+		runner: client.NewShellProgramFunc("docker-credential-" + store),
+		store:  store,
+	}
+}
+
+// Name returns the name of the credential helper
+func (helper *HelperSecretStore) Name() string {
+	return helper.store
+}
+
+// Delete removes the secret for the service from the credential helper
+func (helper *HelperSecretStore) Delete(service string) error {
+	return client.Erase(helper.runner, service)
+}
+
+// Get returns the secret for the service from the credential helper
+func (helper *HelperSecretStore) Get(service string) (*Secret, error) {
+	cred, err := client.Get(helper.runner, service)
+	if err != nil {
+		if credentials.IsErrCredentialsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &Secret{
+		Service:  cred.ServerURL,
+		Secret:   cred.Secret,
+		Username: cred.Username,
+		Store:    helper.store,
+	}, nil
+}
+
+// List returns the services the credential helper has secrets for, sorted
+func (helper *HelperSecretStore) List() ([]string, error) {
+	creds, err := client.List(helper.runner)
+	if err != nil {
+		return nil, err
+	}
+
+	services := []string{}
+	for service := range creds {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	return services, nil
+}
+
+// Store saves the secret with the credential helper
+func (helper *HelperSecretStore) Store(secret *Secret) error {
+	cred := &credentials.Credentials{
+		ServerURL: secret.Service,
+		Username:  secret.Username,
+		Secret:    secret.Secret,
+	}
+
+	// docker-credential requires a username, but it isn't necessary for
+	// all services. Use a default if a username was not set.
+	if cred.Username == "" {
+		cred.Username = "default"
+	}
+
+	return client.Store(helper.runner, cred)
+}
+
+/* -------------------- Environment Variables -------------------- */
+
+// EnvSecretStore reads secrets from environment variables named after their service, such
+// as WTF_SECRET_CIRCLECI for the circleci service, or WTF_SECRET_HTTPS_GITHUB_EXAMPLE_COM_API_V3
+// for https://github.example.com/api/v3. The environment is read-only, so secrets can't be
+// stored in or deleted from it
+type EnvSecretStore struct {
+	prefix string
+}
+
+// NewEnvSecretStore creates and returns an EnvSecretStore that reads variables starting
+// with the prefix
+func NewEnvSecretStore(prefix string) *EnvSecretStore {
+	return &EnvSecretStore{prefix: prefix}
+}
+
+// Name returns the name of the store
+func (env *EnvSecretStore) Name() string {
+	return SecretStoreEnv
+}
+
+// Delete always fails, as secrets can't be deleted from the environment
+func (env *EnvSecretStore) Delete(service string) error {
+	return fmt.Errorf("secrets can't be deleted from the environment, unset %s instead", env.VarName(service))
+}
+
+// Get returns the secret for the service from its environment variable
+func (env *EnvSecretStore) Get(service string) (*Secret, error) {
+	value, ok := os.LookupEnv(env.VarName(service))
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	return &Secret{
+		Service: service,
+		Secret:  value,
+		Store:   SecretStoreEnv,
+	}, nil
+}
+
+// List returns the names of the environment variables that hold secrets, sorted. The service
+// names can't be recovered from them
+func (env *EnvSecretStore) List() ([]string, error) {
+	names := []string{}
+
+	for _, variable := range os.Environ() {
+		name := strings.SplitN(variable, "=", 2)[0]
+		if strings.HasPrefix(name, env.prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Store always fails, as secrets can't be stored in the environment
+func (env *EnvSecretStore) Store(secret *Secret) error {
+	return fmt.Errorf("secrets can't be stored in the environment, set %s instead", env.VarName(secret.Service))
+}
+
+// VarName returns the name of the environment variable that holds the service's secret
+func (env *EnvSecretStore) VarName(service string) string {
+	name := strings.Trim(unsafeEnvChars.ReplaceAllString(strings.ToUpper(service), "_"), "_")
+	return env.prefix + name
+}
+
+// SecretsFilePath returns the path of the file that the file secret store keeps its secrets
+// in, from wtf.secrets.file or the default location in the config dir
+func SecretsFilePath(globalConfig *config.Config) (string, error) {
+	path := globalConfig.UString("wtf.secrets.file")
+	if path == "" {
+		configDir, err := WtfConfigDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(configDir, defaultSecretFile)
+	}
+
+	return expandHomeDir(path)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func newFileSecretStoreFromYAML(globalConfig *config.Config, prompt PassphrasePrompt) (SecretStore, error) {
+	path, err := SecretsFilePath(globalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	passphrase, err := secretPassphrase(globalConfig.UString("wtf.secrets.keyFile"), prompt)
+	if err != nil {
+		return nil, err
+	}
+
+	if passphrase == "" {
+		return nil, errors.New("the passphrase for the secrets file is empty")
+	}
+
+	fileSecretStoresMutex.Lock()
+	defer fileSecretStoresMutex.Unlock()
+
+	store, ok := fileSecretStores[path]
+	if !ok || store.passphrase != passphrase {
+		store = NewFileSecretStore(path, passphrase)
+		fileSecretStores[path] = store
+	}
+
+	return store, nil
+}
+
+// secretPassphrase returns the passphrase the secrets file is encrypted with, from the key
+// file, the environment, or the user
+func secretPassphrase(keyFile string, prompt PassphrasePrompt) (string, error) {
+	if keyFile != "" {
+		path, err := expandHomeDir(keyFile)
+		if err != nil {
+			return "", err
+		}
+
+		key, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("reading key file: %w", err)
+		}
+
+		return strings.TrimSpace(string(key)), nil
+	}
+
+	if passphrase := os.Getenv(secretPassphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+
+	if prompt != nil {
+		return prompt()
+	}
+
+	return "", errors.New("no passphrase for the secrets file: set wtf.secrets.keyFile or " + secretPassphraseEnv)
+}
@@ -0,0 +1,179 @@
+package cfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FileSecretStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wtf-secrets")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "secrets", "secrets.enc")
+	store := NewFileSecretStore(path, "correct horse")
+
+	secret, err := store.Get("circleci")
+	assert.NoError(t, err)
+	assert.Nil(t, secret)
+
+	assert.NoError(t, store.Store(&Secret{Service: "circleci", Secret: "abc123"}))
+	assert.NoError(t, store.Store(&Secret{Service: "https://github.example.com/api/v3", Secret: "def456", Username: "me"}))
+
+	contents, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(contents), "abc123")
+
+	// A new store reads what the first one wrote
+	reopened := NewFileSecretStore(path, "correct horse")
+
+	secret, err = reopened.Get("https://github.example.com/api/v3")
+	assert.NoError(t, err)
+	assert.Equal(t, &Secret{Service: "https://github.example.com/api/v3", Secret: "def456", Username: "me", Store: SecretStoreFile}, secret)
+
+	services, err := reopened.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"circleci", "https://github.example.com/api/v3"}, services)
+
+	assert.NoError(t, reopened.Delete("circleci"))
+	assert.Error(t, reopened.Delete("circleci"))
+
+	services, err = store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://github.example.com/api/v3"}, services)
+
+	_, err = NewFileSecretStore(path, "wrong horse").Get("circleci")
+	assert.EqualError(t, err, "could not decrypt "+path+": the passphrase is wrong or the file is damaged")
+}
+
+func Test_EnvSecretStore(t *testing.T) {
+	os.Setenv("WTF_SECRET_CIRCLECI", "abc123")
+	os.Setenv("WTF_SECRET_HTTPS_GITHUB_EXAMPLE_COM_API_V3", "def456")
+	defer os.Unsetenv("WTF_SECRET_CIRCLECI")
+	defer os.Unsetenv("WTF_SECRET_HTTPS_GITHUB_EXAMPLE_COM_API_V3")
+
+	store := NewEnvSecretStore("WTF_SECRET_")
+
+	secret, err := store.Get("circleci")
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", secret.Secret)
+
+	secret, err = store.Get("https://github.example.com/api/v3")
+	assert.NoError(t, err)
+	assert.Equal(t, "def456", secret.Secret)
+
+	secret, err = store.Get("jira")
+	assert.NoError(t, err)
+	assert.Nil(t, secret)
+
+	names, err := store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"WTF_SECRET_CIRCLECI", "WTF_SECRET_HTTPS_GITHUB_EXAMPLE_COM_API_V3"}, names)
+
+	assert.EqualError(t, store.Store(&Secret{Service: "jira"}), "secrets can't be stored in the environment, set WTF_SECRET_JIRA instead")
+}
+
+func Test_NewSecretStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wtf-secrets")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	keyFile := filepath.Join(dir, "secrets.key")
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte("correct horse\n"), 0600))
+
+	tests := []struct {
+		name     string
+		yaml     string
+		expected string
+		err      string
+	}{
+		{name: "no store", yaml: "wtf:\n  refreshInterval: 5\n", expected: ""},
+		{name: "env", yaml: "wtf:\n  secretStore: env\n", expected: SecretStoreEnv},
+		{name: "credential helper", yaml: "wtf:\n  secretStore: pass\n", expected: "pass"},
+		{
+			name:     "file with key file",
+			yaml:     "wtf:\n  secretStore: file\n  secrets:\n    file: " + filepath.Join(dir, "secrets.enc") + "\n    keyFile: " + keyFile + "\n",
+			expected: SecretStoreFile,
+		},
+		{
+			name: "file without passphrase",
+			yaml: "wtf:\n  secretStore: file\n  secrets:\n    file: " + filepath.Join(dir, "secrets.enc") + "\n",
+			err:  "no passphrase for the secrets file: set wtf.secrets.keyFile or WTF_SECRETS_PASSPHRASE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			globalConfig, err := config.ParseYaml(tt.yaml)
+			assert.NoError(t, err)
+
+			store, err := NewSecretStore(globalConfig, nil)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, store)
+			} else {
+				assert.Equal(t, tt.expected, store.Name())
+			}
+		})
+	}
+}
+
+func Test_SecretsFilePath(t *testing.T) {
+	globalConfig, err := config.ParseYaml("wtf:\n  secrets:\n    file: /tmp/wtf/secrets.enc\n")
+	assert.NoError(t, err)
+
+	path, err := SecretsFilePath(globalConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, "/tmp/wtf/secrets.enc", path)
+
+	configDir, err := WtfConfigDir()
+	assert.NoError(t, err)
+
+	globalConfig, err = config.ParseYaml("wtf:\n  secretStore: file\n")
+	assert.NoError(t, err)
+
+	path, err = SecretsFilePath(globalConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(configDir, defaultSecretFile), path)
+}
+
+func Test_CollectSecretServices(t *testing.T) {
+	globalConfig, _ := config.ParseYaml("wtf:\n  secretStore: env\n")
+
+	os.Setenv("WTF_SECRET_CIRCLECI", "abc123")
+	defer os.Unsetenv("WTF_SECRET_CIRCLECI")
+
+	configured := "from config"
+	var loaded string
+
+	services := CollectSecretServices(func() {
+		ModuleSecret("jira", globalConfig, &configured).Load()
+		ModuleSecret("circleci", globalConfig, &loaded).Load()
+		ModuleSecret("github", globalConfig, &loaded).Service("https://github.example.com/api/v3").Load()
+	})
+
+	assert.Equal(
+		t,
+		[]SecretService{
+			{Module: "circleci", Service: "circleci"},
+			{Module: "github", Service: "https://github.example.com/api/v3"},
+			{Configured: true, Module: "jira", Service: "jira"},
+		},
+		services,
+	)
+	assert.Equal(t, "", loaded)
+
+	// Secrets are loaded again once services are no longer being collected
+	ModuleSecret("circleci", globalConfig, &loaded).Load()
+	assert.Equal(t, "abc123", loaded)
+}
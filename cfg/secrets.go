@@ -3,10 +3,7 @@ package cfg
 import (
 	"errors"
 	"fmt"
-	"runtime"
 
-	"github.com/docker/docker-credential-helpers/client"
-	"github.com/docker/docker-credential-helpers/credentials"
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/logger"
 )
@@ -90,6 +87,10 @@ func (slp *SecretLoadParams) Service(service string) *SecretLoadParams {
 }
 
 func (slp *SecretLoadParams) Load() {
+	if collectSecretService(slp) {
+		return
+	}
+
 	configureSecret(
 		slp.globalConfig,
 		slp.service,
@@ -125,7 +126,7 @@ func configureSecret(
 	cred, err := FetchSecret(globalConfig, service)
 
 	if err != nil {
-		logger.Log(fmt.Sprintf("Loading secret failed: %s", err.Error()))
+		logger.Warnf("", "Loading secret for %s failed: %s", service, err.Error())
 		return
 	}
 
@@ -144,81 +145,40 @@ func configureSecret(
 // of the module.  nil is returned if the secretStore global property is not
 // present or the secret is not found in that store.
 func FetchSecret(globalConfig *config.Config, service string) (*Secret, error) {
-	prog := newProgram(globalConfig)
+	store, err := NewSecretStore(globalConfig, nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if prog == nil {
+	if store == nil {
 		// No secret store configured.
 		return nil, nil
 	}
 
-	cred, err := client.Get(prog.runner, service)
+	secret, err := store.Get(service)
 
 	if err != nil {
-		return nil, fmt.Errorf("get %v from %v: %w", service, prog.store, err)
+		return nil, fmt.Errorf("get %v from %v: %w", service, store.Name(), err)
 	}
 
-	return &Secret{
-		Service:  cred.ServerURL,
-		Secret:   cred.Secret,
-		Username: cred.Username,
-		Store:    prog.store,
-	}, nil
+	return secret, nil
 }
 
 func StoreSecret(globalConfig *config.Config, secret *Secret) error {
-	prog := newProgram(globalConfig)
-
-	if prog == nil {
-		return errors.New("cannot store secrets: wtf.secretStore is not configured")
-	}
-
-	cred := &credentials.Credentials{
-		ServerURL: secret.Service,
-		Username:  secret.Username,
-		Secret:    secret.Secret,
+	store, err := NewSecretStore(globalConfig, nil)
+	if err != nil {
+		return err
 	}
 
-	// docker-credential requires a username, but it isn't necessary for
-	// all services. Use a default if a username was not set.
-	if cred.Username == "" {
-		cred.Username = "default"
+	if store == nil {
+		return errors.New("cannot store secrets: wtf.secretStore is not configured")
 	}
 
-	err := client.Store(prog.runner, cred)
+	err = store.Store(secret)
 
 	if err != nil {
-		return fmt.Errorf("store %v: %w", prog.store, err)
+		return fmt.Errorf("store %v: %w", store.Name(), err)
 	}
 
 	return nil
 }
-
-type program struct {
-	store  string
-	runner client.ProgramFunc
-}
-
-func newProgram(globalConfig *config.Config) *program {
-	secretStore := globalConfig.UString("wtf.secretStore", "(none)")
-
-	if secretStore == "(none)" {
-		return nil
-	}
-
-	if secretStore == "" {
-		switch runtime.GOOS {
-		case "windows":
-			secretStore = "winrt"
-		case "darwin":
-			secretStore = "osxkeychain"
-		default:
-			secretStore = "secretservice"
-		}
-
-	}
-
-	return &program{
-		secretStore,
-		client.NewShellProgramFunc("docker-credential-" + secretStore),
-	}
-}
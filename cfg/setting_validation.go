@@ -0,0 +1,32 @@
+package cfg
+
+import (
+	"github.com/logrusorgru/aurora"
+)
+
+// settingValidation describes a module setting whose value is not one the module accepts
+type settingValidation struct {
+	err error
+	key string
+}
+
+func newSettingValidation(key string, err error) Validatable {
+	return &settingValidation{err: err, key: key}
+}
+
+func (settingVal *settingValidation) Error() error {
+	return settingVal.err
+}
+
+func (settingVal *settingValidation) HasError() bool {
+	return settingVal.err != nil
+}
+
+func (settingVal *settingValidation) IntValue() int {
+	return 0
+}
+
+// String returns the Stringer representation of the settingValidation
+func (settingVal *settingValidation) String() string {
+	return aurora.Yellow(settingVal.key).String()
+}
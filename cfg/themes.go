@@ -0,0 +1,330 @@
+package cfg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+const (
+	// DefaultThemeName is the name of the theme used when wtf.theme is not set
+	DefaultThemeName = "dark"
+
+	// themesDirName is the directory, in the config directory, that user theme files live in
+	themesDirName = "themes"
+)
+
+// bundledThemes are the themes that ship with WTF. A user theme file with the same name
+// replaces the bundled one
+var bundledThemes = map[string]func() ColorTheme{
+	"dark":          NewDefaultColorTheme,
+	"high-contrast": newHighContrastColorTheme,
+	"light":         newLightColorTheme,
+	"solarized":     newSolarizedColorTheme,
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// LoadTheme returns the named theme. User themes are read from the themes directory in the
+// config directory, i.e. ~/.config/wtf/themes/ocean.yml is the theme named "ocean", and take
+// precedence over the bundled themes
+func LoadTheme(name string) (ColorTheme, error) {
+	themesDir, err := userThemesDir()
+	if err != nil {
+		return NewDefaultColorTheme(), err
+	}
+
+	return loadTheme(themesDir, name)
+}
+
+// ThemeNames returns the names of all the bundled and user themes, in alphabetical order
+func ThemeNames() []string {
+	themesDir, _ := userThemesDir()
+	return themeNamesIn(themesDir)
+}
+
+// ThemeFromConfig returns the theme selected by wtf.theme. An unknown theme falls back to the
+// default theme
+func ThemeFromConfig(globalSettings *config.Config) ColorTheme {
+	theme, err := LoadTheme(globalSettings.UString("wtf.theme", DefaultThemeName))
+	if err != nil {
+		return NewDefaultColorTheme()
+	}
+
+	return theme
+}
+
+// ColorsFromConfig returns the colors a module is displayed in. Colors are layered: the
+// module's own colors override the global colors, which override the theme selected by
+// wtf.theme. The module config may be nil, for the colors of the app itself
+func ColorsFromConfig(moduleConfig *config.Config, globalSettings *config.Config) ColorTheme {
+	colors := ThemeFromConfig(globalSettings)
+
+	if colorsConfig, err := globalSettings.Get("wtf.colors"); err == nil {
+		colors = colors.WithColors(colorsConfig)
+	}
+
+	if moduleConfig == nil {
+		return colors
+	}
+
+	if colorsConfig, err := moduleConfig.Get("colors"); err == nil {
+		colors = colors.WithColors(colorsConfig)
+	}
+
+	return colors
+}
+
+// WithColors returns a copy of the theme with any colors defined in the colors config, which
+// has the same layout as wtf.colors, replacing the theme's own
+func (theme ColorTheme) WithColors(colors *config.Config) ColorTheme {
+	if colors == nil {
+		return theme
+	}
+
+	theme.BorderTheme.Errored = colors.UString("border.errored", theme.BorderTheme.Errored)
+	theme.BorderTheme.Focusable = colors.UString("border.focusable", theme.BorderTheme.Focusable)
+	theme.BorderTheme.Focused = colors.UString("border.focused", theme.BorderTheme.Focused)
+	theme.BorderTheme.Stale = colors.UString("border.stale", theme.BorderTheme.Stale)
+	theme.BorderTheme.Unfocusable = colors.UString("border.normal", theme.BorderTheme.Unfocusable)
+
+	theme.CheckboxTheme.Checked = colors.UString("checked", theme.CheckboxTheme.Checked)
+
+	theme.RowTheme.EvenForeground = colors.UString("rows.even", theme.RowTheme.EvenForeground)
+	theme.RowTheme.OddForeground = colors.UString("rows.odd", theme.RowTheme.OddForeground)
+	theme.RowTheme.HighlightedForeground = colors.UString("highlight.fore", theme.RowTheme.HighlightedForeground)
+	theme.RowTheme.HighlightedBackground = colors.UString("highlight.back", theme.RowTheme.HighlightedBackground)
+
+	theme.StatusTheme.Error = colors.UString("error", theme.StatusTheme.Error)
+	theme.StatusTheme.Muted = colors.UString("muted", theme.StatusTheme.Muted)
+	theme.StatusTheme.Success = colors.UString("success", theme.StatusTheme.Success)
+	theme.StatusTheme.Warning = colors.UString("warning", theme.StatusTheme.Warning)
+
+	theme.TextTheme.Accent = colors.UString("accent", theme.TextTheme.Accent)
+	theme.TextTheme.Label = colors.UString("label", theme.TextTheme.Label)
+	theme.TextTheme.Subheading = colors.UString("subheading", theme.TextTheme.Subheading)
+	theme.TextTheme.Text = colors.UString("text", theme.TextTheme.Text)
+	theme.TextTheme.Title = colors.UString("title", theme.TextTheme.Title)
+
+	theme.WidgetTheme.Background = colors.UString("background", theme.WidgetTheme.Background)
+
+	return theme
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// loadTheme returns the named theme, looking first for a theme file in themesDir. A theme
+// file uses the same keys as wtf.colors, and can start from another theme with extends:
+//
+//	extends: solarized
+//	label: "#268bd2"
+//	rows:
+//	  odd: "#2aa198"
+func loadTheme(themesDir, name string) (ColorTheme, error) {
+	return loadThemeFollowing(themesDir, name, map[string]bool{})
+}
+
+func loadThemeFollowing(themesDir, name string, loading map[string]bool) (ColorTheme, error) {
+	if loading[name] {
+		return NewDefaultColorTheme(), fmt.Errorf("theme %q extends itself", name)
+	}
+	loading[name] = true
+
+	if themesDir != "" {
+		themeFile := filepath.Join(themesDir, name+".yml")
+
+		if data, err := ioutil.ReadFile(filepath.Clean(themeFile)); err == nil {
+			colors, err := config.ParseYamlBytes(data)
+			if err != nil {
+				return NewDefaultColorTheme(), fmt.Errorf("theme %q: %s", name, err.Error())
+			}
+
+			base, err := loadThemeFollowing(themesDir, colors.UString("extends", DefaultThemeName), loading)
+			if err != nil {
+				return NewDefaultColorTheme(), err
+			}
+
+			return base.WithColors(colors), nil
+		}
+	}
+
+	if newTheme, ok := bundledThemes[name]; ok {
+		return newTheme(), nil
+	}
+
+	return NewDefaultColorTheme(), fmt.Errorf("no theme named %q", name)
+}
+
+func themeNamesIn(themesDir string) []string {
+	found := map[string]bool{}
+	for name := range bundledThemes {
+		found[name] = true
+	}
+
+	if themesDir != "" {
+		files, _ := filepath.Glob(filepath.Join(themesDir, "*.yml"))
+		for _, file := range files {
+			found[strings.TrimSuffix(filepath.Base(file), ".yml")] = true
+		}
+	}
+
+	names := []string{}
+	for name := range found {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func userThemesDir() (string, error) {
+	configDir, err := WtfConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, themesDirName), nil
+}
+
+func newHighContrastColorTheme() ColorTheme {
+	return ColorTheme{
+		BorderTheme: BorderTheme{
+			Errored:     "red",
+			Focusable:   "white",
+			Focused:     "yellow",
+			Stale:       "fuchsia",
+			Unfocusable: "silver",
+		},
+
+		CheckboxTheme: CheckboxTheme{
+			Checked: "white",
+		},
+
+		RowTheme: RowTheme{
+			EvenBackground: "black",
+			EvenForeground: "white",
+
+			OddBackground: "black",
+			OddForeground: "yellow",
+
+			HighlightedForeground: "black",
+			HighlightedBackground: "yellow",
+		},
+
+		StatusTheme: StatusTheme{
+			Error:   "red",
+			Muted:   "silver",
+			Success: "lime",
+			Warning: "yellow",
+		},
+
+		TextTheme: TextTheme{
+			Accent:     "yellow",
+			Label:      "aqua",
+			Subheading: "fuchsia",
+			Text:       "white",
+			Title:      "white",
+		},
+
+		WidgetTheme: WidgetTheme{
+			Background: "black",
+		},
+	}
+}
+
+func newLightColorTheme() ColorTheme {
+	return ColorTheme{
+		BorderTheme: BorderTheme{
+			Errored:     "maroon",
+			Focusable:   "navy",
+			Focused:     "darkorange",
+			Stale:       "olive",
+			Unfocusable: "gray",
+		},
+
+		CheckboxTheme: CheckboxTheme{
+			Checked: "gray",
+		},
+
+		RowTheme: RowTheme{
+			EvenBackground: "transparent",
+			EvenForeground: "black",
+
+			OddBackground: "transparent",
+			OddForeground: "navy",
+
+			HighlightedForeground: "white",
+			HighlightedBackground: "teal",
+		},
+
+		StatusTheme: StatusTheme{
+			Error:   "maroon",
+			Muted:   "gray",
+			Success: "darkgreen",
+			Warning: "darkorange",
+		},
+
+		TextTheme: TextTheme{
+			Accent:     "purple",
+			Label:      "navy",
+			Subheading: "maroon",
+			Text:       "black",
+			Title:      "darkgreen",
+		},
+
+		WidgetTheme: WidgetTheme{
+			Background: "transparent",
+		},
+	}
+}
+
+func newSolarizedColorTheme() ColorTheme {
+	return ColorTheme{
+		BorderTheme: BorderTheme{
+			Errored:     "#dc322f",
+			Focusable:   "#268bd2",
+			Focused:     "#b58900",
+			Stale:       "#cb4b16",
+			Unfocusable: "#586e75",
+		},
+
+		CheckboxTheme: CheckboxTheme{
+			Checked: "#586e75",
+		},
+
+		RowTheme: RowTheme{
+			EvenBackground: "transparent",
+			EvenForeground: "#93a1a1",
+
+			OddBackground: "transparent",
+			OddForeground: "#2aa198",
+
+			HighlightedForeground: "#002b36",
+			HighlightedBackground: "#b58900",
+		},
+
+		StatusTheme: StatusTheme{
+			Error:   "#dc322f",
+			Muted:   "#586e75",
+			Success: "#859900",
+			Warning: "#b58900",
+		},
+
+		TextTheme: TextTheme{
+			Accent:     "#6c71c4",
+			Label:      "#268bd2",
+			Subheading: "#cb4b16",
+			Text:       "#93a1a1",
+			Title:      "#859900",
+		},
+
+		WidgetTheme: WidgetTheme{
+			Background: "transparent",
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package cfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeThemes(t *testing.T, themes map[string]string) string {
+	dir, err := ioutil.TempDir("", "wtf-themes")
+	assert.NoError(t, err)
+
+	for name, contents := range themes {
+		err := ioutil.WriteFile(filepath.Join(dir, name+".yml"), []byte(contents), 0600)
+		assert.NoError(t, err)
+	}
+
+	return dir
+}
+
+func Test_loadTheme(t *testing.T) {
+	themesDir := writeThemes(t, map[string]string{
+		"ocean":    "extends: solarized\nlabel: aqua\nrows:\n  odd: teal\n",
+		"plain":    "text: silver\n",
+		"light":    "text: navy\n",
+		"loop":     "extends: loop\n",
+		"broken":   "label: [\n",
+		"orphaned": "extends: nope\n",
+	})
+	defer os.RemoveAll(themesDir)
+
+	tests := []struct {
+		name        string
+		themeName   string
+		check       func(theme ColorTheme)
+		expectedErr string
+	}{
+		{
+			name:      "bundled theme",
+			themeName: "solarized",
+			check: func(theme ColorTheme) {
+				assert.Equal(t, "#268bd2", theme.Label)
+				assert.Equal(t, "#dc322f", theme.Error)
+			},
+		},
+		{
+			name:      "user theme extending a bundled theme",
+			themeName: "ocean",
+			check: func(theme ColorTheme) {
+				assert.Equal(t, "aqua", theme.Label)
+				assert.Equal(t, "teal", theme.OddForeground)
+				assert.Equal(t, "#859900", theme.Success)
+			},
+		},
+		{
+			name:      "user theme extending the default theme",
+			themeName: "plain",
+			check: func(theme ColorTheme) {
+				assert.Equal(t, "silver", theme.Text)
+				assert.Equal(t, "lightblue", theme.Label)
+			},
+		},
+		{
+			name:      "user theme replacing a bundled theme",
+			themeName: "light",
+			check: func(theme ColorTheme) {
+				assert.Equal(t, "navy", theme.Text)
+				assert.Equal(t, "lightblue", theme.Label)
+			},
+		},
+		{
+			name:        "unknown theme",
+			themeName:   "nope",
+			expectedErr: `no theme named "nope"`,
+		},
+		{
+			name:        "theme that extends itself",
+			themeName:   "loop",
+			expectedErr: `theme "loop" extends itself`,
+		},
+		{
+			name:        "invalid theme file",
+			themeName:   "broken",
+			expectedErr: `theme "broken":`,
+		},
+		{
+			name:        "theme that extends an unknown theme",
+			themeName:   "orphaned",
+			expectedErr: `no theme named "nope"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			theme, err := loadTheme(themesDir, tt.themeName)
+
+			if tt.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				assert.Equal(t, NewDefaultColorTheme(), theme)
+				return
+			}
+
+			assert.NoError(t, err)
+			tt.check(theme)
+		})
+	}
+}
+
+func Test_themeNamesIn(t *testing.T) {
+	themesDir := writeThemes(t, map[string]string{"ocean": "", "light": ""})
+	defer os.RemoveAll(themesDir)
+
+	assert.Equal(t, []string{"dark", "high-contrast", "light", "ocean", "solarized"}, themeNamesIn(themesDir))
+	assert.Equal(t, []string{"dark", "high-contrast", "light", "solarized"}, themeNamesIn(""))
+}
+
+func Test_WithColors(t *testing.T) {
+	colors, _ := config.ParseYaml(`
+border:
+  normal: silver
+error: maroon
+highlight:
+  back: blue
+subheading: purple
+`)
+
+	theme := NewDefaultColorTheme().WithColors(colors)
+
+	assert.Equal(t, "silver", theme.Unfocusable)
+	assert.Equal(t, "maroon", theme.Error)
+	assert.Equal(t, "blue", theme.HighlightedBackground)
+	assert.Equal(t, "black", theme.HighlightedForeground)
+	assert.Equal(t, "purple", theme.Subheading)
+	assert.Equal(t, "lightblue", theme.Label)
+
+	assert.Equal(t, NewDefaultColorTheme(), NewDefaultColorTheme().WithColors(nil))
+}
+
+func Test_NewCommonSettingsFromModule_Colors(t *testing.T) {
+	// Keep any user themes out of the way
+	configHome := writeThemes(t, map[string]string{})
+	defer os.RemoveAll(configHome)
+
+	os.Setenv("XDG_CONFIG_HOME", configHome)
+	defer os.Unsetenv("XDG_CONFIG_HOME")
+
+	global, _ := config.ParseYaml(`
+wtf:
+  theme: light
+  colors:
+    label: purple
+`)
+	module, _ := config.ParseYaml(`
+colors:
+  text: silver
+`)
+
+	common := NewCommonSettingsFromModule("test", "Test", true, module, global)
+
+	assert.Equal(t, "silver", common.Colors.Text)
+	assert.Equal(t, "purple", common.Colors.Label)
+	assert.Equal(t, "maroon", common.Colors.Subheading)
+	assert.Equal(t, "darkgreen", common.Colors.Success)
+
+	// The app's own colors come from the theme and the global colors alone
+	appColors := ColorsFromConfig(nil, global)
+
+	assert.Equal(t, "black", appColors.Text)
+	assert.Equal(t, "purple", appColors.Label)
+}
@@ -4,21 +4,23 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
-	"github.com/chzyer/readline"
 	goFlags "github.com/jessevdk/go-flags"
 	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/help"
 )
 
 // Flags is the container for command line flag data
 type Flags struct {
-	Config  string `short:"c" long:"config" optional:"yes" description:"Path to config file"`
-	Module  string `short:"m" long:"module" optional:"yes" description:"Display info about a specific module, i.e.: 'wtfutil -m=todo'"`
-	Profile bool   `short:"p" long:"profile" optional:"yes" description:"Profile application memory usage"`
-	Version bool   `short:"v" long:"version" description:"Show version info"`
+	Config      string `short:"c" long:"config" optional:"yes" description:"Path to config file"`
+	Expand      bool   `long:"expand" optional:"yes" description:"For config --print-merged, replace env var and file references with their values"`
+	Format      string `short:"f" long:"format" optional:"yes" default:"text" description:"Output format for the render command: text, ansi, or json"`
+	Module      string `short:"m" long:"module" optional:"yes" description:"Display info about a specific module, i.e.: 'wtfutil -m=todo'"`
+	PrintMerged bool   `long:"print-merged" optional:"yes" description:"For the config command, print the config with its included files merged in"`
+	Profile     bool   `short:"p" long:"profile" optional:"yes" description:"Serve memory usage metrics, as if wtf.metrics.enabled were set"`
+	Version     bool   `short:"v" long:"version" description:"Show version info"`
 	// Work-around go-flags misfeatures. If any sub-command is defined
 	// then `wtf` (no sub-commands, the common usage), is warned about.
 	Opt struct {
@@ -31,12 +33,57 @@ type Flags struct {
 
 var EXTRA = `
 Commands:
+  config --print-merged [--expand]
+  Print the effective config, with every included file merged in. Env var
+  and file references are printed as written, so that the secrets they
+  hold are not revealed. --expand replaces each one with its value.
+
+  render [module...]
+    module       Name of a module in the config to render. Defaults to all
+                 enabled modules.
+  Refresh each module once and print its content to stdout instead of
+  displaying the dashboard. Use --format to choose between text, ansi
+  and json output.
+
+  schema
+  Print the JSON Schema that describes the config file, including the
+  settings of every module type. Editors can use it to complete and check
+  the config as it is written.
+
+  validate
+  Check the config against the schema without displaying the dashboard.
+  Reports unknown keys, values of the wrong type, and module positions
+  that fall outside the grid. Exits with status 1 if any are found.
+  Deprecated keys are reported as warnings.
+
+  migrate-config
+  Rewrite the deprecated keys and module types in the config file, and in
+  the files it includes, with their replacements. A copy of each file is
+  saved alongside it, as <file>.<timestamp>.bak, before it is changed.
+
   save-secret <service>
     service      Service URL or module name of secret.
   Save a secret into the secret store. The secret will be prompted for.
   Requires wtf.secretStore to be configured.  See individual modules for
   information on what service and secret means for their configuration,
   not all modules use secrets.
+
+  delete-secret <service>
+    service      Service URL or module name of secret.
+  Delete a secret from the secret store.
+
+  list-secrets
+  List the services the secret store has secrets for.
+
+  show-secret-services
+  List the services that the modules in the config load their secrets
+  from, and whether each secret is set in the config, stored in the
+  secret store, or missing.
+
+  wtf.secretStore selects the secret store: "file" for an encrypted file,
+  "env" for WTF_SECRET_<SERVICE> environment variables, or the name of a
+  docker-credential-helpers program. The file's passphrase is read from
+  wtf.secrets.keyFile or WTF_SECRETS_PASSPHRASE, or prompted for.
 `
 
 // NewFlags creates an instance of Flags
@@ -54,9 +101,9 @@ func (flags *Flags) ConfigFilePath() string {
 
 // RenderIf displays special-case information based on the flags passed
 // in, if any flags were passed in
-func (flags *Flags) RenderIf(version, date string, config *config.Config) {
+func (flags *Flags) RenderIf(version, date string, wtfConfig *config.Config) {
 	if flags.HasModule() {
-		help.Display(flags.Module, config)
+		help.Display(flags.Module, wtfConfig)
 		os.Exit(0)
 	}
 
@@ -70,48 +117,74 @@ func (flags *Flags) RenderIf(version, date string, config *config.Config) {
 	}
 
 	switch cmd := flags.Opt.Cmd; cmd {
-	case "save-secret":
-		var service, secret string
-		args := flags.Opt.Args
-
-		if len(args) < 1 || args[0] == "" {
-			fmt.Fprintf(os.Stderr, "save-secret: service required, see `%s --help`\n", os.Args[0])
+	case "config":
+		if !flags.PrintMerged {
+			fmt.Fprintf(os.Stderr, "config: nothing to do, see `%s --help`\n", os.Args[0])
 			os.Exit(1)
 		}
 
-		service = args[0]
+		mergedConfig, err := cfg.MergedWtfConfig(flags.Config, flags.Expand)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "config: %s\n", err.Error())
+			os.Exit(1)
+		}
 
-		if len(args) > 1 {
-			fmt.Fprintf(os.Stderr, "save-secret: too many arguments, see `%s --help`\n", os.Args[0])
+		merged, err := config.RenderYaml(mergedConfig.Root)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "config: %s\n", err.Error())
 			os.Exit(1)
 		}
 
-		b, err := readline.Password("Secret: ")
+		fmt.Print(merged)
+		os.Exit(0)
+	case "render":
+		err := app.Render(wtfConfig, flags.Format, flags.Opt.Args, os.Stdout)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "render: %s\n", err.Error())
 			os.Exit(1)
 		}
-		secret = string(b)
-		secret = strings.TrimSpace(secret)
 
-		if secret == "" {
-			fmt.Fprintf(os.Stderr, "save-secret: secret required, see `%s --help`\n", os.Args[0])
+		os.Exit(0)
+	case "schema":
+		schema, err := app.ConfigSchema()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "schema: %s\n", err.Error())
 			os.Exit(1)
 		}
 
-		err = cfg.StoreSecret(config, &cfg.Secret{
-			Service:  service,
-			Secret:   secret,
-			Username: "default",
-		})
+		fmt.Println(string(schema))
+		os.Exit(0)
+	case "validate":
+		// Deprecated keys still work, so they're reported without failing validation
+		if _, files, err := cfg.ParseWtfConfigFiles(flags.Config); err == nil {
+			deprecations, _ := cfg.FindDeprecations(files)
+			for _, deprecation := range deprecations {
+				fmt.Fprintf(os.Stderr, "warning: %s\n", deprecation)
+			}
+		}
 
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Saving secret for service %q: %s\n", service, err.Error())
+		problems := app.ValidateConfig(wtfConfig)
+		if len(problems) > 0 {
+			for _, problem := range problems {
+				fmt.Fprintln(os.Stderr, problem)
+			}
+
+			fmt.Fprintf(os.Stderr, "validate: %d problem(s) found in %s\n", len(problems), flags.Config)
 			os.Exit(1)
 		}
 
-		fmt.Printf("Saved secret for service %q\n", service)
+		fmt.Printf("%s is valid\n", flags.Config)
 		os.Exit(0)
+	case "migrate-config":
+		migrateConfig(flags.Config)
+	case "save-secret":
+		saveSecret(wtfConfig, flags.Opt.Args)
+	case "delete-secret":
+		deleteSecret(wtfConfig, flags.Opt.Args)
+	case "list-secrets":
+		listSecrets(wtfConfig)
+	case "show-secret-services":
+		showSecretServices(wtfConfig)
 	default:
 		fmt.Fprintf(os.Stderr, "Command `%s` is not supported, try `%s --help`\n", cmd, os.Args[0])
 		os.Exit(1)
@@ -0,0 +1,47 @@
+package flags
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/wtfutil/wtf/cfg"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+// migrateConfig rewrites the deprecated keys and module types in the config file, and in every
+// file it includes, keeping a backup of each file it changes
+func migrateConfig(configPath string) {
+	_, files, err := cfg.ParseWtfConfigFiles(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate-config: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	migrated := 0
+
+	for _, file := range files {
+		changes, backup, err := cfg.MigrateConfigFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "migrate-config: %s\n", err.Error())
+			os.Exit(1)
+		}
+
+		if len(changes) == 0 {
+			continue
+		}
+
+		fmt.Printf("Migrated %s, the original was saved to %s\n", file, backup)
+		for _, change := range changes {
+			fmt.Printf("  %s -> %s\n", change.Key, change.Replacement)
+		}
+
+		migrated++
+	}
+
+	if migrated == 0 {
+		fmt.Printf("%s is up to date\n", configPath)
+	}
+
+	os.Exit(0)
+}
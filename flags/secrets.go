@@ -0,0 +1,201 @@
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/chzyer/readline"
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+/* -------------------- Unexported Functions -------------------- */
+
+func saveSecret(wtfConfig *config.Config, args []string) {
+	service := serviceArg("save-secret", args)
+
+	// A new secrets file is encrypted with the passphrase, so a typo in it would lock the
+	// secrets away
+	prompt := promptForPassphrase
+	if !secretsFileExists(wtfConfig) {
+		prompt = promptForNewPassphrase
+	}
+
+	store := secretStore("save-secret", wtfConfig, prompt)
+
+	b, err := readline.Password("Secret: ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	secret := strings.TrimSpace(string(b))
+
+	if secret == "" {
+		fmt.Fprintf(os.Stderr, "save-secret: secret required, see `%s --help`\n", os.Args[0])
+		os.Exit(1)
+	}
+
+	err = store.Store(&cfg.Secret{
+		Service:  service,
+		Secret:   secret,
+		Username: "default",
+	})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Saving secret for service %q: %s\n", service, err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Printf("Saved secret for service %q\n", service)
+	os.Exit(0)
+}
+
+func deleteSecret(wtfConfig *config.Config, args []string) {
+	service := serviceArg("delete-secret", args)
+	store := secretStore("delete-secret", wtfConfig, promptForPassphrase)
+
+	if err := store.Delete(service); err != nil {
+		fmt.Fprintf(os.Stderr, "Deleting secret for service %q: %s\n", service, err.Error())
+		os.Exit(1)
+	}
+
+	fmt.Printf("Deleted secret for service %q\n", service)
+	os.Exit(0)
+}
+
+func listSecrets(wtfConfig *config.Config) {
+	store := secretStore("list-secrets", wtfConfig, promptForPassphrase)
+
+	services, err := store.List()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "list-secrets: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	for _, service := range services {
+		fmt.Println(service)
+	}
+
+	os.Exit(0)
+}
+
+func showSecretServices(wtfConfig *config.Config) {
+	services, err := app.SecretServices(wtfConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "show-secret-services: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	store, err := cfg.NewSecretStore(wtfConfig, promptForPassphrase)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "show-secret-services: %s\n", err.Error())
+		os.Exit(1)
+	}
+
+	out := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(out, "MODULE\tSERVICE\tSECRET")
+
+	for _, service := range services {
+		fmt.Fprintf(out, "%s\t%s\t%s\n", service.Module, service.Service, secretStatus(store, service))
+	}
+
+	_ = out.Flush()
+	os.Exit(0)
+}
+
+func promptForPassphrase() (string, error) {
+	b, err := readline.Password("Passphrase: ")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+// promptForNewPassphrase asks for the passphrase twice, and fails if the two don't match
+func promptForNewPassphrase() (string, error) {
+	passphrase, err := promptForPassphrase()
+	if err != nil {
+		return "", err
+	}
+
+	b, err := readline.Password("Confirm passphrase: ")
+	if err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(string(b)) != passphrase {
+		return "", errors.New("the passphrases do not match")
+	}
+
+	return passphrase, nil
+}
+
+// secretsFileExists returns true if the file secret store's file has already been created
+func secretsFileExists(wtfConfig *config.Config) bool {
+	path, err := cfg.SecretsFilePath(wtfConfig)
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// secretStatus describes where the service's secret comes from, if anywhere
+func secretStatus(store cfg.SecretStore, service cfg.SecretService) string {
+	if service.Configured {
+		return "in config"
+	}
+
+	if store == nil {
+		return "missing, no secret store"
+	}
+
+	secret, err := store.Get(service.Service)
+	switch {
+	case err != nil:
+		return fmt.Sprintf("unknown, %s", err.Error())
+	case secret == nil:
+		return "missing"
+	default:
+		return fmt.Sprintf("in %s", store.Name())
+	}
+}
+
+// secretStore returns the configured secret store, exiting if there isn't one. The prompt
+// asks for the passphrase of the secrets file, if it is needed
+func secretStore(cmd string, wtfConfig *config.Config, prompt cfg.PassphrasePrompt) cfg.SecretStore {
+	store, err := cfg.NewSecretStore(wtfConfig, prompt)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", cmd, err.Error())
+		os.Exit(1)
+	}
+
+	if store == nil {
+		fmt.Fprintf(os.Stderr, "%s: wtf.secretStore is not configured\n", cmd)
+		os.Exit(1)
+	}
+
+	return store
+}
+
+// serviceArg returns the service named in the command's arguments, exiting if there isn't
+// exactly one
+func serviceArg(cmd string, args []string) string {
+	if len(args) < 1 || args[0] == "" {
+		fmt.Fprintf(os.Stderr, "%s: service required, see `%s --help`\n", cmd, os.Args[0])
+		os.Exit(1)
+	}
+
+	if len(args) > 1 {
+		fmt.Fprintf(os.Stderr, "%s: too many arguments, see `%s --help`\n", cmd, os.Args[0])
+		os.Exit(1)
+	}
+
+	return args[0]
+}
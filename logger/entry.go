@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// timeFormat is how an entry's time is written to the log file
+	timeFormat = "2006-01-02 15:04:05"
+
+	// noModule stands in for the module of entries that weren't logged by one
+	noModule = "-"
+)
+
+// Entry is one line of the log file, such as:
+//
+//	2020-06-14 09:15:02 WARN github: rate limit almost reached
+//
+// Entries not logged by a module have "-" in place of the module name
+type Entry struct {
+	Level   Level
+	Message string
+	Module  string
+	Time    time.Time
+}
+
+// ParseEntry parses and returns the entry in a line of the log file. Lines that aren't
+// entries, such as those written before entries had levels, are returned as info-level
+// entries with the whole line as the message
+func ParseEntry(line string) Entry {
+	fields := strings.SplitN(line, " ", 5)
+	if len(fields) < 5 || !strings.HasSuffix(fields[3], ":") {
+		return Entry{Level: LevelInfo, Message: line}
+	}
+
+	at, err := time.ParseInLocation(timeFormat, fields[0]+" "+fields[1], time.Local)
+	if err != nil {
+		return Entry{Level: LevelInfo, Message: line}
+	}
+
+	level, err := ParseLevel(fields[2])
+	if err != nil {
+		return Entry{Level: LevelInfo, Message: line}
+	}
+
+	module := strings.TrimSuffix(fields[3], ":")
+	if module == noModule {
+		module = ""
+	}
+
+	return Entry{
+		Level:   level,
+		Message: fields[4],
+		Module:  module,
+		Time:    at,
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// String returns the entry as it is written to the log file. Entries always take up a single
+// line, so line breaks in the message are replaced by spaces
+func (entry Entry) String() string {
+	module := entry.Module
+	if module == "" {
+		module = noModule
+	}
+
+	return fmt.Sprintf(
+		"%s %s %s: %s",
+		entry.Time.Format(timeFormat),
+		entry.Level,
+		strings.ReplaceAll(module, " ", "_"),
+		strings.ReplaceAll(entry.Message, "\n", " "),
+	)
+}
@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Entry_String(t *testing.T) {
+	at := time.Date(2020, 6, 14, 9, 15, 2, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		entry    Entry
+		expected string
+	}{
+		{
+			name:     "with module",
+			entry:    Entry{Level: LevelWarn, Message: "rate limit almost reached", Module: "github", Time: at},
+			expected: "2020-06-14 09:15:02 WARN github: rate limit almost reached",
+		},
+		{
+			name:     "without module",
+			entry:    Entry{Level: LevelInfo, Message: "started", Time: at},
+			expected: "2020-06-14 09:15:02 INFO -: started",
+		},
+		{
+			name:     "with line breaks",
+			entry:    Entry{Level: LevelError, Message: "failed\nbadly", Module: "my mod", Time: at},
+			expected: "2020-06-14 09:15:02 ERROR my_mod: failed badly",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.entry.String())
+		})
+	}
+}
+
+func Test_ParseEntry(t *testing.T) {
+	at := time.Date(2020, 6, 14, 9, 15, 2, 0, time.Local)
+
+	tests := []struct {
+		name     string
+		line     string
+		expected Entry
+	}{
+		{
+			name:     "with module",
+			line:     "2020-06-14 09:15:02 WARN github: rate limit: almost reached",
+			expected: Entry{Level: LevelWarn, Message: "rate limit: almost reached", Module: "github", Time: at},
+		},
+		{
+			name:     "without module",
+			line:     "2020-06-14 09:15:02 DEBUG -: started",
+			expected: Entry{Level: LevelDebug, Message: "started", Time: at},
+		},
+		{
+			name:     "old format",
+			line:     "2020/06/14 09:15:02 widget.go:153: Failed to run command",
+			expected: Entry{Level: LevelInfo, Message: "2020/06/14 09:15:02 widget.go:153: Failed to run command"},
+		},
+		{
+			name:     "unknown level",
+			line:     "2020-06-14 09:15:02 LOUD github: hello",
+			expected: Entry{Level: LevelInfo, Message: "2020-06-14 09:15:02 LOUD github: hello"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseEntry(tt.line))
+		})
+	}
+}
+
+func Test_ParseLevel(t *testing.T) {
+	level, err := ParseLevel("warn")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelWarn, level)
+
+	level, err = ParseLevel("ERROR")
+	assert.NoError(t, err)
+	assert.Equal(t, LevelError, level)
+
+	_, err = ParseLevel("loud")
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Level is how severe a log entry is
+type Level int
+
+const (
+	// LevelDebug is for detail that's only useful when tracking down a problem
+	LevelDebug Level = iota
+	// LevelInfo is for things worth knowing happened
+	LevelInfo
+	// LevelWarn is for problems WTF can carry on through
+	LevelWarn
+	// LevelError is for failures
+	LevelError
+)
+
+// Levels are all the levels, least severe first
+var Levels = []Level{LevelDebug, LevelInfo, LevelWarn, LevelError}
+
+// ParseLevel returns the level with the given name, in any case
+func ParseLevel(name string) (Level, error) {
+	for _, level := range Levels {
+		if strings.EqualFold(name, level.String()) {
+			return level, nil
+		}
+	}
+
+	return LevelInfo, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", name)
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// String returns the level's name, as written to the log file
+func (level Level) String() string {
+	switch level {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	}
+
+	return fmt.Sprintf("LEVEL%d", int(level))
+}
@@ -1,33 +1,243 @@
 package logger
 
 import (
-	"log"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/olebedev/config"
 )
 
-/* -------------------- Exported Functions -------------------- */
+const (
+	defaultMaxBackups = 3
+	defaultMaxSize    = 1024
+)
 
-func Log(msg string) {
-	if LogFileMissing() {
-		return
-	}
+var (
+	defaultLogger = NewLogger(&config.Config{Root: map[string]interface{}{}})
+	defaultMutex  = &sync.RWMutex{}
+)
 
-	f, err := os.OpenFile(LogFilePath(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+// Logger writes leveled entries, tagged with the module that logged them, to a log file. The
+// file is rotated once it grows past its maximum size:
+//
+//	wtf:
+//	  log:
+//	    path: "~/.config/wtf/log.txt"
+//	    level: info          <- entries below this level are not written: debug, info, warn or error
+//	    maxSize: 1024        <- the size, in kilobytes, the file is rotated at. 0 never rotates it
+//	    maxBackups: 3        <- how many rotated files are kept, as log.txt.1, log.txt.2, and so on
+//
+// A Logger that can't open its file drops entries rather than stopping WTF
+type Logger struct {
+	file       *os.File
+	level      Level
+	maxBackups int
+	maxSize    int64
+	mutex      sync.Mutex
+	now        func() time.Time
+	path       string
+	size       int64
+}
+
+// NewLogger creates and returns a Logger configured by the log settings in the config. The log
+// file isn't opened until the first entry is written to it
+func NewLogger(config *config.Config) *Logger {
+	level, err := ParseLevel(config.UString("wtf.log.level", LevelInfo.String()))
 	if err != nil {
-		log.Fatalf("error opening file: %v", err)
+		level = LevelInfo
+	}
+
+	return &Logger{
+		level:      level,
+		maxBackups: config.UInt("wtf.log.maxBackups", defaultMaxBackups),
+		maxSize:    int64(config.UInt("wtf.log.maxSize", defaultMaxSize)) * 1024,
+		now:        time.Now,
+		path:       expandHomeDir(config.UString("wtf.log.path", defaultLogFilePath())),
 	}
-	defer func() { _ = f.Close() }()
+}
+
+// SetDefault sets the logger that the package-level functions write to, closing the one it
+// replaces
+func SetDefault(logger *Logger) {
+	defaultMutex.Lock()
+	previous := defaultLogger
+	defaultLogger = logger
+	defaultMutex.Unlock()
+
+	if previous != nil && previous != logger {
+		_ = previous.Close()
+	}
+}
+
+// Default returns the logger that the package-level functions write to
+func Default() *Logger {
+	defaultMutex.RLock()
+	defer defaultMutex.RUnlock()
+
+	return defaultLogger
+}
+
+// Log writes msg to the default logger at the info level, without a module
+func Log(msg string) {
+	_ = Default().Write(LevelInfo, "", msg)
+}
+
+// Debugf writes a debug-level entry for the module to the default logger
+func Debugf(module string, format string, args ...interface{}) {
+	_ = Default().Write(LevelDebug, module, fmt.Sprintf(format, args...))
+}
 
-	log.SetOutput(f)
-	log.Println(msg)
+// Infof writes an info-level entry for the module to the default logger
+func Infof(module string, format string, args ...interface{}) {
+	_ = Default().Write(LevelInfo, module, fmt.Sprintf(format, args...))
 }
 
+// Warnf writes a warn-level entry for the module to the default logger
+func Warnf(module string, format string, args ...interface{}) {
+	_ = Default().Write(LevelWarn, module, fmt.Sprintf(format, args...))
+}
+
+// Errorf writes an error-level entry for the module to the default logger
+func Errorf(module string, format string, args ...interface{}) {
+	_ = Default().Write(LevelError, module, fmt.Sprintf(format, args...))
+}
+
+// LogFileMissing returns TRUE if there is nowhere to write the log file
 func LogFileMissing() bool {
 	return LogFilePath() == ""
 }
 
+// LogFilePath returns the path of the default logger's log file
 func LogFilePath() string {
+	return Default().Path()
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Close closes the log file. It is reopened by the next entry written
+func (logger *Logger) Close() error {
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	return logger.closeFile()
+}
+
+// Level returns the lowest level of entry the logger writes
+func (logger *Logger) Level() Level {
+	return logger.level
+}
+
+// Path returns the path of the log file
+func (logger *Logger) Path() string {
+	return logger.path
+}
+
+// Write writes an entry for the module to the log file, if its level is high enough, first
+// rotating the file if the entry would take it past its maximum size
+func (logger *Logger) Write(level Level, module string, msg string) error {
+	if level < logger.level || logger.path == "" {
+		return nil
+	}
+
+	line := Entry{Level: level, Message: msg, Module: module, Time: logger.now()}.String() + "\n"
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+
+	if err := logger.openFile(); err != nil {
+		return err
+	}
+
+	if logger.maxSize > 0 && logger.size > 0 && logger.size+int64(len(line)) > logger.maxSize {
+		if err := logger.rotate(); err != nil {
+			return err
+		}
+
+		if err := logger.openFile(); err != nil {
+			return err
+		}
+	}
+
+	written, err := logger.file.WriteString(line)
+	logger.size += int64(written)
+
+	return err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (logger *Logger) closeFile() error {
+	if logger.file == nil {
+		return nil
+	}
+
+	err := logger.file.Close()
+	logger.file = nil
+	logger.size = 0
+
+	return err
+}
+
+func (logger *Logger) openFile() error {
+	if logger.file != nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(logger.path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(logger.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	logger.file = file
+	logger.size = stat.Size()
+
+	return nil
+}
+
+// rotate moves the log file to log.txt.1, log.txt.1 to log.txt.2, and so on, dropping the
+// oldest file once there are more than maxBackups of them
+func (logger *Logger) rotate() error {
+	if err := logger.closeFile(); err != nil {
+		return err
+	}
+
+	if logger.maxBackups < 1 {
+		if err := os.Remove(logger.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	_ = os.Remove(backupPath(logger.path, logger.maxBackups))
+
+	for idx := logger.maxBackups - 1; idx >= 1; idx-- {
+		if err := os.Rename(backupPath(logger.path, idx), backupPath(logger.path, idx+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(logger.path, backupPath(logger.path, 1))
+}
+
+func backupPath(path string, idx int) string {
+	return fmt.Sprintf("%s.%d", path, idx)
+}
+
+func defaultLogFilePath() string {
 	dir, err := os.UserHomeDir()
 	if err != nil {
 		return ""
@@ -35,3 +245,17 @@ func LogFilePath() string {
 
 	return filepath.Join(dir, ".config", "wtf", "log.txt")
 }
+
+// expandHomeDir replaces a leading ~ in the path with the user's home directory
+func expandHomeDir(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, strings.TrimPrefix(path, "~"))
+}
@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func testLogger(t *testing.T, yaml string) (*Logger, string) {
+	dir, err := ioutil.TempDir("", "wtf-logger")
+	assert.NoError(t, err)
+
+	path := filepath.Join(dir, "logs", "log.txt")
+
+	logConfig, err := config.ParseYaml("wtf:\n  log:\n    path: " + path + "\n" + yaml)
+	assert.NoError(t, err)
+
+	logger := NewLogger(logConfig)
+	logger.now = func() time.Time { return time.Date(2020, 6, 14, 9, 15, 2, 0, time.Local) }
+
+	return logger, path
+}
+
+func readLines(t *testing.T, path string) []string {
+	contents, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+
+	return strings.Split(strings.TrimSpace(string(contents)), "\n")
+}
+
+func Test_Logger_Write(t *testing.T) {
+	logger, path := testLogger(t, "    level: info\n")
+	defer func() { _ = os.RemoveAll(filepath.Dir(filepath.Dir(path))) }()
+
+	assert.NoError(t, logger.Write(LevelDebug, "github", "not written"))
+	assert.NoError(t, logger.Write(LevelInfo, "github", "written"))
+	assert.NoError(t, logger.Write(LevelError, "", "also written"))
+	assert.NoError(t, logger.Close())
+
+	assert.Equal(
+		t,
+		[]string{
+			"2020-06-14 09:15:02 INFO github: written",
+			"2020-06-14 09:15:02 ERROR -: also written",
+		},
+		readLines(t, path),
+	)
+}
+
+func Test_Logger_Rotate(t *testing.T) {
+	// Each entry is 57 bytes, so a 1KB file holds 17 of them
+	logger, path := testLogger(t, "    maxSize: 1\n    maxBackups: 2\n")
+	defer func() { _ = os.RemoveAll(filepath.Dir(filepath.Dir(path))) }()
+
+	for i := 0; i < 70; i++ {
+		assert.NoError(t, logger.Write(LevelInfo, "github", "an entry of some length"))
+	}
+	assert.NoError(t, logger.Close())
+
+	assert.Equal(t, 70-4*17, len(readLines(t, path)))
+	assert.Equal(t, 17, len(readLines(t, path+".1")))
+	assert.Equal(t, 17, len(readLines(t, path+".2")))
+
+	_, err := os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func Test_SetDefault(t *testing.T) {
+	previous := Default()
+	defer SetDefault(previous)
+
+	logger, path := testLogger(t, "")
+	defer func() { _ = os.RemoveAll(filepath.Dir(filepath.Dir(path))) }()
+
+	SetDefault(logger)
+
+	assert.Equal(t, path, LogFilePath())
+
+	Log("plain")
+	Warnf("github", "%d requests left", 10)
+	Debugf("github", "not written")
+
+	assert.NoError(t, logger.Close())
+	assert.Equal(
+		t,
+		[]string{
+			"2020-06-14 09:15:02 INFO -: plain",
+			"2020-06-14 09:15:02 WARN github: 10 requests left",
+		},
+		readLines(t, path),
+	)
+}
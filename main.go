@@ -18,12 +18,12 @@ import (
 
 	"github.com/logrusorgru/aurora"
 	"github.com/olebedev/config"
-	"github.com/pkg/profile"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/app"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/flags"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -61,9 +61,7 @@ func main() {
 	config := cfg.LoadWtfConfigFile(flags.ConfigFilePath())
 	flags.RenderIf(version, date, config)
 
-	if flags.Profile {
-		defer profile.Start(profile.MemProfile).Stop()
-	}
+	logger.SetDefault(logger.NewLogger(config))
 
 	openFileUtil := config.UString("wtf.openFileUtil", "open")
 	openURLUtil := utils.ToStrs(config.UList("wtf.openUrlUtil", []interface{}{}))
@@ -74,6 +72,17 @@ func main() {
 	// Build the application
 	tviewApp = tview.NewApplication()
 	wtfApp := app.NewWtfApp(tviewApp, config, flags.Config)
+
+	// Memory usage is served, along with the widget refresh metrics, by the metrics server
+	if flags.Profile {
+		wtfApp.EnableProfiling()
+	}
+
+	if err := wtfApp.EnableMouse(); err != nil {
+		fmt.Printf("\n%s %v\n", aurora.Red("ERROR"), err)
+		os.Exit(1)
+	}
+
 	wtfApp.Start()
 
 	if err := tviewApp.Run(); err != nil {
@@ -8,13 +8,20 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (widget *Widget) getBuildStats() string {
+func (widget *Widget) getBuildStats() (string, error) {
 	projName := widget.settings.projectName
 	statusFilter := azrBuild.BuildStatusValues.All
 	top := widget.settings.maxRows
 	builds, err := widget.cli.GetBuilds(widget.ctx, azrBuild.GetBuildsArgs{Project: &projName, StatusFilter: &statusFilter, Top: &top})
 	if err != nil {
-		return errors.Wrap(err, "could not get builds").Error()
+		return "", errors.Wrap(err, "could not get builds")
+	}
+
+	colors := widget.settings.common.Colors
+
+	// Statuses are displayed as white text on a background in the status color
+	badge := func(color, text string) string {
+		return fmt.Sprintf("[white:%s]%s", color, text)
 	}
 
 	result := ""
@@ -28,23 +35,23 @@ func (widget *Widget) getBuildStats() string {
 		}
 		branch = strings.TrimPrefix(branch, "refs/heads/")
 		status := *build.Status
-		statusDisplay := "[white:grey]unknown"
+		statusDisplay := badge(colors.Muted, "unknown")
 		if status == azrBuild.BuildStatusValues.InProgress {
-			statusDisplay = "[white:blue]in progress"
+			statusDisplay = badge(colors.Label, "in progress")
 		} else if status == azrBuild.BuildStatusValues.Cancelling {
-			statusDisplay = "[white:orange]in cancelling"
+			statusDisplay = badge(colors.Warning, "in cancelling")
 		} else if (status == azrBuild.BuildStatusValues.Postponed) || (status == azrBuild.BuildStatusValues.NotStarted) {
-			statusDisplay = "[white:blue]waiting"
+			statusDisplay = badge(colors.Label, "waiting")
 		} else if status == azrBuild.BuildStatusValues.Completed {
 			buildResult := *build.Result
 			if buildResult == azrBuild.BuildResultValues.Succeeded {
-				statusDisplay = "[white:green]succeeded"
+				statusDisplay = badge(colors.Success, "succeeded")
 			} else if buildResult == azrBuild.BuildResultValues.Failed {
-				statusDisplay = "[white:red]failed"
+				statusDisplay = badge(colors.Error, "failed")
 			} else if buildResult == azrBuild.BuildResultValues.Canceled {
-				statusDisplay = "[white:darkgrey]cancelled"
+				statusDisplay = badge(colors.Muted, "cancelled")
 			} else if buildResult == azrBuild.BuildResultValues.PartiallySucceeded {
-				statusDisplay = "[white:magenta]partially"
+				statusDisplay = badge(colors.Accent, "partially")
 			}
 		}
 
@@ -55,5 +62,5 @@ func (widget *Widget) getBuildStats() string {
 		result = "no builds found"
 	}
 
-	return result
+	return result, nil
 }
@@ -26,24 +26,13 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 	}
 
 	widget.View.SetScrollable(true)
-	connection := azr.NewPatConnection(settings.orgURL, settings.apiToken)
-	ctx := context.Background()
-
-	cli, err := azrBuild.NewClient(ctx, connection)
-	if err != nil {
-		widget.displayBuffer = errors.Wrap(err, "could not create client 2").Error()
-	} else {
-		widget.cli = cli
-		widget.ctx = ctx
-	}
-
-	widget.refreshDisplayBuffer()
 
 	return &widget
 }
 
 func (widget *Widget) Refresh() {
-	widget.refreshDisplayBuffer()
+	err := widget.refreshDisplayBuffer()
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.display)
 }
 
@@ -51,9 +40,25 @@ func (widget *Widget) display() (string, string, bool) {
 	return widget.CommonSettings().Title, widget.displayBuffer, true
 }
 
-func (widget *Widget) refreshDisplayBuffer() {
+// refreshDisplayBuffer rebuilds the widget's content from Azure DevOps. If the builds can't
+// be fetched the previous content is left in place and the error is returned
+func (widget *Widget) refreshDisplayBuffer() error {
 	if widget.cli == nil {
-		return
+		connection := azr.NewPatConnection(widget.settings.orgURL, widget.settings.apiToken)
+		ctx := context.Background()
+
+		cli, err := azrBuild.NewClient(ctx, connection)
+		if err != nil {
+			return errors.Wrap(err, "could not create client")
+		}
+
+		widget.cli = cli
+		widget.ctx = ctx
+	}
+
+	buildStats, err := widget.getBuildStats()
+	if err != nil {
+		return err
 	}
 
 	widget.displayBuffer = ""
@@ -62,5 +67,7 @@ func (widget *Widget) refreshDisplayBuffer() {
 		widget.settings.labelColor,
 		widget.settings.projectName)
 
-	widget.displayBuffer += widget.getBuildStats()
+	widget.displayBuffer += buildStats
+
+	return nil
 }
@@ -38,12 +38,16 @@ func (widget *Widget) Refresh() {
 		widget.settings.subdomain,
 	)
 
-	widget.items = client.Away(
+	items, err := client.Away(
 		"timeOff",
 		time.Now().Local().Format(wtf.DateFormat),
 		time.Now().Local().Format(wtf.DateFormat),
 	)
+	if err == nil {
+		widget.items = items
+	}
 
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -52,7 +56,7 @@ func (widget *Widget) Refresh() {
 func (widget *Widget) content() (string, string, bool) {
 	str := ""
 	if len(widget.items) == 0 {
-		str = fmt.Sprintf("\n\n\n\n\n\n\n\n%s", utils.CenterText("[grey]no one[white]", 50))
+		str = fmt.Sprintf("\n\n\n\n\n\n\n\n%s", utils.CenterText(fmt.Sprintf("[%s]no one[-]", widget.settings.common.Colors.Muted), 50))
 	} else {
 		for _, item := range widget.items {
 			str += widget.format(item)
@@ -66,9 +70,9 @@ func (widget *Widget) format(item Item) string {
 	var str string
 
 	if item.IsOneDay() {
-		str = fmt.Sprintf(" [green]%s[white]\n %s\n\n", item.Name(), item.PrettyEnd())
+		str = fmt.Sprintf(" [%s]%s[-]\n %s\n\n", widget.settings.common.Colors.Title, item.Name(), item.PrettyEnd())
 	} else {
-		str = fmt.Sprintf(" [green]%s[white]\n %s - %s\n\n", item.Name(), item.PrettyStart(), item.PrettyEnd())
+		str = fmt.Sprintf(" [%s]%s[-]\n %s - %s\n\n", widget.settings.common.Colors.Title, item.Name(), item.PrettyStart(), item.PrettyEnd())
 	}
 
 	return str
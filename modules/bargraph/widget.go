@@ -50,7 +50,7 @@ func MakeGraph(widget *Widget) {
 		bar := view.Bar{
 			Label:      barTime.Format("15:04"),
 			Percent:    rand.Intn(100-5) + 5,
-			LabelColor: "red",
+			LabelColor: widget.CommonSettings().Colors.Subheading,
 		}
 
 		stats[i] = bar
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+
+	"github.com/wtfutil/wtf/cfg"
 )
 
 type pipelinesDisplayData struct {
@@ -11,14 +13,14 @@ type pipelinesDisplayData struct {
 	orderedPipelines  []string
 }
 
-func (data *pipelinesDisplayData) Content() string {
+func (data *pipelinesDisplayData) Content(colors cfg.ColorTheme) string {
 	maxPipelineLength := getLongestLength(data.orderedPipelines)
 
 	str := ""
 	for _, pipeline := range data.orderedPipelines {
-		str += fmt.Sprintf("[white]%s", padRight(pipeline, maxPipelineLength))
+		str += fmt.Sprintf("[-]%s", padRight(pipeline, maxPipelineLength))
 		for _, build := range data.buildsForPipeline[pipeline] {
-			str += fmt.Sprintf("  [%s]%s[white]", buildColor(build.State), build.Branch)
+			str += fmt.Sprintf("  [%s]%s[-]", buildColor(build.State, colors), build.Branch)
 		}
 		str += "\n"
 	}
@@ -108,13 +110,13 @@ func padRight(text string, length int) string {
 	return text + strings.Repeat(" ", padLength)
 }
 
-func buildColor(state string) string {
+func buildColor(state string, colors cfg.ColorTheme) string {
 	switch state {
 	case "passed":
-		return "green"
+		return colors.Success
 	case "failed":
-		return "red"
+		return colors.Error
 	default:
-		return "yellow"
+		return colors.Warning
 	}
 }
@@ -17,7 +17,6 @@ type Widget struct {
 	settings *Settings
 
 	builds []Build
-	err    error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -39,15 +38,11 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 func (widget *Widget) Refresh() {
 	builds, err := widget.getBuilds()
-
-	if err != nil {
-		widget.err = err
-		widget.builds = nil
-	} else {
+	if err == nil {
 		widget.builds = builds
-		widget.err = nil
 	}
 
+	widget.SetRefreshError(err)
 	// The last call should always be to the display function
 	widget.display()
 }
@@ -59,13 +54,9 @@ func (widget *Widget) display() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-	title := fmt.Sprintf("%s - [green]%s", widget.CommonSettings().Title, widget.settings.orgSlug)
-
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
+	title := fmt.Sprintf("%s - [%s]%s", widget.CommonSettings().Title, widget.settings.common.Colors.Title, widget.settings.orgSlug)
 
 	displayData := NewPipelinesDisplayData(widget.builds)
 
-	return title, displayData.Content(), false
+	return title, displayData.Content(widget.settings.common.Colors), false
 }
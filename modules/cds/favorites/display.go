@@ -35,19 +35,19 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) title(workflow *sdk.Workflow) string {
 	return fmt.Sprintf(
-		"[%s]%s/%s[white]",
+		"[%s]%s/%s[-]",
 		widget.settings.common.Colors.TextTheme.Title,
 		workflow.ProjectKey, workflow.Name,
 	)
 }
 
 func (widget *Widget) displayWorkflowRuns(workflow *sdk.Workflow) string {
-	runs, _ := widget.client.WorkflowRunList(workflow.ProjectKey, workflow.Name, 0, 16)
+	runs := widget.runs[workflowKey(workflow)]
 
 	widget.SetItemCount(len(runs))
 
 	if len(runs) == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	content := ""
@@ -65,7 +65,7 @@ func (widget *Widget) displayWorkflowRuns(workflow *sdk.Workflow) string {
 				tags = fmt.Sprintf("%s%s:%s ", tags, tag.Tag, tag.Value)
 			}
 		}
-		content += fmt.Sprintf(`[%s]["%d"]%d %-6s[""][gray] %s`, getStatusColor(run.Status), idx, run.Number, run.Status, tags)
+		content += fmt.Sprintf(`[%s]["%d"]%d %-6s[""][%s] %s`, widget.statusColor(run.Status), idx, run.Number, run.Status, widget.settings.common.Colors.Muted, tags)
 		content += "\n"
 		widget.Items = append(widget.Items, run.Number)
 	}
@@ -73,20 +73,22 @@ func (widget *Widget) displayWorkflowRuns(workflow *sdk.Workflow) string {
 	return content
 }
 
-func getStatusColor(status string) string {
+func (widget *Widget) statusColor(status string) string {
+	colors := widget.settings.common.Colors
+
 	switch status {
 	case sdk.StatusSuccess:
-		return "green"
+		return colors.Success
 	case sdk.StatusBuilding, sdk.StatusWaiting:
-		return "blue"
+		return colors.Label
 	case sdk.StatusFail:
-		return "red"
+		return colors.Error
 	case sdk.StatusStopped:
-		return "red"
+		return colors.Error
 	case sdk.StatusSkipped:
-		return "grey"
+		return colors.Muted
 	case sdk.StatusDisabled:
-		return "grey"
+		return colors.Muted
 	}
-	return "red"
+	return colors.Error
 }
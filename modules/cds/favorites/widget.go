@@ -18,6 +18,7 @@ type Widget struct {
 	view.TextWidget
 
 	workflows []sdk.Workflow
+	runs      map[string][]sdk.WorkflowRun
 
 	client cdsclient.Interface
 
@@ -35,12 +36,13 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
 		settings: settings,
+		runs:     map[string][]sdk.WorkflowRun{},
 	}
 
 	widget.initializeKeyboardControls()
 	widget.View.SetRegions(true)
 	widget.View.SetInputCapture(widget.InputCapture)
-	widget.SetDisplayFunction(widget.display)
+	widget.SetDisplayFunction(widget.Refresh)
 
 	widget.Unselect()
 	widget.KeyboardWidget.SetView(widget.View)
@@ -56,7 +58,6 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		widget.settings.uiURL = config.URLUI
 	}
 
-	widget.workflows = widget.buildWorkflowsCollection()
 	return &widget
 }
 
@@ -107,6 +108,8 @@ func (widget *Widget) Unselect() {
 
 // Refresh reloads the data
 func (widget *Widget) Refresh() {
+	err := widget.refreshWorkflows()
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -117,15 +120,47 @@ func (widget *Widget) HelpText() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) buildWorkflowsCollection() []sdk.Workflow {
+func (widget *Widget) buildWorkflowsCollection() ([]sdk.Workflow, error) {
 	workflows := []sdk.Workflow{}
-	data, _ := widget.client.Navbar()
+	data, err := widget.client.Navbar()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, v := range data {
 		if v.Favorite && v.WorkflowName != "" {
 			workflows = append(workflows, sdk.Workflow{ProjectKey: v.Key, Name: v.WorkflowName})
 		}
 	}
-	return workflows
+	return workflows, nil
+}
+
+// refreshWorkflows reloads the favorite workflows and the runs of the one being displayed.
+// If either fails to load, the data from the last successful refresh is kept
+func (widget *Widget) refreshWorkflows() error {
+	workflows, err := widget.buildWorkflowsCollection()
+	if err != nil {
+		return err
+	}
+	widget.workflows = workflows
+
+	workflow := widget.currentCDSWorkflow()
+	if workflow == nil {
+		return nil
+	}
+
+	runs, err := widget.client.WorkflowRunList(workflow.ProjectKey, workflow.Name, 0, 16)
+	if err != nil {
+		return err
+	}
+	widget.runs[workflowKey(workflow)] = runs
+
+	return nil
+}
+
+// workflowKey identifies a workflow's runs
+func workflowKey(workflow *sdk.Workflow) string {
+	return workflow.ProjectKey + "/" + workflow.Name
 }
 
 func (widget *Widget) currentCDSWorkflow() *sdk.Workflow {
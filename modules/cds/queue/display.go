@@ -33,7 +33,7 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) title(filter string) string {
 	return fmt.Sprintf(
-		"[%s]%d - %s[white]",
+		"[%s]%d - %s[-]",
 		widget.settings.common.Colors.TextTheme.Title,
 		widget.maxItems,
 		filter,
@@ -41,18 +41,18 @@ func (widget *Widget) title(filter string) string {
 }
 
 func (widget *Widget) displayQueue(filter string) string {
-	runs, _ := widget.client.QueueWorkflowNodeJobRun(filter)
+	runs := widget.jobs[filter]
 
 	widget.SetItemCount(len(runs))
 
 	if len(runs) == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	var content string
 	for idx, job := range runs {
-		content += fmt.Sprintf(`[grey]["%d"]%s`,
-			idx, widget.generateQueueJobLine(job.ID, job.Parameters, job.Job, time.Since(job.Queued), job.BookedBy, job.Status))
+		content += fmt.Sprintf(`[%s]["%d"]%s`,
+			widget.settings.common.Colors.Muted, idx, widget.generateQueueJobLine(job.ID, job.Parameters, job.Job, time.Since(job.Queued), job.BookedBy, job.Status))
 
 		widget.Items = append(widget.Items, job)
 	}
@@ -84,16 +84,18 @@ func (widget *Widget) generateQueueJobLine(id int64, parameters []sdk.Parameter,
 
 	row[4] = fmt.Sprintf("➤ %s", pad(triggeredBy, 17))
 
-	c := "grey"
+	colors := widget.settings.common.Colors
+
+	c := colors.Muted
 	if status == sdk.StatusWaiting {
 		if duration > 120*time.Second {
-			c = "red"
+			c = colors.Error
 		} else if duration > 50*time.Second {
-			c = "yellow"
+			c = colors.Warning
 		}
 	}
 
-	return fmt.Sprintf("[%s]%s [grey]%s %s %s %s\n", c, row[0], row[1], row[2], row[3], row[4])
+	return fmt.Sprintf("[%s]%s [%s]%s %s %s %s\n", c, row[0], colors.Muted, row[1], row[2], row[3], row[4])
 }
 
 func pad(t string, size int) string {
@@ -18,6 +18,7 @@ type Widget struct {
 	view.TextWidget
 
 	filters []string
+	jobs    map[string][]sdk.WorkflowNodeJobRun
 
 	client cdsclient.Interface
 
@@ -35,12 +36,13 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
 		settings: settings,
+		jobs:     map[string][]sdk.WorkflowNodeJobRun{},
 	}
 
 	widget.initializeKeyboardControls()
 	widget.View.SetRegions(true)
 	widget.View.SetInputCapture(widget.InputCapture)
-	widget.SetDisplayFunction(widget.display)
+	widget.SetDisplayFunction(widget.Refresh)
 
 	widget.Unselect()
 	widget.filters = []string{sdk.StatusWaiting, sdk.StatusBuilding}
@@ -108,6 +110,14 @@ func (widget *Widget) Unselect() {
 
 // Refresh reloads the data
 func (widget *Widget) Refresh() {
+	filter := widget.currentFilter()
+
+	jobs, err := widget.client.QueueWorkflowNodeJobRun(filter)
+	if err == nil {
+		widget.jobs[filter] = jobs
+	}
+
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -25,10 +25,9 @@ func (widget *Widget) content() (string, string, bool) {
 }
 
 func (widget *Widget) displayStatus() string {
-	status, err := widget.client.MonStatus()
-
-	if err != nil || len(status.Lines) == 0 {
-		return fmt.Sprintf(" [red]Error: %v[white]\n", err.Error())
+	status := widget.status
+	if status == nil || len(status.Lines) == 0 {
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	widget.SetItemCount(len(status.Lines))
@@ -58,30 +57,32 @@ func (widget *Widget) displayStatus() string {
 			red = append(red, line.String())
 		}
 	}
+	colors := widget.settings.common.Colors
+
 	var idx int
 	var content string
 	for _, v := range globalRed {
-		content += fmt.Sprintf("[grey][\"%d\"][red]%s\n", idx, v)
+		content += fmt.Sprintf("[%s][\"%d\"][%s]%s\n", colors.Muted, idx, colors.Error, v)
 		idx++
 	}
 	for _, v := range globalWarn {
-		content += fmt.Sprintf("[grey][\"%d\"][yellow]%s\n", idx, v)
+		content += fmt.Sprintf("[%s][\"%d\"][%s]%s\n", colors.Muted, idx, colors.Warning, v)
 		idx++
 	}
 	for _, v := range global {
-		content += fmt.Sprintf("[grey][\"%d\"][grey]%s\n", idx, v)
+		content += fmt.Sprintf("[%s][\"%d\"][%s]%s\n", colors.Muted, idx, colors.Muted, v)
 		idx++
 	}
 	for _, v := range red {
-		content += fmt.Sprintf("[grey][\"%d\"][red]%s\n", idx, v)
+		content += fmt.Sprintf("[%s][\"%d\"][%s]%s\n", colors.Muted, idx, colors.Error, v)
 		idx++
 	}
 	for _, v := range warn {
-		content += fmt.Sprintf("[grey][\"%d\"][yellow]%s\n", idx, v)
+		content += fmt.Sprintf("[%s][\"%d\"][%s]%s\n", colors.Muted, idx, colors.Warning, v)
 		idx++
 	}
 	for _, v := range ok {
-		content += fmt.Sprintf("[grey][\"%d\"][grey]%s\n", idx, v)
+		content += fmt.Sprintf("[%s][\"%d\"][%s]%s\n", colors.Muted, idx, colors.Muted, v)
 		idx++
 	}
 	return content
@@ -18,6 +18,7 @@ type Widget struct {
 	view.TextWidget
 
 	filters []string
+	status  *sdk.MonitoringStatus
 
 	client cdsclient.Interface
 
@@ -108,6 +109,12 @@ func (widget *Widget) Unselect() {
 
 // Refresh reloads the data
 func (widget *Widget) Refresh() {
+	status, err := widget.client.MonStatus()
+	if err == nil {
+		widget.status = status
+	}
+
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -12,6 +12,7 @@ type Widget struct {
 	*Client
 
 	settings *Settings
+	builds   []*Build
 }
 
 func NewWidget(app *tview.Application, settings *Settings) *Widget {
@@ -32,51 +33,51 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
+	builds, err := widget.Client.BuildsFor()
+	if err == nil {
+		widget.builds = builds
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
-	builds, err := widget.Client.BuildsFor()
-
 	title := fmt.Sprintf("%s - Builds", widget.CommonSettings().Title)
 	var str string
-	wrap := false
-	if err != nil {
-		wrap = true
-		str = err.Error()
-	} else {
-		for idx, build := range builds {
-			if idx > 10 {
-				break
-			}
-
-			str += fmt.Sprintf(
-				"[%s] %s-%d (%s) [white]%s\n",
-				buildColor(build),
-				build.Reponame,
-				build.BuildNum,
-				build.Branch,
-				build.AuthorName,
-			)
+	for idx, build := range widget.builds {
+		if idx > 10 {
+			break
 		}
+
+		str += fmt.Sprintf(
+			"[%s] %s-%d (%s) [-]%s\n",
+			widget.buildColor(build),
+			build.Reponame,
+			build.BuildNum,
+			build.Branch,
+			build.AuthorName,
+		)
 	}
 
-	return title, str, wrap
+	return title, str, false
 }
 
-func buildColor(build *Build) string {
+func (widget *Widget) buildColor(build *Build) string {
+	colors := widget.settings.common.Colors
+
 	switch build.Status {
 	case "failed":
-		return "red"
+		return colors.Error
 	case "running":
-		return "yellow"
+		return colors.Warning
 	case "success":
-		return "green"
+		return colors.Success
 	case "fixed":
-		return "green"
+		return colors.Success
 	default:
-		return "white"
+		return colors.Text
 	}
 }
@@ -10,7 +10,7 @@ func (widget *Widget) display(clocks []Clock, dateFormat string, timeFormat stri
 	} else {
 		for idx, clock := range clocks {
 			str += fmt.Sprintf(
-				" [%s]%-12s %-10s %7s[white]\n",
+				" [%s]%-12s %-10s %7s[-]\n",
 				widget.CommonSettings().RowColor(idx),
 				clock.Label,
 				clock.Time(timeFormat),
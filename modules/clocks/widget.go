@@ -2,6 +2,7 @@ package clocks
 
 import (
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/rules"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -36,12 +37,29 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 func (widget *Widget) Refresh() {
 	widget.app.QueueUpdateDraw(func() {
 		sortedClocks := widget.clockColl.Sorted(widget.settings.sort)
+		widget.SetValues(valuesFrom(sortedClocks))
 		widget.display(sortedClocks, widget.dateFormat, widget.timeFormat)
 	})
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
+// valuesFrom returns the values rules can be written against, for each clock: hour.<label>,
+// the hour of the day, 0 to 23, and weekday.<label>, the day of the week, 0 for Sunday to 6
+// for Saturday
+func valuesFrom(clocks []Clock) map[string]interface{} {
+	values := map[string]interface{}{}
+
+	for _, clock := range clocks {
+		localTime := clock.LocalTime()
+
+		values[rules.ValueName("hour", clock.Label)] = localTime.Hour()
+		values[rules.ValueName("weekday", clock.Label)] = int(localTime.Weekday())
+	}
+
+	return values
+}
+
 func (widget *Widget) buildClockCollection() ClockCollection {
 	clockColl := ClockCollection{}
 
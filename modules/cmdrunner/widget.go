@@ -130,9 +130,7 @@ func runCommandLoop(widget *Widget) {
 		} else {
 			err = runCommand(widget, cmd)
 		}
-		if err != nil {
-			widget.handleError(err)
-		}
+		widget.SetRefreshError(err)
 		widget.redrawChan <- true
 	}
 }
@@ -153,15 +151,6 @@ func runCommandPty(widget *Widget, cmd *exec.Cmd) error {
 	return err
 }
 
-func (widget *Widget) handleError(err error) {
-	widget.m.Lock()
-	defer widget.m.Unlock()
-	_, writeErr := widget.buffer.WriteString(err.Error())
-	if writeErr != nil {
-		return
-	}
-}
-
 func redrawLoop(widget *Widget) {
 	for {
 		widget.Redraw(widget.content)
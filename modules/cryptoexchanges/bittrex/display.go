@@ -12,10 +12,6 @@ func (widget *Widget) display() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-	if !ok {
-		return widget.CommonSettings().Title, errorText, true
-	}
-
 	list := &widget.summaryList
 	str := ""
 
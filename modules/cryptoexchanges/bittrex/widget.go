@@ -2,6 +2,7 @@ package bittrex
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,11 +16,6 @@ const (
 	baseURL = "https://bittrex.com/api/v1.1/public/getmarketsummary"
 )
 
-var (
-	errorText = ""
-	ok        = true
-)
-
 // Widget define wtf widget to register widget later
 type Widget struct {
 	view.TextWidget
@@ -37,9 +33,6 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 		summaryList: summaryList{},
 	}
 
-	ok = true
-	errorText = ""
-
 	widget.setSummaryList()
 
 	return &widget
@@ -80,18 +73,19 @@ func makeMarketCurrency(name string) *mCurrency {
 
 // Refresh & update after interval time
 func (widget *Widget) Refresh() {
-	widget.updateSummary()
+	err := widget.updateSummary()
+	widget.SetRefreshError(err)
 
 	widget.display()
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) updateSummary() {
+func (widget *Widget) updateSummary() (err error) {
 	// In case if anything bad happened!
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Println("recovered in updateSummary()", r)
+			err = fmt.Errorf("recovered in updateSummary(): %v", r)
 		}
 	}()
 
@@ -103,23 +97,13 @@ func (widget *Widget) updateSummary() {
 		for _, mCurrency := range baseCurrency.markets {
 			request := makeRequest(baseCurrency.name, mCurrency.name)
 			response, err := client.Do(request)
-
 			if err != nil {
-				ok = false
-				errorText = "Please Check Your Internet Connection!"
-				break
-			} else {
-				ok = true
-				errorText = ""
+				return err
 			}
 
 			if response.StatusCode != http.StatusOK {
-				errorText = response.Status
-				ok = false
-				break
-			} else {
-				ok = true
-				errorText = ""
+				_ = response.Body.Close()
+				return errors.New(response.Status)
 			}
 
 			defer func() { _ = response.Body.Close() }()
@@ -127,17 +111,12 @@ func (widget *Widget) updateSummary() {
 			decoder := json.NewDecoder(response.Body)
 			err = decoder.Decode(&jsonResponse)
 			if err != nil {
-				errorText = "Could not parse JSON!"
-				break
+				return fmt.Errorf("could not parse JSON: %w", err)
 			}
 
 			if !jsonResponse.Success {
-				ok = false
-				errorText = fmt.Sprintf("%s-%s: %s", baseCurrency.name, mCurrency.name, jsonResponse.Message)
-				break
+				return fmt.Errorf("%s-%s: %s", baseCurrency.name, mCurrency.name, jsonResponse.Message)
 			}
-			ok = true
-			errorText = ""
 
 			mCurrency.Last = fmt.Sprintf("%f", jsonResponse.Result[0].Last)
 			mCurrency.High = fmt.Sprintf("%f", jsonResponse.Result[0].High)
@@ -148,7 +127,7 @@ func (widget *Widget) updateSummary() {
 		}
 	}
 
-	widget.display()
+	return nil
 }
 
 func makeRequest(baseName, marketName string) *http.Request {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 
 	"github.com/rivo/tview"
@@ -15,6 +14,7 @@ type Widget struct {
 	view.TextWidget
 
 	device_token string
+	positions    *AllPositionsResponse
 	settings     *Settings
 }
 
@@ -32,16 +32,21 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
+	positions, err := Fetch(widget.device_token)
+	if err == nil {
+		widget.positions = positions
+	}
 
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 func (widget *Widget) content() (string, string, bool) {
-	positions, err := Fetch(widget.device_token)
 	title := widget.CommonSettings().Title
-	if err != nil {
-		return title, err.Error(), true
+	positions := widget.positions
+	if positions == nil {
+		return title, "", true
 	}
 
 	res := ""
@@ -124,12 +129,14 @@ func MakeApiRequest(token string, method string) ([]byte, error) {
 }
 
 func GetAllPositions(token string) (*AllPositionsResponse, error) {
-	jsn, _ := MakeApiRequest(token, "get_all_positions")
+	jsn, err := MakeApiRequest(token, "get_all_positions")
+	if err != nil {
+		return nil, err
+	}
 	var parsed AllPositionsResponse
 
-	err := json.Unmarshal(jsn, &parsed)
+	err = json.Unmarshal(jsn, &parsed)
 	if err != nil {
-		log.Fatalf("Failed to parse json %v", err)
 		return nil, err
 	}
 	return &parsed, err
@@ -9,7 +9,6 @@ import (
 )
 
 var baseURL = "https://min-api.cryptocompare.com/data/price"
-var ok = true
 
 // Widget define wtf widget to register widget later
 type Widget struct {
@@ -17,6 +16,7 @@ type Widget struct {
 	settings *Settings
 
 	Result string
+	Err    error
 
 	RefreshInterval int
 }
@@ -46,10 +46,8 @@ func (widget *Widget) setList() {
 // Refresh & update after interval time
 func (widget *Widget) Refresh(wg *sync.WaitGroup) {
 	if len(widget.list.items) != 0 {
-		widget.updateCurrencies()
-		if !ok {
-			widget.Result = "Please check your internet connection"
-		} else {
+		widget.Err = widget.updateCurrencies()
+		if widget.Err == nil {
 			widget.display()
 		}
 	}
@@ -97,10 +95,10 @@ func (widget *Widget) getToList(symbol string) []*toCurrency {
 	return toList
 }
 
-func (widget *Widget) updateCurrencies() {
+func (widget *Widget) updateCurrencies() (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Println("recovered in updateSummary()", r)
+			err = fmt.Errorf("recovered in updateCurrencies(): %v", r)
 		}
 	}()
 	for _, fromCurrency := range widget.list.items {
@@ -116,19 +114,21 @@ func (widget *Widget) updateCurrencies() {
 
 		request := makeRequest(fromCurrency)
 		response, err := client.Do(request)
-
 		if err != nil {
-			ok = false
-		} else {
-			ok = true
+			return err
 		}
 
 		defer func() { _ = response.Body.Close() }()
 
-		_ = json.NewDecoder(response.Body).Decode(&jsonResponse)
+		err = json.NewDecoder(response.Body).Decode(&jsonResponse)
+		if err != nil {
+			return err
+		}
 
 		setPrices(&jsonResponse, fromCurrency)
 	}
+
+	return nil
 }
 
 func makeRequest(currency *fromCurrency) *http.Request {
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
 	"sync"
 	"time"
 )
@@ -14,6 +13,7 @@ var baseURL = "https://min-api.cryptocompare.com/data/top/exchanges"
 // Widget Toplist Widget
 type Widget struct {
 	Result string
+	Err    error
 
 	RefreshInterval int
 
@@ -57,19 +57,20 @@ func (widget *Widget) makeToList(symbol string, limit int) (list []*tCurrency) {
 func (widget *Widget) Refresh(wg *sync.WaitGroup) {
 	if len(widget.list.items) != 0 {
 
-		widget.updateData()
-
-		widget.display()
+		widget.Err = widget.updateData()
+		if widget.Err == nil {
+			widget.display()
+		}
 	}
 	wg.Done()
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) updateData() {
+func (widget *Widget) updateData() (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Println("recovered in updateSummary()", r)
+			err = fmt.Errorf("recovered in updateData(): %v", r)
 		}
 	}()
 
@@ -81,14 +82,17 @@ func (widget *Widget) updateData() {
 		for _, toCurrency := range fromCurrency.to {
 
 			request := makeRequest(fromCurrency.name, toCurrency.name, fromCurrency.limit)
-			response, _ := client.Do(request)
+			response, err := client.Do(request)
+			if err != nil {
+				return err
+			}
 
 			var jsonResponse responseInterface
 
-			err := json.NewDecoder(response.Body).Decode(&jsonResponse)
-
+			err = json.NewDecoder(response.Body).Decode(&jsonResponse)
+			_ = response.Body.Close()
 			if err != nil {
-				os.Exit(1)
+				return err
 			}
 
 			for idx, info := range jsonResponse.Data {
@@ -101,6 +105,8 @@ func (widget *Widget) updateData() {
 
 		}
 	}
+
+	return nil
 }
 
 func makeRequest(fsym, tsym string, limit int) *http.Request {
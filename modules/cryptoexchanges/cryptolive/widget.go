@@ -46,6 +46,12 @@ func (widget *Widget) Refresh() {
 	widget.toplistWidget.Refresh(&wg)
 	wg.Wait()
 
+	err := widget.priceWidget.Err
+	if err == nil {
+		err = widget.toplistWidget.Err
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
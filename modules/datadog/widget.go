@@ -15,7 +15,6 @@ type Widget struct {
 
 	monitors []datadog.Monitor
 	settings *Settings
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -38,14 +37,11 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	widget.err = nil
-	monitors, monitorErr := widget.Monitors()
-
-	if monitorErr != nil {
-		widget.monitors = nil
-		widget.err = monitorErr
-		widget.SetItemCount(0)
-		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, monitorErr.Error(), true })
+	monitors, err := widget.Monitors()
+	widget.SetRefreshError(err)
+
+	if err != nil {
+		widget.Render()
 		return
 	}
 	triggeredMonitors := []datadog.Monitor{}
@@ -78,21 +74,22 @@ func (widget *Widget) content() (string, string, bool) {
 
 	title := widget.CommonSettings().Title
 
-	if widget.err != nil {
-		return title, widget.err.Error(), true
+	if triggeredMonitors == nil {
+		return title, "", false
 	}
 
 	if len(triggeredMonitors) > 0 {
 		str += fmt.Sprintf(
 			" %s\n",
 			fmt.Sprintf(
-				"[%s]Triggered Monitors[white]",
+				"[%s]Triggered Monitors[-]",
 				widget.settings.common.Colors.Subheading,
 			),
 		)
 		for idx, triggeredMonitor := range triggeredMonitors {
-			row := fmt.Sprintf(`[%s][red] %s[%s]`,
+			row := fmt.Sprintf(`[%s][%s] %s[%s]`,
 				widget.RowColor(idx),
+				widget.settings.common.Colors.Error,
 				*triggeredMonitor.Name,
 				widget.RowColor(idx),
 			)
@@ -101,7 +98,7 @@ func (widget *Widget) content() (string, string, bool) {
 	} else {
 		str += fmt.Sprintf(
 			" %s\n",
-			"[green]No Triggered Monitors[white]",
+			fmt.Sprintf("[%s]No Triggered Monitors[-]", widget.settings.common.Colors.Success),
 		)
 	}
 
@@ -16,7 +16,6 @@ type Widget struct {
 	view.ScrollableWidget
 	articles []devto.ListedArticle
 	settings *Settings
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -45,7 +44,12 @@ func (widget *Widget) Refresh() {
 	ctx := context.Background()
 	wCfg, _ := devto.NewConfig(false, "")
 
-	c, _ := devto.NewClient(ctx, wCfg, nil, devto.BaseURL)
+	c, err := devto.NewClient(ctx, wCfg, nil, devto.BaseURL)
+	if err != nil {
+		widget.SetRefreshError(err)
+		widget.Render()
+		return
+	}
 
 	options := devto.ArticleListOptions{
 		Tags:     widget.settings.contentTag,
@@ -54,11 +58,7 @@ func (widget *Widget) Refresh() {
 	}
 
 	articles, err := c.Articles.List(ctx, options)
-	if err != nil {
-		widget.err = err
-		widget.articles = nil
-		widget.SetItemCount(0)
-	} else {
+	if err == nil {
 		var displayArticles []devto.ListedArticle
 		var l int
 		if len(articles) < widget.settings.numberOfArticles {
@@ -76,6 +76,7 @@ func (widget *Widget) Refresh() {
 		widget.SetItemCount(len(displayArticles))
 	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -89,10 +90,6 @@ func (widget *Widget) Render() {
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf("%s - %s stories", widget.CommonSettings().Title, widget.settings.contentTag)
 
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	articles := widget.articles
 	if len(articles) == 0 {
 		return title, "No stories to display", false
@@ -101,10 +98,11 @@ func (widget *Widget) content() (string, string, bool) {
 	var str string
 	for idx, article := range articles {
 		row := fmt.Sprintf(
-			`[%s]%2d. %s [lightblue](%s)[white]`,
+			`[%s]%2d. %s [%s](%s)[-]`,
 			widget.RowColor(idx),
 			idx+1,
 			article.Title,
+			widget.settings.common.Colors.Label,
 			article.User.Username,
 		)
 
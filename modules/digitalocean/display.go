@@ -1,67 +1,67 @@
 package digitalocean
 
 import (
-	"fmt"
-
-	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
 )
 
 const maxColWidth = 12
 
-func (widget *Widget) content() (string, string, bool) {
-	columnSet := widget.settings.columns
+// numericColumns are the droplet properties that are sorted as numbers
+var numericColumns = map[string]bool{
+	"Disk":   true,
+	"ID":     true,
+	"Memory": true,
+	"Vcpus":  true,
+}
 
-	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
+// tableColumns returns a column of the droplets table for each of the defined columns
+func tableColumns(columnSet []string) []view.TableColumn {
+	columns := []view.TableColumn{}
 
-	if len(columnSet) < 1 {
-		return title, " no columns defined", false
-	}
+	for _, colName := range columnSet {
+		colType := view.ColumnText
+		if numericColumns[colName] {
+			colType = view.ColumnNumber
+		}
 
-	str := fmt.Sprintf(" [::b][%s]", widget.settings.common.Colors.Subheading)
+		columns = append(columns, view.TableColumn{MaxWidth: maxColWidth, Name: colName, Type: colType})
+	}
 
-	for _, colName := range columnSet {
-		truncName := utils.Truncate(colName, maxColWidth, false)
+	return columns
+}
 
-		str += fmt.Sprintf("%-12s", truncName)
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.TableTitle(widget.CommonSettings().Title)
+	if len(widget.settings.columns) < 1 {
+		return title, " no columns defined", false
 	}
 
-	str += "\n"
+	return title, widget.TableContent(), false
+}
 
-	for idx, droplet := range widget.droplets {
-		// This defines the formatting for the row, one tab-seperated string for each defined column
-		fmtStr := " [%s]"
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(widget.content)
+}
 
-		for range columnSet {
-			fmtStr += "%-12s"
-		}
+// dropletRows returns a row of the droplets table for each droplet
+func (widget *Widget) dropletRows(droplets []*Droplet) []view.TableRow {
+	rows := []view.TableRow{}
 
-		vals := []interface{}{
-			widget.RowColor(idx),
-		}
+	for _, droplet := range droplets {
+		cells := []view.TableCell{}
 
 		// Dynamically access the droplet to get the requested columns values
-		for _, colName := range columnSet {
+		for _, colName := range widget.settings.columns {
 			val, err := droplet.StringValueForProperty(colName)
 			if err != nil {
 				val = "???"
 			}
 
-			truncVal := utils.Truncate(val, maxColWidth, false)
-
-			vals = append(vals, truncVal)
+			cells = append(cells, view.TableCell{Text: val})
 		}
 
-		// And format, print, and color the row
-		row := fmt.Sprintf(fmtStr, vals...)
-		str += utils.HighlightableHelper(widget.View, row, idx, 33)
+		rows = append(rows, view.TableRow{Cells: cells, Item: droplet})
 	}
 
-	return title, str, false
-}
-
-func (widget *Widget) display() {
-	widget.ScrollableWidget.Redraw(widget.content)
+	return rows
 }
@@ -8,8 +8,11 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("?", widget.showInfo, "Show info about the selected droplet")
 
 	widget.SetKeyboardChar("b", widget.dropletRestart, "Reboot the selected droplet")
+	widget.SetKeyboardChar("f", widget.ShowFilter, "Filter droplets")
 	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("o", widget.SortNext, "Sort by the next column")
+	widget.SetKeyboardChar("O", widget.SortReverse, "Reverse the sort order")
 	widget.SetKeyboardChar("p", widget.dropletEnabledPrivateNetworking, "Enable private networking for the selected drople")
 	widget.SetKeyboardChar("s", widget.dropletShutDown, "Shut down the selected droplet")
 	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
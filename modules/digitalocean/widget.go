@@ -31,22 +31,19 @@ func (t *tokenSource) Token() (*oauth2.Token, error) {
 // Widget is the container for droplet data
 type Widget struct {
 	view.KeyboardWidget
-	view.ScrollableWidget
+	view.TableWidget
 
 	app      *tview.Application
 	client   *godo.Client
-	droplets []*Droplet
 	pages    *tview.Pages
 	settings *Settings
-
-	err error
 }
 
 // NewWidget creates a new instance of a widget
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
-		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
+		KeyboardWidget: view.NewKeyboardWidget(app, pages, settings.common),
+		TableWidget:    view.NewTableWidget(app, pages, settings.common, tableColumns(settings.columns)),
 
 		app:      app,
 		pages:    pages,
@@ -56,8 +53,6 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 	widget.initializeKeyboardControls()
 	widget.View.SetInputCapture(widget.InputCapture)
 
-	widget.View.SetScrollable(true)
-
 	widget.KeyboardWidget.SetView(widget.View)
 	widget.SetRenderFunction(widget.display)
 
@@ -74,9 +69,13 @@ func (widget *Widget) Fetch() error {
 		return errors.New("client could not be initialized")
 	}
 
-	var err error
-	widget.droplets, err = widget.dropletsFetch()
-	return err
+	droplets, err := widget.dropletsFetch()
+	if err != nil {
+		return err
+	}
+
+	widget.SetRows(widget.dropletRows(droplets))
+	return nil
 }
 
 // HelpText returns the help text for this widget
@@ -97,14 +96,8 @@ func (widget *Widget) Prev() {
 // Refresh updates the data for this widget and displays it onscreen
 func (widget *Widget) Refresh() {
 	err := widget.Fetch()
-	if err != nil {
-		widget.err = err
-		widget.SetItemCount(0)
-	} else {
-		widget.err = nil
-		widget.SetItemCount(len(widget.droplets))
-	}
 
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -129,15 +122,12 @@ func (widget *Widget) createClient() {
 // currentDroplet returns the currently-selected droplet, if there is one
 // Returns nil if no droplet is selected
 func (widget *Widget) currentDroplet() *Droplet {
-	if len(widget.droplets) == 0 {
+	row := widget.SelectedRow()
+	if row == nil {
 		return nil
 	}
 
-	if len(widget.droplets) <= widget.Selected {
-		return nil
-	}
-
-	return widget.droplets[widget.Selected]
+	return row.Item.(*Droplet)
 }
 
 // dropletsFetch uses the DigitalOcean API to fetch information about all the available droplets
@@ -186,7 +176,6 @@ func (widget *Widget) dropletDestroy() {
 		return
 	}
 
-	widget.dropletRemoveSelected()
 	widget.Refresh()
 }
 
@@ -205,15 +194,6 @@ func (widget *Widget) dropletEnabledPrivateNetworking() {
 	widget.Refresh()
 }
 
-// dropletRemoveSelected removes the currently-selected droplet from the internal list of droplets
-func (widget *Widget) dropletRemoveSelected() {
-	currDroplet := widget.currentDroplet()
-	if currDroplet != nil {
-		widget.droplets[len(widget.droplets)-1], widget.droplets[widget.Selected] = widget.droplets[widget.Selected], widget.droplets[len(widget.droplets)-1]
-		widget.droplets = widget.droplets[:len(widget.droplets)-1]
-	}
-}
-
 // dropletRestart restarts the selected droplet
 func (widget *Widget) dropletRestart() {
 	currDroplet := widget.currentDroplet()
@@ -9,17 +9,18 @@ import (
 	"github.com/docker/docker/api/types"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+	"github.com/wtfutil/wtf/view"
 )
 
-func (widget *Widget) getSystemInfo() string {
+func (widget *Widget) getSystemInfo() (string, error) {
 	info, err := widget.cli.Info(context.Background())
 	if err != nil {
-		return errors.Wrap(err, "could not get docker system info").Error()
+		return "", errors.Wrap(err, "could not get docker system info")
 	}
 
 	diskUsage, err := widget.cli.DiskUsage(context.Background())
 	if err != nil {
-		return errors.Wrap(err, "could not get disk usage").Error()
+		return "", errors.Wrap(err, "could not get disk usage")
 	}
 
 	var duContainer int64
@@ -51,9 +52,10 @@ func (widget *Widget) getSystemInfo() string {
 		},
 		{
 			name: "containers:",
-			value: fmt.Sprintf("[lime]%d[white]/[yellow]%d[white]/[red]%d",
-				info.ContainersRunning,
-				info.ContainersPaused, info.ContainersStopped),
+			value: fmt.Sprintf("[%s]%d[-]/[%s]%d[-]/[%s]%d",
+				widget.settings.common.Colors.Success, info.ContainersRunning,
+				widget.settings.common.Colors.Warning, info.ContainersPaused,
+				widget.settings.common.Colors.Error, info.ContainersStopped),
 		},
 		{
 			name:  "images:",
@@ -104,27 +106,27 @@ func (widget *Widget) getSystemInfo() string {
 		result += fmt.Sprintf("[%s]%s %s\n", widget.settings.labelColor, info.name, info.value)
 	}
 
-	return result
+	return result, nil
 }
 
-func (widget *Widget) getContainerStates() string {
+// getContainerRows returns a row of the containers table for each container, in order of
+// their names
+func (widget *Widget) getContainerRows() ([]view.TableRow, error) {
 	cntrs, err := widget.cli.ContainerList(context.Background(), types.ContainerListOptions{All: true})
 	if err != nil {
-		return errors.Wrapf(err, " could not get container list").Error()
+		return nil, errors.Wrap(err, "could not get container list")
 	}
 
-	if len(cntrs) == 0 {
-		return " no containers"
-	}
+	colors := widget.settings.common.Colors
 
 	colorMap := map[string]string{
-		"created":    "green",
-		"running":    "lime",
-		"paused":     "yellow",
-		"restarting": "yellow",
-		"removing":   "yellow",
-		"exited":     "red",
-		"dead":       "red",
+		"created":    colors.Title,
+		"running":    colors.Success,
+		"paused":     colors.Warning,
+		"restarting": colors.Warning,
+		"removing":   colors.Warning,
+		"exited":     colors.Error,
+		"dead":       colors.Error,
 	}
 
 	containers := []struct {
@@ -148,16 +150,15 @@ func (widget *Widget) getContainerStates() string {
 		return containers[i].name < containers[j].name
 	})
 
-	padSlice(false, containers, func(i int) string {
-		return containers[i].name
-	}, func(i int, val string) {
-		containers[i].name = val
-	})
-
-	result := ""
+	rows := []view.TableRow{}
 	for _, c := range containers {
-		result += fmt.Sprintf("[white]%s [%s]%s\n", c.name, colorMap[c.state], c.state)
+		rows = append(rows, view.TableRow{
+			Cells: []view.TableCell{
+				{Text: c.name},
+				{Color: colorMap[c.state], Text: c.state},
+			},
+		})
 	}
 
-	return result
+	return rows, nil
 }
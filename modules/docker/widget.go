@@ -2,6 +2,7 @@ package docker
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/docker/docker/client"
 	"github.com/pkg/errors"
@@ -9,29 +10,35 @@ import (
 	"github.com/wtfutil/wtf/view"
 )
 
+// columns are the columns of the containers table
+var columns = []view.TableColumn{
+	{Name: "Name", Type: view.ColumnText},
+	{Name: "State", Type: view.ColumnText},
+}
+
 type Widget struct {
-	view.TextWidget
-	cli           *client.Client
-	settings      *Settings
-	displayBuffer string
+	view.KeyboardWidget
+	view.TableWidget
+
+	cli        *client.Client
+	mu         sync.Mutex
+	settings   *Settings
+	systemInfo string
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(app, settings.common),
-		settings:   settings,
-	}
+		KeyboardWidget: view.NewKeyboardWidget(app, pages, settings.common),
+		TableWidget:    view.NewTableWidget(app, pages, settings.common, columns),
 
-	widget.View.SetScrollable(true)
-
-	cli, err := client.NewEnvClient()
-	if err != nil {
-		widget.displayBuffer = errors.Wrap(err, "could not create client").Error()
-	} else {
-		widget.cli = cli
+		settings: settings,
 	}
 
-	widget.refreshDisplayBuffer()
+	widget.SetRenderFunction(widget.display)
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.KeyboardWidget.SetView(widget.View)
 
 	return &widget
 }
@@ -39,28 +46,81 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	widget.refreshDisplayBuffer()
-	widget.Redraw(widget.display)
+	err := widget.refreshContainers()
+	widget.SetRefreshError(err)
+	widget.display()
+}
+
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
+}
+
+// Next selects the next container
+func (widget *Widget) Next() {
+	widget.ScrollableWidget.Next()
+}
+
+// Prev selects the previous container
+func (widget *Widget) Prev() {
+	widget.ScrollableWidget.Prev()
+}
+
+// Unselect clears the selection of containers
+func (widget *Widget) Unselect() {
+	widget.ScrollableWidget.Unselect()
+	widget.RenderFunction()
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) display() (string, string, bool) {
-	return widget.CommonSettings().Title, widget.displayBuffer, true
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(widget.content)
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	widget.mu.Lock()
+	defer widget.mu.Unlock()
+
+	str := fmt.Sprintf("[%s] System[-]\n", widget.settings.common.Colors.Subheading)
+	str += widget.systemInfo
+	str += "\n"
+	str += fmt.Sprintf("[%s] Containers[-]\n", widget.settings.common.Colors.Subheading)
+
+	if len(widget.Rows()) == 0 && widget.Filter() == "" {
+		str += " no containers"
+	} else {
+		str += widget.TableContent()
+	}
+
+	return widget.TableTitle(widget.CommonSettings().Title), str, false
 }
 
-func (widget *Widget) refreshDisplayBuffer() {
+// refreshContainers reloads the system info and the containers from the Docker daemon. If
+// the daemon can't be reached the previous content is left in place and the error is returned
+func (widget *Widget) refreshContainers() error {
 	if widget.cli == nil {
-		return
+		cli, err := client.NewEnvClient()
+		if err != nil {
+			return errors.Wrap(err, "could not create client")
+		}
+		widget.cli = cli
 	}
 
-	widget.displayBuffer = ""
+	systemInfo, err := widget.getSystemInfo()
+	if err != nil {
+		return err
+	}
 
-	widget.displayBuffer += fmt.Sprintf("[%s] System[white]\n", widget.settings.common.Colors.Subheading)
-	widget.displayBuffer += widget.getSystemInfo()
+	rows, err := widget.getContainerRows()
+	if err != nil {
+		return err
+	}
 
-	widget.displayBuffer += "\n"
+	widget.mu.Lock()
+	widget.systemInfo = systemInfo
+	widget.SetRows(rows)
+	widget.mu.Unlock()
 
-	widget.displayBuffer += fmt.Sprintf("[%s] Containers[white]\n", widget.settings.common.Colors.Subheading)
-	widget.displayBuffer += widget.getContainerStates()
+	return nil
 }
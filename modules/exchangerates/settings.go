@@ -4,6 +4,7 @@ package exchangerates
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
 )
 
 const (
@@ -17,6 +18,9 @@ type Settings struct {
 
 	precision int `help:"How many decimal places to display." optional:"true"`
 
+	chartLength int    `help:"How many recent rates the trend chart of each rate shows. 0 hides the charts." optional:"true"`
+	chartStyle  string `help:"How the trend charts are drawn: blocks or braille." optional:"true"`
+
 	rates map[string][]string `help:"Defines what currency rates we want to know about"`
 	order []string
 }
@@ -28,10 +32,15 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 		precision: ymlConfig.UInt("precision", 7),
 
+		chartLength: ymlConfig.UInt("chartLength", 20),
+		chartStyle:  ymlConfig.UString("chartStyle", view.SparklineBlocks),
+
 		rates: map[string][]string{},
 		order: []string{},
 	}
 
+	settings.common.ValidateSetting("chartStyle", view.ValidateSparklineStyle(settings.chartStyle))
+
 	raw := ymlConfig.UMap("rates", map[string]interface{}{})
 	for key, value := range raw {
 		settings.order = append(settings.order, key)
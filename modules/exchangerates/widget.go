@@ -14,8 +14,8 @@ type Widget struct {
 	view.ScrollableWidget
 
 	settings *Settings
+	history  map[string]*view.Sparkline
 	rates    map[string]map[string]float64
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -23,6 +23,7 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
 		settings: settings,
+		history:  map[string]*view.Sparkline{},
 	}
 
 	widget.SetRenderFunction(widget.Render)
@@ -35,12 +36,12 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 func (widget *Widget) Refresh() {
 
 	rates, err := FetchExchangeRates(widget.settings)
-	if err != nil {
-		widget.err = err
-	} else {
+	if err == nil {
 		widget.rates = rates
+		widget.addToHistory(rates)
 	}
 
+	widget.SetRefreshError(err)
 	// The last call should always be to the display function
 	widget.Render()
 }
@@ -52,11 +53,6 @@ func (widget *Widget) Render() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
-	if widget.err != nil {
-		widget.View.SetWrap(true)
-		return widget.CommonSettings().Title, widget.err.Error(), false
-	}
-
 	// Sort the bases alphabetically to ensure consistent display ordering
 	bases := []string{}
 	for base := range widget.settings.rates {
@@ -64,7 +60,11 @@ func (widget *Widget) content() (string, string, bool) {
 	}
 	sort.Strings(bases)
 
-	out := ""
+	// The rows are built first so that their trend charts can be lined up
+	rows := []string{}
+	charts := []string{}
+	colors := []string{}
+	longest := 0
 
 	for idx, base := range bases {
 		rates := widget.settings.rates[base]
@@ -74,22 +74,68 @@ func (widget *Widget) content() (string, string, bool) {
 		for _, cur := range rates {
 			rate := widget.rates[base][cur]
 
-			out += fmt.Sprintf(
-				"[%s]1 %s = %s %s[white]\n",
-				rowColor,
-				base,
-				widget.formatConversionRate(rate),
-				cur,
-			)
+			row := fmt.Sprintf("1 %s = %s %s", base, widget.formatConversionRate(rate), cur)
+			if len(row) > longest {
+				longest = len(row)
+			}
+
+			rows = append(rows, row)
+			charts = append(charts, widget.trend(base, cur))
+			colors = append(colors, rowColor)
 
 			idx++
 		}
 	}
 
+	out := ""
+	for idx, row := range rows {
+		if charts[idx] != "" {
+			row = fmt.Sprintf("%-*s %s", longest, row, charts[idx])
+		}
+
+		out += fmt.Sprintf("[%s]%s[-]\n", colors[idx], row)
+	}
+
 	widget.View.SetWrap(false)
 	return widget.CommonSettings().Title, out, false
 }
 
+// addToHistory adds each of the rates to its history
+func (widget *Widget) addToHistory(rates map[string]map[string]float64) {
+	if widget.settings.chartLength <= 0 {
+		return
+	}
+
+	for base, curs := range rates {
+		for cur, rate := range curs {
+			sparkline, ok := widget.history[base+"/"+cur]
+			if !ok {
+				sparkline = view.NewSparkline(widget.settings.chartLength)
+				widget.history[base+"/"+cur] = sparkline
+			}
+
+			sparkline.Add(rate)
+		}
+	}
+}
+
+// trend returns the chart of the rate's history, followed by its range and average, or an
+// empty string if there isn't one
+func (widget *Widget) trend(base, cur string) string {
+	sparkline, ok := widget.history[base+"/"+cur]
+	if !ok {
+		return ""
+	}
+
+	width := view.SparklineWidth(widget.settings.chartStyle, widget.settings.chartLength)
+
+	return fmt.Sprintf(
+		"%s %s",
+		sparkline.Render(widget.settings.chartStyle, width, 1),
+		sparkline.Summary(fmt.Sprintf("%%.%df", widget.settings.precision)),
+	)
+}
+
 // formatConversionRate takes the raw conversion float and formats it to the precision the
 // user specifies in their config (or to the default value)
 func (widget *Widget) formatConversionRate(rate float64) string {
@@ -34,7 +34,6 @@ type Widget struct {
 	stories  []*FeedItem
 	parser   *gofeed.Parser
 	settings *Settings
-	err      error
 	showType ShowType
 }
 
@@ -75,6 +74,7 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 	}
 
 	widget.SetRenderFunction(widget.Render)
+	widget.SetOpenFunction(widget.openStory)
 	widget.initializeKeyboardControls()
 	widget.View.SetInputCapture(widget.InputCapture)
 
@@ -106,16 +106,12 @@ func (widget *Widget) Fetch(feedURLs []string) ([]*FeedItem, error) {
 // Refresh updates the data in the widget
 func (widget *Widget) Refresh() {
 	feedItems, err := widget.Fetch(widget.settings.feeds)
-	if err != nil {
-		widget.err = err
-		widget.stories = nil
-		widget.SetItemCount(0)
-	} else {
-		widget.err = nil
+	if err == nil {
 		widget.stories = feedItems
 		widget.SetItemCount(len(feedItems))
 	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -154,9 +150,6 @@ func (widget *Widget) fetchForFeed(feedURL string) ([]*FeedItem, error) {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
 	data := widget.stories
 	if len(data) == 0 {
 		return title, "No data", false
@@ -168,16 +161,16 @@ func (widget *Widget) content() (string, string, bool) {
 
 		if feedItem.viewed {
 			// Grays out viewed items in the list, while preserving background highlighting when selected
-			rowColor = "gray"
+			rowColor = widget.settings.common.Colors.Muted
 			if idx == widget.Selected {
-				rowColor = fmt.Sprintf("gray:%s", widget.settings.common.Colors.RowTheme.HighlightedBackground)
+				rowColor = fmt.Sprintf("%s:%s", widget.settings.common.Colors.Muted, widget.settings.common.Colors.RowTheme.HighlightedBackground)
 			}
 		}
 
 		displayText := getShowText(feedItem, widget.showType)
 
 		row := fmt.Sprintf(
-			"[%s]%2d. %s[white]",
+			"[%s]%2d. %s[-]",
 			rowColor,
 			idx+1,
 			displayText,
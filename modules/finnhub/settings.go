@@ -6,6 +6,7 @@ import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
 )
 
 const (
@@ -18,6 +19,9 @@ type Settings struct {
 	common  *cfg.Common
 	apiKey  string   `help:"Your finnhub API token."`
 	symbols []string `help:"An array of stocks symbols (i.e. AAPL, MSFT)"`
+
+	chartLength int    `help:"How many recent prices the trend chart of each stock shows. 0 hides the charts." optional:"true"`
+	chartStyle  string `help:"How the trend charts are drawn: blocks or braille." optional:"true"`
 }
 
 // NewSettingsFromYAML creates a new settings instance from a YAML config block
@@ -28,8 +32,13 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 		apiKey:  ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_FINNHUB_API_KEY"))),
 		symbols: utils.ToStrs(ymlConfig.UList("symbols")),
+
+		chartLength: ymlConfig.UInt("chartLength", 20),
+		chartStyle:  ymlConfig.UString("chartStyle", view.SparklineBlocks),
 	}
 
+	settings.common.ValidateSetting("chartStyle", view.ValidateSparklineStyle(settings.chartStyle))
+
 	cfg.ModuleSecret(name, globalConfig, &settings.apiKey).Load()
 
 	return &settings
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jedib0t/go-pretty/table"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/rules"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -13,6 +14,8 @@ type Widget struct {
 	view.TextWidget
 	*Client
 
+	history  map[string]*view.Sparkline
+	quotes   []Quote
 	settings *Settings
 }
 
@@ -22,6 +25,7 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 		TextWidget: view.NewTextWidget(app, settings.common),
 		Client:     NewClient(settings.symbols, settings.apiKey),
 
+		history:  map[string]*view.Sparkline{},
 		settings: settings,
 	}
 
@@ -35,27 +39,89 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
+	quotes, err := widget.Client.Getquote()
+	if err == nil {
+		widget.quotes = quotes
+		for _, q := range quotes {
+			widget.addToHistory(q.Stock, q.C)
+		}
+
+		widget.SetValues(valuesFrom(quotes))
+	}
+
+	widget.SetRefreshError(err)
+	widget.Render()
+}
+
+// Render redraws the last quotes that were fetched
+func (widget *Widget) Render() {
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
-	quotes, err := widget.Client.Getquote()
-
 	title := widget.CommonSettings().Title
 	t := table.NewWriter()
-	t.AppendHeader(table.Row{"#", "Stock", "Current Price", "Open Price", "Change"})
-	wrap := false
-	if err != nil {
-		wrap = true
-	} else {
-		for idx, q := range quotes {
-			t.AppendRows([]table.Row{
-				{idx, q.Stock, q.C, q.O, fmt.Sprintf("%.4f", (q.C-q.O)/q.C)},
-			})
+	header := table.Row{"#", "Stock", "Current Price", "Open Price", "Change"}
+	if widget.settings.chartLength > 0 {
+		header = append(header, "Trend")
+	}
+	t.AppendHeader(header)
+
+	for idx, q := range widget.quotes {
+		row := table.Row{idx, q.Stock, q.C, q.O, fmt.Sprintf("%.4f", (q.C-q.O)/q.C)}
+		if widget.settings.chartLength > 0 {
+			row = append(row, widget.trend(q.Stock))
+		}
+
+		t.AppendRows([]table.Row{row})
+	}
+
+	return title, t.Render(), false
+}
+
+// addToHistory adds the price to the stock's history
+func (widget *Widget) addToHistory(symbol string, price float64) {
+	sparkline, ok := widget.history[symbol]
+	if !ok {
+		sparkline = view.NewSparkline(widget.settings.chartLength)
+		widget.history[symbol] = sparkline
+	}
+
+	sparkline.Add(price)
+}
+
+// trend returns the chart of the stock's price history, followed by its range and average
+func (widget *Widget) trend(symbol string) string {
+	sparkline, ok := widget.history[symbol]
+	if !ok {
+		return ""
+	}
+
+	width := view.SparklineWidth(widget.settings.chartStyle, widget.settings.chartLength)
+
+	return fmt.Sprintf(
+		"%s %s",
+		sparkline.Render(widget.settings.chartStyle, width, 1),
+		sparkline.Summary("%.2f"),
+	)
+}
+
+// valuesFrom returns the values rules can be written against, for each stock: price.<symbol>,
+// the current price, change.<symbol>, the change since the open, and changePercent.<symbol>,
+// that change as a percentage of the open price
+func valuesFrom(quotes []Quote) map[string]interface{} {
+	values := map[string]interface{}{}
+
+	for _, q := range quotes {
+		values[rules.ValueName("price", q.Stock)] = q.C
+		values[rules.ValueName("change", q.Stock)] = q.C - q.O
+
+		if q.O != 0 {
+			values[rules.ValueName("changePercent", q.Stock)] = (q.C - q.O) / q.O * 100
 		}
 	}
 
-	return title, t.Render(), wrap
+	return values
 }
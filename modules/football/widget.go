@@ -31,47 +31,60 @@ var leagueID = map[string]leagueInfo{
 type Widget struct {
 	view.TextWidget
 	*Client
-	settings *Settings
-	League   leagueInfo
-	err      error
+	settings      *Settings
+	League        leagueInfo
+	leagueErr     error
+	displayBuffer string
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
-	var widget Widget
-	leagueId, err := getLeague(settings.league)
-	if err != nil {
-		widget = Widget{
-			err:      fmt.Errorf("unable to get the league id for provided league '%s'", settings.league),
-			Client:   NewClient(settings.apiKey),
-			settings: settings,
-		}
-		return &widget
-	}
-	widget = Widget{
+	widget := Widget{
 		TextWidget: view.NewTextWidget(app, settings.common),
 		Client:     NewClient(settings.apiKey),
-		League:     leagueId,
 		settings:   settings,
 	}
+
+	leagueId, err := getLeague(settings.league)
+	if err != nil {
+		widget.leagueErr = fmt.Errorf("unable to get the league id for provided league '%s'", settings.league)
+	} else {
+		widget.League = leagueId
+	}
+
 	return &widget
 }
 
 func (widget *Widget) Refresh() {
+	err := widget.refreshDisplayBuffer()
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 func (widget *Widget) content() (string, string, bool) {
-
-	var content string
 	title := fmt.Sprintf("%s %s", widget.CommonSettings().Title, widget.League.caption)
-	wrap := false
-	if widget.err != nil {
-		return title, widget.err.Error(), true
+	return title, widget.displayBuffer, false
+}
+
+// refreshDisplayBuffer rebuilds the widget's content from the league's standings and
+// matches. If either can't be fetched the previous content is left in place and the error
+// is returned
+func (widget *Widget) refreshDisplayBuffer() error {
+	if widget.leagueErr != nil {
+		return widget.leagueErr
+	}
+
+	standings, err := widget.GetStandings(widget.League.id)
+	if err != nil {
+		return err
+	}
+
+	matches, err := widget.GetMatches(widget.League.id)
+	if err != nil {
+		return err
 	}
-	content += widget.GetStandings(widget.League.id)
-	content += widget.GetMatches(widget.League.id)
 
-	return title, content, wrap
+	widget.displayBuffer = standings + matches
+	return nil
 }
 
 func getLeague(league string) (leagueInfo, error) {
@@ -84,7 +97,7 @@ func getLeague(league string) (leagueInfo, error) {
 }
 
 // GetStandings of particular league
-func (widget *Widget) GetStandings(leagueId int) string {
+func (widget *Widget) GetStandings(leagueId int) (string, error) {
 
 	var l LeagueStandings
 	var content string
@@ -93,20 +106,20 @@ func (widget *Widget) GetStandings(leagueId int) string {
 	tStandings := createTable([]string{"No.", "Team", "MP", "Won", "Draw", "Lost", "GD", "Points"}, buf)
 	resp, err := widget.Client.footballRequest("standings", leagueId)
 	if err != nil {
-		return fmt.Sprintf("Error fetching standings: %s", err.Error())
+		return "", fmt.Errorf("error fetching standings: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 	data, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Sprintf("Error fetching standings: %s", err.Error())
+		return "", fmt.Errorf("error fetching standings: %w", err)
 	}
 	err = json.Unmarshal(data, &l)
 	if err != nil {
-		return "Error fetching standings"
+		return "", fmt.Errorf("error fetching standings: %w", err)
 	}
 
 	if len(l.Standings) == 0 {
-		return "Error fetching standings"
+		return "", fmt.Errorf("error fetching standings: no standings returned")
 	}
 
 	for _, i := range l.Standings[0].Table {
@@ -119,11 +132,11 @@ func (widget *Widget) GetStandings(leagueId int) string {
 	tStandings.Render()
 	content += buf.String()
 
-	return content
+	return content, nil
 }
 
 // GetMatches of particular league
-func (widget *Widget) GetMatches(leagueId int) string {
+func (widget *Widget) GetMatches(leagueId int) (string, error) {
 
 	var l LeagueFixtuers
 	var content string
@@ -139,20 +152,20 @@ func (widget *Widget) GetMatches(leagueId int) string {
 	requestPath := fmt.Sprintf("matches?dateFrom=%s&dateTo=%s", from, to)
 	resp, err := widget.Client.footballRequest(requestPath, leagueId)
 	if err != nil {
-		return fmt.Sprintf("Error fetching matches: %s", err.Error())
+		return "", fmt.Errorf("error fetching matches: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 	data, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Sprintf("Error fetching matches: %s", err.Error())
+		return "", fmt.Errorf("error fetching matches: %w", err)
 	}
 	err = json.Unmarshal(data, &l)
 	if err != nil {
-		return fmt.Sprintf("Error fetching matches: %s", err.Error())
+		return "", fmt.Errorf("error fetching matches: %w", err)
 	}
 
 	if len(l.Matches) == 0 {
-		return "Error fetching matches"
+		return "", fmt.Errorf("error fetching matches: no matches returned")
 	}
 
 	for _, m := range l.Matches {
@@ -180,7 +193,7 @@ func (widget *Widget) GetMatches(leagueId int) string {
 		content += scheduledBuf.String()
 	}
 
-	return content
+	return content, nil
 }
 
 func (widget *Widget) markFavorite(m *Matches) {
@@ -22,7 +22,7 @@ func (widget *Widget) display() {
 		conflict := widget.conflicts(event, widget.Events)
 
 		str = str + fmt.Sprintf(
-			"%s %s[%s]%s[white]\n %s[%s]%s %s[white]\n\n",
+			"%s %s[%s]%s[-]\n %s[%s]%s %s[-]\n\n",
 			widget.dayDivider(event, prevEvent),
 			widget.responseIcon(event),
 			widget.titleColor(event, i),
@@ -179,7 +179,7 @@ func (widget *Widget) responseIcon(event *calendar.Event) string {
 		}
 	}
 
-	icon := "[gray]"
+	icon := "[" + widget.settings.common.Colors.Muted + "]"
 
 	switch response {
 	case "accepted":
@@ -231,5 +231,5 @@ func (widget *Widget) until(event *calendar.Event) string {
 		untilStr = fmt.Sprintf("%dm", mins)
 	}
 
-	return "[lightblue]" + untilStr + "[white]"
+	return "[" + widget.settings.common.Colors.Label + "]" + untilStr + "[-]"
 }
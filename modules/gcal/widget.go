@@ -3,6 +3,7 @@ package gcal
 import (
 	"fmt"
 	"os/exec"
+	"time"
 
 	"github.com/gdamore/tcell"
 	"github.com/olebedev/config"
@@ -57,8 +58,14 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	widget.Events, _ = widget.Fetch()
+	events, err := widget.Fetch()
+	if err == nil && events != nil {
+		widget.Events = events
+		widget.SetValues(valuesFrom(events, time.Now()))
+	}
 
+	widget.SetRefreshError(err)
+	widget.RedrawTitle()
 	widget.display()
 }
 
@@ -82,6 +89,40 @@ func (widget *Widget) Prev() {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// valuesFrom returns the values rules can be written against: events, the number of events
+// displayed, and minutesUntil, hoursUntil and daysUntil, how long it is until the next event
+// starts. There are no "until" values when no event has yet to start
+func valuesFrom(events *calendar.Events, now time.Time) map[string]interface{} {
+	values := map[string]interface{}{
+		"events": len(events.Items),
+	}
+
+	for _, event := range events.Items {
+		startTime, err := time.Parse(time.RFC3339, event.Start.DateTime)
+		if err != nil {
+			// All-day events only have a date
+			startTime, err = time.ParseInLocation("2006-01-02", event.Start.Date, now.Location())
+			if err != nil {
+				continue
+			}
+		}
+
+		if startTime.Before(now) {
+			continue
+		}
+
+		until := startTime.Sub(now)
+
+		values["minutesUntil"] = until.Minutes()
+		values["hoursUntil"] = until.Hours()
+		values["daysUntil"] = until.Hours() / 24
+
+		break
+	}
+
+	return values
+}
+
 func (widget *Widget) currentEvent() *calendar.Event {
 	if len(widget.Events.Items) == 0 {
 		return nil
@@ -158,6 +199,6 @@ func (widget *Widget) showNotification(event *calendar.Event) {
 
 	err := runCommand.Start()
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to run alert command: %v", err))
+		logger.Errorf(widget.Name(), "Failed to run alert command: %v", err)
 	}
 }
@@ -10,10 +10,6 @@ func (widget *Widget) display() {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	project := widget.currentGerritProject()
 	if project == nil {
 		return title, "Gerrit project data is unavailable", true
@@ -23,13 +19,13 @@ func (widget *Widget) content() (string, string, bool) {
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.common.SigilStr(len(widget.GerritProjects), widget.Idx, width) + "\n"
-	str += fmt.Sprintf(" [%s]Stats[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Stats[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayStats(project)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]Open Incoming Reviews[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Open Incoming Reviews[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyIncomingReviews(project, widget.settings.username)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]My Outgoing Reviews[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]My Outgoing Reviews[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyOutgoingReviews(project, widget.settings.username)
 
 	return title, str, false
@@ -37,12 +33,12 @@ func (widget *Widget) content() (string, string, bool) {
 
 func (widget *Widget) displayMyIncomingReviews(project *GerritProject, username string) string {
 	if len(project.IncomingReviews) == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	str := ""
 	for idx, r := range project.IncomingReviews {
-		str += fmt.Sprintf(" [%s] [green]%d[white] [%s] %s\n", widget.rowColor(idx), r.Number, widget.rowColor(idx), r.Subject)
+		str += fmt.Sprintf(" [%s] [%s]%d[-] [%s] %s\n", widget.rowColor(idx), widget.settings.common.Colors.Title, r.Number, widget.rowColor(idx), r.Subject)
 	}
 
 	return str
@@ -50,12 +46,12 @@ func (widget *Widget) displayMyIncomingReviews(project *GerritProject, username
 
 func (widget *Widget) displayMyOutgoingReviews(project *GerritProject, username string) string {
 	if len(project.OutgoingReviews) == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	str := ""
 	for idx, r := range project.OutgoingReviews {
-		str += fmt.Sprintf(" [%s] [green]%d[white] [%s] %s\n", widget.rowColor(idx+len(project.IncomingReviews)), r.Number, widget.rowColor(idx+len(project.IncomingReviews)), r.Subject)
+		str += fmt.Sprintf(" [%s] [%s]%d[-] [%s] %s\n", widget.rowColor(idx+len(project.IncomingReviews)), widget.settings.common.Colors.Title, r.Number, widget.rowColor(idx+len(project.IncomingReviews)), r.Subject)
 	}
 
 	return str
@@ -79,5 +75,5 @@ func (widget *Widget) rowColor(idx int) string {
 }
 
 func (widget *Widget) title(project *GerritProject) string {
-	return fmt.Sprintf("[green]%s [white]", project.Path)
+	return fmt.Sprintf("[%s]%s [-]", widget.settings.common.Colors.Title, project.Path)
 }
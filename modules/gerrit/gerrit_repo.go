@@ -24,13 +24,18 @@ func NewGerritProject(path string, gerrit *glb.Client) *GerritProject {
 }
 
 // Refresh reloads the gerrit data via the Gerrit API
-func (project *GerritProject) Refresh(username string) {
-	project.Changes, _ = project.loadChanges()
+func (project *GerritProject) Refresh(username string) error {
+	changes, err := project.loadChanges()
+	if err != nil {
+		return err
+	}
 
+	project.Changes = changes
 	project.ReviewCount = project.countReviews(project.Changes)
 	project.IncomingReviews = project.myIncomingReviews(project.Changes, username)
 	project.OutgoingReviews = project.myOutgoingReviews(project.Changes, username)
 
+	return nil
 }
 
 /* -------------------- Counts -------------------- */
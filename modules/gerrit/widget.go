@@ -23,7 +23,6 @@ type Widget struct {
 
 	selected int
 	settings *Settings
-	err      error
 }
 
 var (
@@ -75,20 +74,9 @@ func (widget *Widget) Refresh() {
 			submatch[2],
 		)
 	}
-	gerrit, err := glb.NewClient(gerritUrl, httpClient)
-	if err != nil {
-		widget.err = err
-		widget.gerrit = nil
-		widget.GerritProjects = nil
-	} else {
-		widget.err = nil
-		widget.gerrit = gerrit
-		widget.GerritProjects = widget.buildProjectCollection(widget.settings.projects)
-		for _, project := range widget.GerritProjects {
-			project.Refresh(widget.settings.username)
-		}
-	}
+	err := widget.refreshProjects(gerritUrl, httpClient)
 
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -98,6 +86,26 @@ func (widget *Widget) HelpText() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// refreshProjects reloads every project. If any of them fails to load, the projects from the
+// last successful refresh are kept and the error is returned
+func (widget *Widget) refreshProjects(gerritUrl string, httpClient *http.Client) error {
+	gerrit, err := glb.NewClient(gerritUrl, httpClient)
+	if err != nil {
+		return err
+	}
+
+	widget.gerrit = gerrit
+	projects := widget.buildProjectCollection(widget.settings.projects)
+	for _, project := range projects {
+		if err := project.Refresh(widget.settings.username); err != nil {
+			return err
+		}
+	}
+
+	widget.GerritProjects = projects
+	return nil
+}
+
 func (widget *Widget) nextProject() {
 	widget.Idx++
 	widget.unselect()
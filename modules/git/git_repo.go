@@ -16,40 +16,56 @@ type GitRepo struct {
 	Path         string
 }
 
-func NewGitRepo(repoPath string, commitCount int, commitFormat, dateFormat string) *GitRepo {
+// NewGitRepo reads the state of the repository at repoPath. It returns an error if any of
+// the git commands it runs fails
+func NewGitRepo(repoPath string, commitCount int, commitFormat, dateFormat string) (*GitRepo, error) {
 	repo := GitRepo{Path: repoPath}
 
-	repo.Branch = repo.branch()
-	repo.ChangedFiles = repo.changedFiles()
-	repo.Commits = repo.commits(commitCount, commitFormat, dateFormat)
-	repo.Repository = strings.TrimSpace(repo.repository())
-
-	return &repo
+	var err error
+
+	if repo.Branch, err = repo.branch(); err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
+	if repo.ChangedFiles, err = repo.changedFiles(); err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
+	if repo.Commits, err = repo.commits(commitCount, commitFormat, dateFormat); err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
+
+	repository, err := repo.repository()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
+	repo.Repository = strings.TrimSpace(repository)
+
+	return &repo, nil
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (repo *GitRepo) branch() string {
+func (repo *GitRepo) branch() (string, error) {
 	arg := []string{repo.gitDir(), repo.workTree(), "rev-parse", "--abbrev-ref", "HEAD"}
 
 	cmd := exec.Command("git", arg...)
-	str := utils.ExecuteCommand(cmd)
-
-	return str
+	return utils.RunCommand(cmd)
 }
 
-func (repo *GitRepo) changedFiles() []string {
+func (repo *GitRepo) changedFiles() ([]string, error) {
 	arg := []string{repo.gitDir(), repo.workTree(), "status", "--porcelain"}
 
 	cmd := exec.Command("git", arg...)
-	str := utils.ExecuteCommand(cmd)
+	str, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	data := strings.Split(str, "\n")
 
-	return data
+	return data, nil
 }
 
-func (repo *GitRepo) commits(commitCount int, commitFormat, dateFormat string) []string {
+func (repo *GitRepo) commits(commitCount int, commitFormat, dateFormat string) ([]string, error) {
 	dateStr := fmt.Sprintf("--date=format:\"%s\"", dateFormat)
 	numStr := fmt.Sprintf("-n %d", commitCount)
 	commitStr := fmt.Sprintf("--pretty=format:\"%s\"", commitFormat)
@@ -57,19 +73,20 @@ func (repo *GitRepo) commits(commitCount int, commitFormat, dateFormat string) [
 	arg := []string{repo.gitDir(), repo.workTree(), "log", dateStr, numStr, commitStr}
 
 	cmd := exec.Command("git", arg...)
-	str := utils.ExecuteCommand(cmd)
+	str, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	data := strings.Split(str, "\n")
 
-	return data
+	return data, nil
 }
 
-func (repo *GitRepo) repository() string {
+func (repo *GitRepo) repository() (string, error) {
 	arg := []string{repo.gitDir(), repo.workTree(), "rev-parse", "--show-toplevel"}
 	cmd := exec.Command("git", arg...)
-	str := utils.ExecuteCommand(cmd)
-
-	return str
+	return utils.RunCommand(cmd)
 }
 func (repo *GitRepo) pull() string {
 	arg := []string{repo.gitDir(), repo.workTree(), "pull"}
@@ -2,7 +2,6 @@ package git
 
 import (
 	"io/ioutil"
-	"log"
 	"strings"
 
 	"github.com/gdamore/tcell"
@@ -57,8 +56,10 @@ func (widget *Widget) Checkout() {
 
 	checkoutFctn := func() {
 		text := form.GetFormItem(0).(*tview.InputField).GetText()
-		repoToCheckout := widget.GitRepos[widget.Idx]
-		repoToCheckout.checkout(text)
+		repoToCheckout := widget.currentData()
+		if repoToCheckout != nil {
+			repoToCheckout.checkout(text)
+		}
 		widget.pages.RemovePage("modal")
 		widget.app.SetFocus(widget.View)
 		widget.display()
@@ -70,7 +71,11 @@ func (widget *Widget) Checkout() {
 }
 
 func (widget *Widget) Pull() {
-	repoToPull := widget.GitRepos[widget.Idx]
+	repoToPull := widget.currentData()
+	if repoToPull == nil {
+		return
+	}
+
 	repoToPull.pull()
 	widget.Refresh()
 
@@ -79,8 +84,12 @@ func (widget *Widget) Pull() {
 func (widget *Widget) Refresh() {
 	repoPaths := utils.ToStrs(widget.settings.repositories)
 
-	widget.GitRepos = widget.gitRepos(repoPaths)
+	repos, err := widget.gitRepos(repoPaths)
+	if err == nil {
+		widget.GitRepos = repos
+	}
 
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -153,34 +162,41 @@ func (widget *Widget) currentData() *GitRepo {
 	return widget.GitRepos[widget.Idx]
 }
 
-func (widget *Widget) gitRepos(repoPaths []string) []*GitRepo {
+func (widget *Widget) gitRepos(repoPaths []string) ([]*GitRepo, error) {
 	repos := []*GitRepo{}
 
 	for _, repoPath := range repoPaths {
 		if strings.HasSuffix(repoPath, "/") {
-			repos = append(repos, widget.findGitRepositories(make([]*GitRepo, 0), repoPath)...)
+			found, err := widget.findGitRepositories(make([]*GitRepo, 0), repoPath)
+			if err != nil {
+				return nil, err
+			}
 
+			repos = append(repos, found...)
 		} else {
-			repo := NewGitRepo(
+			repo, err := NewGitRepo(
 				repoPath,
 				widget.settings.commitCount,
 				widget.settings.commitFormat,
 				widget.settings.dateFormat,
 			)
+			if err != nil {
+				return nil, err
+			}
 
 			repos = append(repos, repo)
 		}
 	}
 
-	return repos
+	return repos, nil
 }
 
-func (widget *Widget) findGitRepositories(repositories []*GitRepo, directory string) []*GitRepo {
+func (widget *Widget) findGitRepositories(repositories []*GitRepo, directory string) ([]*GitRepo, error) {
 	directory = strings.TrimSuffix(directory, "/")
 
 	files, err := ioutil.ReadDir(directory)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	var path string
@@ -192,12 +208,15 @@ func (widget *Widget) findGitRepositories(repositories []*GitRepo, directory str
 			if file.Name() == ".git" {
 				path = strings.TrimSuffix(path, "/.git")
 
-				repo := NewGitRepo(
+				repo, err := NewGitRepo(
 					path,
 					widget.settings.commitCount,
 					widget.settings.commitFormat,
 					widget.settings.dateFormat,
 				)
+				if err != nil {
+					return nil, err
+				}
 
 				repositories = append(repositories, repo)
 				continue
@@ -205,9 +224,13 @@ func (widget *Widget) findGitRepositories(repositories []*GitRepo, directory str
 			if file.Name() == "vendor" || file.Name() == "node_modules" {
 				continue
 			}
-			repositories = widget.findGitRepositories(repositories, path)
+
+			repositories, err = widget.findGitRepositories(repositories, path)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return repositories
+	return repositories, nil
 }
@@ -25,31 +25,30 @@ func (widget *Widget) content() (string, string, bool) {
 
 	// initial maxItems count
 	widget.Items = make([]int, 0)
+	if repo == nil {
+		widget.SetItemCount(0)
+		return widget.CommonSettings().Title, " GitHub repo data is unavailable ", false
+	}
 	widget.SetItemCount(len(repo.myReviewRequests((username))))
 
 	title := fmt.Sprintf("%s - %s", widget.CommonSettings().Title, widget.title(repo))
-	if repo == nil {
-		return title, " GitHub repo data is unavailable ", false
-	} else if repo.Err != nil {
-		return title, repo.Err.Error(), true
-	}
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.common.SigilStr(len(widget.GithubRepos), widget.Idx, width)
 	if widget.settings.showStats {
-		str += fmt.Sprintf("\n [%s]Stats[white]\n", widget.settings.common.Colors.Subheading)
+		str += fmt.Sprintf("\n [%s]Stats[-]\n", widget.settings.common.Colors.Subheading)
 		str += widget.displayStats(repo)
 	}
 	if widget.settings.showOpenReviewRequests {
-		str += fmt.Sprintf("\n [%s]Open Review Requests[white]\n", widget.settings.common.Colors.Subheading)
+		str += fmt.Sprintf("\n [%s]Open Review Requests[-]\n", widget.settings.common.Colors.Subheading)
 		str += widget.displayMyReviewRequests(repo, username)
 	}
 	if widget.settings.showMyPullRequests {
-		str += fmt.Sprintf("\n [%s]My Pull Requests[white]\n", widget.settings.common.Colors.Subheading)
+		str += fmt.Sprintf("\n [%s]My Pull Requests[-]\n", widget.settings.common.Colors.Subheading)
 		str += widget.displayMyPullRequests(repo, username)
 	}
 	for _, customQuery := range widget.settings.customQueries {
-		str += fmt.Sprintf("\n [%s]%s[white]\n", widget.settings.common.Colors.Subheading, customQuery.title)
+		str += fmt.Sprintf("\n [%s]%s[-]\n", widget.settings.common.Colors.Subheading, customQuery.title)
 		str += widget.displayCustomQuery(repo, customQuery.filter, customQuery.perPage)
 	}
 
@@ -62,14 +61,14 @@ func (widget *Widget) displayMyPullRequests(repo *Repo, username string) string
 	prLength := len(prs)
 
 	if prLength == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	maxItems := widget.GetItemCount()
 
 	str := ""
 	for idx, pr := range prs {
-		str += fmt.Sprintf(` %s[green]["%d"]%4d[""][white] %s`, widget.mergeString(pr), maxItems+idx, *pr.Number, *pr.Title)
+		str += fmt.Sprintf(` %s[%s]["%d"]%4d[""][-] %s`, widget.mergeString(pr), widget.settings.common.Colors.Title, maxItems+idx, *pr.Number, *pr.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *pr.Number)
 	}
@@ -83,20 +82,20 @@ func (widget *Widget) displayCustomQuery(repo *Repo, filter string, perPage int)
 	res := repo.customIssueQuery(filter, perPage)
 
 	if res == nil {
-		return " [grey]Invalid Query[white]\n"
+		return fmt.Sprintf(" [%s]Invalid Query[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	issuesLength := len(res.Issues)
 
 	if issuesLength == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	maxItems := widget.GetItemCount()
 
 	str := ""
 	for idx, issue := range res.Issues {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, *issue.Number, *issue.Title)
+		str += fmt.Sprintf(` [%s]["%d"]%4d[""][-] %s`, widget.settings.common.Colors.Title, maxItems+idx, *issue.Number, *issue.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *issue.Number)
 	}
@@ -110,12 +109,12 @@ func (widget *Widget) displayMyReviewRequests(repo *Repo, username string) strin
 	prs := repo.myReviewRequests(username)
 
 	if len(prs) == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 
 	str := ""
 	for idx, pr := range prs {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, idx, *pr.Number, *pr.Title)
+		str += fmt.Sprintf(` [%s]["%d"]%4d[""][-] %s`, widget.settings.common.Colors.Title, idx, *pr.Number, *pr.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *pr.Number)
 	}
@@ -138,26 +137,28 @@ func (widget *Widget) displayStats(repo *Repo) string {
 
 func (widget *Widget) title(repo *Repo) string {
 	return fmt.Sprintf(
-		"[%s]%s - %s[white]",
+		"[%s]%s - %s[-]",
 		widget.settings.common.Colors.TextTheme.Title,
 		repo.Owner,
 		repo.Name,
 	)
 }
 
-var mergeIcons = map[string]string{
-	"dirty":    "[red]\u0021[white] ",
-	"clean":    "[green]\u2713[white] ",
-	"unstable": "[red]\u2717[white] ",
-	"blocked":  "[red]\u2717[white] ",
-}
-
 func (widget *Widget) mergeString(pr *ghb.PullRequest) string {
 	if !widget.settings.enableStatus {
 		return ""
 	}
-	if str, ok := mergeIcons[pr.GetMergeableState()]; ok {
-		return str
+
+	colors := widget.settings.common.Colors
+
+	switch pr.GetMergeableState() {
+	case "dirty":
+		return "[" + colors.Error + "]\u0021[-] "
+	case "clean":
+		return "[" + colors.Success + "]\u2713[-] "
+	case "unstable", "blocked":
+		return "[" + colors.Error + "]\u2717[-] "
 	}
+
 	return "? "
 }
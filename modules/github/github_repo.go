@@ -25,7 +25,6 @@ type Repo struct {
 	Owner        string
 	PullRequests []*ghb.PullRequest
 	RemoteRepo   *ghb.Repository
-	Err          error
 }
 
 // NewGithubRepo returns a new Github Repo with a name, owner, apiKey, baseURL and uploadURL
@@ -44,30 +43,48 @@ func NewGithubRepo(name, owner, apiKey, baseURL, uploadURL string) *Repo {
 
 // Open will open the GitHub Repo URL using the utils helper
 func (repo *Repo) Open() {
+	if repo.RemoteRepo == nil {
+		return
+	}
+
 	utils.OpenFile(*repo.RemoteRepo.HTMLURL)
 }
 
 // OpenPulls will open the GitHub Pull Requests URL using the utils helper
 func (repo *Repo) OpenPulls() {
+	if repo.RemoteRepo == nil {
+		return
+	}
+
 	utils.OpenFile(*repo.RemoteRepo.HTMLURL + pullRequestsPath)
 }
 
 // OpenIssues will open the GitHub Issues URL using the utils helper
 func (repo *Repo) OpenIssues() {
+	if repo.RemoteRepo == nil {
+		return
+	}
+
 	utils.OpenFile(*repo.RemoteRepo.HTMLURL + issuesPath)
 }
 
-// Refresh reloads the github data via the Github API
-func (repo *Repo) Refresh() {
+// Refresh reloads the github data via the Github API. If it can't be loaded, the data from
+// the last successful refresh is kept and the error is returned
+func (repo *Repo) Refresh() error {
 	prs, err := repo.loadPullRequests()
-	repo.Err = err
-	repo.PullRequests = prs
 	if err != nil {
-		return
+		return err
 	}
+
 	remote, err := repo.loadRemoteRepository()
-	repo.Err = err
+	if err != nil {
+		return err
+	}
+
+	repo.PullRequests = prs
 	repo.RemoteRepo = remote
+
+	return nil
 }
 
 /* -------------------- Counts -------------------- */
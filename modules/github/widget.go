@@ -1,10 +1,13 @@
 package github
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
+	ghb "github.com/google/go-github/v32/github"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -21,6 +24,14 @@ type Widget struct {
 	Selected int
 	maxItems int
 	Items    []int
+
+	reviewRequests *notify.Changes
+}
+
+// cachedRepo is the data fetched from GitHub for a repository that is saved between runs
+type cachedRepo struct {
+	PullRequests []*ghb.PullRequest
+	RemoteRepo   *ghb.Repository
 }
 
 // NewWidget creates a new instance of the widget
@@ -30,7 +41,8 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.common, "repository", "repositories"),
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
-		settings: settings,
+		reviewRequests: notify.NewChanges(),
+		settings:       settings,
 	}
 
 	widget.GithubRepos = widget.buildRepoCollection(widget.settings.repositories)
@@ -87,6 +99,25 @@ func (widget *Widget) Prev() {
 	widget.View.Highlight(strconv.Itoa(widget.Selected)).ScrollToHighlight()
 }
 
+// SelectAt highlights the item displayed on the given screen row. Returns FALSE if there is
+// no item on that row
+func (widget *Widget) SelectAt(y int) bool {
+	idx, err := strconv.Atoi(view.RegionAt(widget.View, y))
+	if err != nil || idx < 0 || idx >= len(widget.Items) {
+		return false
+	}
+
+	widget.Selected = idx
+	widget.View.Highlight(strconv.Itoa(widget.Selected))
+
+	return true
+}
+
+// OpenSelected opens the highlighted pull request or issue in the browser
+func (widget *Widget) OpenSelected() {
+	widget.openPr()
+}
+
 // Unselect stops highlighting the text and jumps the scroll position to the top
 func (widget *Widget) Unselect() {
 	widget.Selected = -1
@@ -96,10 +127,23 @@ func (widget *Widget) Unselect() {
 
 // Refresh reloads the github data via the Github API and reruns the display
 func (widget *Widget) Refresh() {
+	widget.loadCache()
+
+	var err error
 	for _, repo := range widget.GithubRepos {
-		repo.Refresh()
+		if repoErr := repo.Refresh(); repoErr != nil && err == nil {
+			err = fmt.Errorf("%s/%s: %w", repo.Owner, repo.Name, repoErr)
+		}
+	}
+
+	// Nothing is raised while any repository fails to load, as its review requests would look
+	// new once it loads again
+	if err == nil {
+		widget.notifyNewReviewRequests()
+		widget.saveCache()
 	}
 
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -142,6 +186,69 @@ func (widget *Widget) currentGithubRepo() *Repo {
 	return widget.GithubRepos[widget.Idx]
 }
 
+// loadCache displays the repositories saved by the last run, if there are any, while the
+// first refresh fetches new data
+func (widget *Widget) loadCache() {
+	cached := map[string]cachedRepo{}
+	if !widget.LoadCacheOnce(&cached) {
+		return
+	}
+
+	for _, repo := range widget.GithubRepos {
+		if data, ok := cached[repoKey(repo)]; ok {
+			repo.PullRequests = data.PullRequests
+			repo.RemoteRepo = data.RemoteRepo
+		}
+	}
+
+	widget.display()
+}
+
+// saveCache saves every repository's data so the next run can display it immediately
+func (widget *Widget) saveCache() {
+	cached := map[string]cachedRepo{}
+	for _, repo := range widget.GithubRepos {
+		cached[repoKey(repo)] = cachedRepo{
+			PullRequests: repo.PullRequests,
+			RemoteRepo:   repo.RemoteRepo,
+		}
+	}
+
+	_ = widget.SaveCache(cached)
+}
+
+// repoKey identifies a repository in the cache
+func repoKey(repo *Repo) string {
+	return repo.Owner + "/" + repo.Name
+}
+
+// notifyNewReviewRequests raises a notification for each pull request the user has been asked
+// to review since the last refresh
+func (widget *Widget) notifyNewReviewRequests() {
+	keys := []string{}
+	byKey := map[string]*ghb.PullRequest{}
+
+	for _, repo := range widget.GithubRepos {
+		for _, pr := range repo.myReviewRequests(widget.settings.username) {
+			key := fmt.Sprintf("%s/%s#%d", repo.Owner, repo.Name, pr.GetNumber())
+
+			keys = append(keys, key)
+			byKey[key] = pr
+		}
+	}
+
+	for _, key := range widget.reviewRequests.Added(keys) {
+		pr := byKey[key]
+
+		widget.Notify(notify.Event{
+			Kind:    "review-request",
+			Title:   "Review requested on " + key,
+			Message: pr.GetTitle(),
+			URL:     pr.GetHTMLURL(),
+		})
+	}
+}
+
 func (widget *Widget) openPr() {
 	currentSelection := widget.View.GetHighlights()
 	if widget.Selected >= 0 && currentSelection[0] != "" {
@@ -10,21 +10,6 @@ func (widget *Widget) display() {
 	widget.Redraw(widget.content)
 }
 
-func (widget *Widget) displayError() {
-	widget.Redraw(widget.contentError)
-}
-
-func (widget *Widget) contentError() (string, string, bool) {
-
-	title := fmt.Sprintf("%s - Error", widget.CommonSettings().Title)
-
-	if widget.configError != nil {
-		return title, fmt.Sprintf("Error: \n [red]%v[white]", widget.configError), false
-
-	}
-	return title, "Error", false
-}
-
 func (widget *Widget) content() (string, string, bool) {
 
 	project := widget.currentGitlabProject()
@@ -40,19 +25,19 @@ func (widget *Widget) content() (string, string, bool) {
 
 	_, _, width, _ := widget.View.GetRect()
 	str := widget.settings.common.SigilStr(len(widget.GitlabProjects), widget.Idx, width) + "\n"
-	str += fmt.Sprintf(" [%s]Stats[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Stats[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayStats(project)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]Open Assigned Merge Requests[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Open Assigned Merge Requests[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyAssignedMergeRequests(project, widget.settings.username)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]My Merge Requests[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]My Merge Requests[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyMergeRequests(project, widget.settings.username)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]Open Assigned Issues[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Open Assigned Issues[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyAssignedIssues(project, widget.settings.username)
 	str += "\n"
-	str += fmt.Sprintf(" [%s]My Issues[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]My Issues[-]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyIssues(project, widget.settings.username)
 
 	return title, str, false
@@ -83,13 +68,13 @@ func (widget *Widget) renderMergeRequests(mrs []*gitlab.MergeRequest, username s
 	length := len(mrs)
 
 	if length == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 	maxItems := widget.GetItemCount()
 
 	str := ""
 	for idx, issue := range mrs {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, issue.IID, issue.Title)
+		str += fmt.Sprintf(` [%s]["%d"]%4d[""][-] %s`, widget.settings.common.Colors.Title, maxItems+idx, issue.IID, issue.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, ContentItem{Type: "MR", ID: issue.IID})
 	}
@@ -103,13 +88,13 @@ func (widget *Widget) renderIssues(issues []*gitlab.Issue, username string) stri
 	length := len(issues)
 
 	if length == 0 {
-		return " [grey]none[white]\n"
+		return fmt.Sprintf(" [%s]none[-]\n", widget.settings.common.Colors.Muted)
 	}
 	maxItems := widget.GetItemCount()
 
 	str := ""
 	for idx, issue := range issues {
-		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, issue.IID, issue.Title)
+		str += fmt.Sprintf(` [%s]["%d"]%4d[""][-] %s`, widget.settings.common.Colors.Title, maxItems+idx, issue.IID, issue.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, ContentItem{Type: "ISSUE", ID: issue.IID})
 	}
@@ -130,5 +115,5 @@ func (widget *Widget) displayStats(project *GitlabProject) string {
 }
 
 func (widget *Widget) title(project *GitlabProject) string {
-	return fmt.Sprintf("[green]%s [white]", project.path)
+	return fmt.Sprintf("[%s]%s [-]", widget.settings.common.Colors.Title, project.path)
 }
@@ -11,7 +11,10 @@ type context struct {
 
 func newContext(settings *Settings) (*context, error) {
 	baseURL := settings.domain
-	gitlabClient, _ := glb.NewClient(settings.apiKey, glb.WithBaseURL(baseURL))
+	gitlabClient, err := glb.NewClient(settings.apiKey, glb.WithBaseURL(baseURL))
+	if err != nil {
+		return nil, err
+	}
 
 	user, _, err := gitlabClient.Users.CurrentUser()
 
@@ -48,14 +51,42 @@ func NewGitlabProject(context *context, projectPath string) *GitlabProject {
 	return &project
 }
 
-// Refresh reloads the gitlab data via the Gitlab API
-func (project *GitlabProject) Refresh() {
-	project.MergeRequests, _ = project.loadMergeRequests()
-	project.AssignedMergeRequests, _ = project.loadAssignedMergeRequests()
-	project.AuthoredMergeRequests, _ = project.loadAuthoredMergeRequests()
-	project.AssignedIssues, _ = project.loadAssignedIssues()
-	project.AuthoredIssues, _ = project.loadAuthoredIssues()
-	project.RemoteProject, _ = project.loadRemoteProject()
+// Refresh reloads the gitlab data via the Gitlab API. If any of it can't be loaded, the data
+// from the last successful refresh is kept and the error is returned
+func (project *GitlabProject) Refresh() error {
+	mergeRequests, err := project.loadMergeRequests()
+	if err != nil {
+		return err
+	}
+	assignedMergeRequests, err := project.loadAssignedMergeRequests()
+	if err != nil {
+		return err
+	}
+	authoredMergeRequests, err := project.loadAuthoredMergeRequests()
+	if err != nil {
+		return err
+	}
+	assignedIssues, err := project.loadAssignedIssues()
+	if err != nil {
+		return err
+	}
+	authoredIssues, err := project.loadAuthoredIssues()
+	if err != nil {
+		return err
+	}
+	remoteProject, err := project.loadRemoteProject()
+	if err != nil {
+		return err
+	}
+
+	project.MergeRequests = mergeRequests
+	project.AssignedMergeRequests = assignedMergeRequests
+	project.AuthoredMergeRequests = authoredMergeRequests
+	project.AssignedIssues = assignedIssues
+	project.AuthoredIssues = authoredIssues
+	project.RemoteProject = remoteProject
+
+	return nil
 }
 
 /* -------------------- Counts -------------------- */
@@ -166,7 +197,7 @@ func (project *GitlabProject) loadAssignedIssues() ([]*glb.Issue, error) {
 	return issues, nil
 }
 
-func (project *GitlabProject) loadAuthoredIssues() ([]*glb.Issue, interface{}) {
+func (project *GitlabProject) loadAuthoredIssues() ([]*glb.Issue, error) {
 	state := "opened"
 	opts := glb.ListProjectIssuesOptions{
 		State:    &state,
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/rivo/tview"
@@ -25,27 +26,18 @@ type Widget struct {
 	Selected int
 	maxItems int
 	Items    []ContentItem
-
-	configError error
 }
 
 // NewWidget creates a new instance of the widget
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
-	context, err := newContext(settings)
-
 	widget := Widget{
 		KeyboardWidget:    view.NewKeyboardWidget(app, pages, settings.common),
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.common, "repository", "repositories"),
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
-		context:  context,
 		settings: settings,
-
-		configError: err,
 	}
 
-	widget.GitlabProjects = widget.buildProjectCollection(context, settings.projects)
-
 	widget.initializeKeyboardControls()
 	widget.View.SetRegions(true)
 	widget.View.SetInputCapture(widget.InputCapture)
@@ -61,15 +53,8 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	if widget.context == nil || widget.configError != nil {
-		widget.displayError()
-		return
-	}
-
-	for _, project := range widget.GitlabProjects {
-		project.Refresh()
-	}
-
+	err := widget.refreshProjects()
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -122,6 +107,30 @@ func (widget *Widget) Unselect() {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// refreshProjects reloads every project, connecting to GitLab first if the widget hasn't yet.
+// If a project fails to load, the data from its last successful refresh is kept and the
+// error is returned
+func (widget *Widget) refreshProjects() error {
+	if widget.context == nil {
+		context, err := newContext(widget.settings)
+		if err != nil {
+			return err
+		}
+
+		widget.context = context
+		widget.GitlabProjects = widget.buildProjectCollection(context, widget.settings.projects)
+	}
+
+	var err error
+	for _, project := range widget.GitlabProjects {
+		if projectErr := project.Refresh(); projectErr != nil && err == nil {
+			err = fmt.Errorf("%s: %w", project.path, projectErr)
+		}
+	}
+
+	return err
+}
+
 func (widget *Widget) buildProjectCollection(context *context, projectData []string) []*GitlabProject {
 	gitlabProjects := []*GitlabProject{}
 
@@ -16,7 +16,6 @@ type Widget struct {
 	todos        []*gitlab.Todo
 	gitlabClient *gitlab.Client
 	settings     *Settings
-	err          error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -46,10 +45,12 @@ func (widget *Widget) Refresh() {
 	}
 
 	todos, err := widget.getTodos(widget.settings.apiKey)
-	widget.todos = todos
-	widget.err = err
-	widget.SetItemCount(len(todos))
+	if err == nil {
+		widget.todos = todos
+		widget.SetItemCount(len(todos))
+	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -63,10 +64,6 @@ func (widget *Widget) Render() {
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf("GitLab ToDos (%d)", len(widget.todos))
 
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	if widget.todos == nil {
 		return title, "No ToDos to display", false
 	}
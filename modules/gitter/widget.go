@@ -1,6 +1,7 @@
 package gitter
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/rivo/tview"
@@ -42,26 +43,13 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
-	room, err := GetRoom(widget.settings.roomURI, widget.settings.apiToken)
-	if err != nil {
-		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, err.Error(), true })
-		return
+	messages, err := widget.fetchMessages()
+	if err == nil {
+		widget.messages = messages
+		widget.SetItemCount(len(messages))
 	}
 
-	if room == nil {
-		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, "No room", true })
-		return
-	}
-
-	messages, err := GetMessages(room.ID, widget.settings.numberOfMessages, widget.settings.apiToken)
-
-	if err != nil {
-		widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, err.Error(), true })
-		return
-	}
-	widget.messages = messages
-	widget.SetItemCount(len(messages))
-
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -71,6 +59,20 @@ func (widget *Widget) HelpText() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// fetchMessages returns the most recent messages posted to the widget's room
+func (widget *Widget) fetchMessages() ([]Message, error) {
+	room, err := GetRoom(widget.settings.roomURI, widget.settings.apiToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if room == nil {
+		return nil, errors.New("no room")
+	}
+
+	return GetMessages(room.ID, widget.settings.numberOfMessages, widget.settings.apiToken)
+}
+
 func (widget *Widget) display() {
 	widget.Redraw(widget.content)
 }
@@ -83,12 +85,15 @@ func (widget *Widget) content() (string, string, bool) {
 	var str string
 	for idx, message := range widget.messages {
 		row := fmt.Sprintf(
-			`[%s] [blue]%s [lightslategray]%s: [%s]%s [aqua]%s`,
+			`[%s] [%s]%s [%s]%s: [%s]%s [%s]%s`,
 			widget.RowColor(idx),
+			widget.settings.common.Colors.Label,
 			message.From.DisplayName,
+			widget.settings.common.Colors.Muted,
 			message.From.Username,
 			widget.RowColor(idx),
 			message.Text,
+			widget.settings.common.Colors.Accent,
 			message.Sent.Format("Jan 02, 15:04 MST"),
 		)
 
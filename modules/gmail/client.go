@@ -39,35 +39,31 @@ type GmailClient struct {
 	settings *Settings
 }
 
-func NewClient(settings *Settings) *GmailClient {
+func NewClient(settings *Settings) (*GmailClient, error) {
 	ctx := context.Background()
 
 	secretPath, _ := utils.ExpandHomeDir(settings.secretFile)
 
 	b, err := ioutil.ReadFile(secretPath)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
 
 	config, err := google.ConfigFromJSON(b, gmail.GmailModifyScope)
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
 	client := getClient(ctx, config)
 
 	srv, err := gmail.New(client)
-
 	if err != nil {
-		fmt.Println(err)
-		return nil
+		return nil, err
 	}
 
 	return &GmailClient{
 		service:  srv,
 		settings: settings,
-	}
+	}, nil
 }
 
 func (client *GmailClient) Fetch() ([]*GmailMessage, error) {
@@ -36,7 +36,8 @@ type Widget struct {
 	pages    *tview.Pages
 	settings *Settings
 
-	Client *GmailClient
+	Client    *GmailClient
+	clientErr error
 
 	Messages []*GmailMessage
 	Idx      int
@@ -46,14 +47,14 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 	widget := Widget{
 		TextWidget: view.NewTextWidget(app, settings.common),
 
-		Client:   NewClient(settings),
 		app:      app,
 		pages:    pages,
 		settings: settings,
 		Idx:      0,
 	}
 
-	widget.Messages, _ = widget.Client.Fetch()
+	// The client is created up front as it may need to ask for authorization on the command line
+	widget.Client, widget.clientErr = NewClient(settings)
 
 	widget.View.SetScrollable(true)
 	widget.View.SetInputCapture(widget.keyboardIntercept)
@@ -64,15 +65,27 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
+	if widget.Client == nil {
+		widget.SetRefreshError(widget.clientErr)
+		widget.RedrawTitle()
+		return
+	}
+
 	widget.View.SetText("Updating...")
 
-	widget.Messages, _ = widget.Client.Fetch()
+	messages, err := widget.Client.Fetch()
+	widget.SetRefreshError(err)
+	widget.RedrawTitle()
 
-	if len(widget.Messages) > 0 {
-		widget.Idx = len(widget.Messages) - 1
-		widget.showNotification()
-	} else {
-		widget.Idx = 0
+	if err == nil {
+		widget.Messages = messages
+
+		if len(widget.Messages) > 0 {
+			widget.Idx = len(widget.Messages) - 1
+			widget.showNotification()
+		} else {
+			widget.Idx = 0
+		}
 	}
 
 	widget.display()
@@ -173,7 +186,7 @@ func (widget *Widget) showNotification() {
 
 	err := alertCommand.Start()
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to run alert command: %v", err))
+		logger.Errorf(widget.Name(), "Failed to run alert command: %v", err)
 	}
 }
 
@@ -186,7 +199,7 @@ func (widget *Widget) archiveMessage(message *GmailMessage) {
 	filePath := widget.getMessageDir() + message.id + ".html"
 	err := os.Remove(filePath)
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to delete message file: %v", err))
+		logger.Errorf(widget.Name(), "Failed to delete message file: %v", err)
 	}
 	widget.Client.Archive(widget.currentMessage())
 }
@@ -195,7 +208,7 @@ func (widget *Widget) deleteMessage(message *GmailMessage) {
 	filePath := widget.getMessageDir() + message.id + ".html"
 	err := os.Remove(filePath)
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to delete message file: %v", err))
+		logger.Errorf(widget.Name(), "Failed to delete message file: %v", err)
 	}
 	widget.Client.Trash(widget.currentMessage())
 }
@@ -203,12 +216,12 @@ func (widget *Widget) deleteMessage(message *GmailMessage) {
 func (widget *Widget) deleteOldMessages() {
 	dirRead, err := os.Open(widget.getMessageDir())
 	if err != nil {
-		logger.Log(fmt.Sprintf("Error opening directory: %v", err))
+		logger.Errorf(widget.Name(), "Error opening directory: %v", err)
 	}
 
 	dirFiles, err := dirRead.Readdir(0)
 	if err != nil {
-		logger.Log(fmt.Sprintf("Error getting all files: %v", err))
+		logger.Errorf(widget.Name(), "Error getting all files: %v", err)
 	}
 
 	expectedFiles := make(map[string]struct{}, len(widget.Messages))
@@ -230,6 +243,6 @@ func (widget *Widget) deleteOldMessages() {
 
 		// Remove the file.
 		os.Remove(fullPath)
-		logger.Log(fmt.Sprintf("Removed file: %s", fullPath))
+		logger.Infof(widget.Name(), "Removed file: %s", fullPath)
 	}
 }
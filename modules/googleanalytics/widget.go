@@ -8,7 +8,8 @@ import (
 type Widget struct {
 	view.TextWidget
 
-	settings *Settings
+	settings      *Settings
+	displayBuffer string
 }
 
 func NewWidget(app *tview.Application, settings *Settings) *Widget {
@@ -22,8 +23,11 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 }
 
 func (widget *Widget) Refresh() {
-	websiteReports := widget.Fetch()
-	contentTable := widget.createTable(websiteReports)
+	websiteReports, err := widget.Fetch()
+	if err == nil {
+		widget.displayBuffer = widget.createTable(websiteReports)
+	}
 
-	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, contentTable, false })
+	widget.SetRefreshError(err)
+	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.displayBuffer, false })
 }
@@ -6,37 +6,35 @@ func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
 
 	var out string
-	if widget.Err != nil {
-		return title, widget.Err.Error(), false
-	} else {
-		for idx, alert := range widget.Alerts {
-			out += fmt.Sprintf(` ["%d"][%s]%s - %s[""]`,
-				idx,
-				stateColor(alert.State),
-				stateToEmoji(alert.State),
-				alert.Name,
-			)
-			out += "\n"
-		}
+	for idx, alert := range widget.Alerts {
+		out += fmt.Sprintf(` ["%d"][%s]%s - %s[""]`,
+			idx,
+			widget.stateColor(alert.State),
+			stateToEmoji(alert.State),
+			alert.Name,
+		)
+		out += "\n"
 	}
 
 	return title, out, false
 }
 
-func stateColor(state AlertState) string {
+func (widget *Widget) stateColor(state AlertState) string {
+	colors := widget.settings.common.Colors
+
 	switch state {
 	case Ok:
-		return "green"
+		return colors.Success
 	case Paused:
-		return "yellow"
+		return colors.Warning
 	case Alerting:
-		return "red"
+		return colors.Error
 	case Pending:
-		return "orange"
+		return colors.Warning
 	case NoData:
-		return "yellow"
+		return colors.Warning
 	default:
-		return "white"
+		return colors.Text
 	}
 }
 
@@ -1,7 +1,7 @@
 package grafana
 
 import (
-	"log"
+	"errors"
 	"os"
 	"strings"
 
@@ -30,8 +30,10 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		baseURI: ymlConfig.UString("baseUri", ""),
 	}
 
+	// Settings are also built for modules that aren't enabled, so a missing baseUri is reported
+	// with the module's other configuration errors rather than exiting here
 	if settings.baseURI == "" {
-		log.Fatal("baseUri for grafana is empty, but is required")
+		settings.common.ValidateSetting("baseUri", errors.New("baseUri for grafana is empty, but is required"))
 	} else {
 		settings.baseURI = strings.TrimSuffix(settings.baseURI, "/")
 	}
@@ -15,7 +15,6 @@ type Widget struct {
 
 	Client   *Client
 	Alerts   []Alert
-	Err      error
 	Selected int
 
 	settings *Settings
@@ -45,14 +44,11 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 func (widget *Widget) Refresh() {
 	alerts, err := widget.Client.Alerts()
-	if err != nil {
-		widget.Err = err
-		widget.Alerts = nil
-	} else {
-		widget.Err = nil
+	if err == nil {
 		widget.Alerts = alerts
 	}
 
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -33,7 +33,6 @@ func (widget *Widget) Fetch() ([]*sheets.ValueRange, error) {
 
 	b, err := ioutil.ReadFile(filepath.Clean(secretPath))
 	if err != nil {
-		log.Fatalf("Unable to read secretPath. %v", err)
 		return nil, err
 	}
 
@@ -14,7 +14,6 @@ type Widget struct {
 
 	settings *Settings
 	cells    []*sheets.ValueRange
-	err      error
 }
 
 func NewWidget(app *tview.Application, settings *Settings) *Widget {
@@ -31,9 +30,11 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 
 func (widget *Widget) Refresh() {
 	cells, err := widget.Fetch()
-	widget.err = err
-	widget.cells = cells
+	if err == nil {
+		widget.cells = cells
+	}
 
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -41,9 +42,6 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
 
 	if widget.cells == nil {
 		return title, "No cells", false
@@ -16,7 +16,6 @@ type Widget struct {
 
 	stories  []Story
 	settings *Settings
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -44,11 +43,7 @@ func (widget *Widget) Refresh() {
 	}
 
 	storyIds, err := GetStories(widget.settings.storyType)
-	if err != nil {
-		widget.err = err
-		widget.stories = nil
-		widget.SetItemCount(0)
-	} else {
+	if err == nil {
 		var stories []Story
 		for idx := 0; idx < widget.settings.numberOfStories; idx++ {
 			story, e := GetStory(storyIds[idx])
@@ -60,6 +55,7 @@ func (widget *Widget) Refresh() {
 		widget.SetItemCount(len(stories))
 	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -73,10 +69,6 @@ func (widget *Widget) Render() {
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf("%s - %s stories", widget.CommonSettings().Title, widget.settings.storyType)
 
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	if len(widget.stories) == 0 {
 		return title, "No stories to display", false
 	}
@@ -86,10 +78,11 @@ func (widget *Widget) content() (string, string, bool) {
 		u, _ := url.Parse(story.URL)
 
 		row := fmt.Sprintf(
-			`[%s]%2d. %s [lightblue](%s)[white]`,
+			`[%s]%2d. %s [%s](%s)[-]`,
 			widget.RowColor(idx),
 			idx+1,
 			story.Title,
+			widget.settings.common.Colors.Label,
 			strings.TrimPrefix(u.Host, "www."),
 		)
 
@@ -13,7 +13,6 @@ type Widget struct {
 
 	settings *Settings
 	statuses []*Status
-	err      error
 }
 
 // NewWidget creates a new instance of a widget
@@ -49,14 +48,11 @@ func (widget *Widget) Fetch(accounts []string) ([]*Status, error) {
 func (widget *Widget) Refresh() {
 	statuses, err := widget.Fetch(widget.settings.accounts)
 
-	if err != nil {
-		widget.err = err
-		widget.statuses = nil
-	} else {
-		widget.err = nil
+	if err == nil {
 		widget.statuses = statuses
 	}
 
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -64,10 +60,6 @@ func (widget *Widget) Refresh() {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	title += widget.sinceDateForTitle()
 	str := ""
 
@@ -79,7 +71,7 @@ func (widget *Widget) content() (string, string, bool) {
 		}
 
 		if status != nil {
-			str += fmt.Sprintf(" [%s]%s[white]\n", color, status.Account)
+			str += fmt.Sprintf(" [%s]%s[-]\n", color, status.Account)
 		}
 	}
 
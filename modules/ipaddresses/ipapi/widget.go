@@ -50,37 +50,35 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 
 // Refresh refresh the module
 func (widget *Widget) Refresh() {
-	widget.ipinfo()
+	err := widget.ipinfo()
 
+	widget.SetRefreshError(err)
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
 //this method reads the config and calls ipinfo for ip information
-func (widget *Widget) ipinfo() {
+func (widget *Widget) ipinfo() error {
 	client := &http.Client{}
 	req, err := http.NewRequest("GET", "http://ip-api.com/json", nil)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 	req.Header.Set("User-Agent", "curl")
 	response, err := client.Do(req)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 	defer func() { _ = response.Body.Close() }()
 	var info ipinfo
 	err = json.NewDecoder(response.Body).Decode(&info)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 
-	widget.setResult(&info)
+	return widget.setResult(&info)
 }
 
-func (widget *Widget) setResult(info *ipinfo) {
+func (widget *Widget) setResult(info *ipinfo) error {
 	resultTemplate, _ := template.New("ipinfo_result").Parse(
 		formatableText("IP Address", "Ip") +
 			formatableText("ISP", "ISP") +
@@ -112,10 +110,12 @@ func (widget *Widget) setResult(info *ipinfo) {
 	})
 
 	if err != nil {
-		widget.result = err.Error()
+		return err
 	}
 
 	widget.result = resultBuffer.String()
+
+	return nil
 }
 
 func formatableText(key, value string) string {
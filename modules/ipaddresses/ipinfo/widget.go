@@ -43,13 +43,14 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 }
 
 func (widget *Widget) Refresh() {
-	widget.ipinfo()
+	err := widget.ipinfo()
 
+	widget.SetRefreshError(err)
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
 //this method reads the config and calls ipinfo for ip information
-func (widget *Widget) ipinfo() {
+func (widget *Widget) ipinfo() error {
 	client := &http.Client{}
 	var url string
 	ip, ipv6 := getMyIP()
@@ -61,28 +62,25 @@ func (widget *Widget) ipinfo() {
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 	req.Header.Set("User-Agent", "curl")
 	response, err := client.Do(req)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 	defer func() { _ = response.Body.Close() }()
 
 	var info ipinfo
 	err = json.NewDecoder(response.Body).Decode(&info)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 
-	widget.setResult(&info)
+	return widget.setResult(&info)
 }
 
-func (widget *Widget) setResult(info *ipinfo) {
+func (widget *Widget) setResult(info *ipinfo) error {
 	resultTemplate, _ := template.New("ipinfo_result").Parse(
 		formatableText("IP", "Ip") +
 			formatableText("Hostname", "Hostname") +
@@ -109,10 +107,12 @@ func (widget *Widget) setResult(info *ipinfo) {
 	})
 
 	if err != nil {
-		widget.result = err.Error()
+		return err
 	}
 
 	widget.result = resultBuffer.String()
+
+	return nil
 }
 
 func formatableText(key, value string) string {
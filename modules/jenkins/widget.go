@@ -3,8 +3,10 @@ package jenkins
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -13,9 +15,9 @@ type Widget struct {
 	view.KeyboardWidget
 	view.ScrollableWidget
 
+	failed   *notify.Changes
 	settings *Settings
 	view     *View
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -23,10 +25,12 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
 		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
+		failed:   notify.NewChanges(),
 		settings: settings,
 	}
 
 	widget.SetRenderFunction(widget.Render)
+	widget.SetOpenFunction(widget.openJob)
 	widget.initializeKeyboardControls()
 	widget.View.SetInputCapture(widget.InputCapture)
 
@@ -47,15 +51,13 @@ func (widget *Widget) Refresh() {
 		widget.settings.user,
 		widget.settings.apiKey,
 	)
-	widget.view = view
-
-	if err != nil {
-		widget.err = err
-		widget.SetItemCount(0)
-	} else {
+	if err == nil {
+		widget.view = view
 		widget.SetItemCount(len(widget.view.Jobs))
+		widget.notifyNewFailures(widget.view.Jobs)
 	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -66,11 +68,12 @@ func (widget *Widget) Render() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-	title := fmt.Sprintf("%s: [red]%s", widget.CommonSettings().Title, widget.view.Name)
-	if widget.err != nil {
-		return title, widget.err.Error(), true
+	if widget.view == nil {
+		return widget.CommonSettings().Title, "No content to display", false
 	}
-	if widget.view == nil || len(widget.view.Jobs) == 0 {
+
+	title := fmt.Sprintf("%s: [%s]%s", widget.CommonSettings().Title, widget.settings.common.Colors.Subheading, widget.view.Name)
+	if len(widget.view.Jobs) == 0 {
 		return title, "No content to display", false
 	}
 
@@ -80,7 +83,7 @@ func (widget *Widget) content() (string, string, bool) {
 		jobName, _ := url.QueryUnescape(job.Name)
 
 		row := fmt.Sprintf(
-			`[%s] [%s]%-6s[white]`,
+			`[%s] [%s]%-6s[-]`,
 			widget.RowColor(idx),
 			widget.jobColor(job),
 			jobName,
@@ -98,9 +101,34 @@ func (widget *Widget) jobColor(job Job) string {
 		// Override color if successBallColor boolean param provided in config
 		return widget.settings.successBallColor
 	case "red":
-		return "red"
+		return widget.settings.common.Colors.Error
 	default:
-		return "white"
+		return widget.settings.common.Colors.Text
+	}
+}
+
+// notifyNewFailures raises a notification for each job that has failed since the last refresh
+func (widget *Widget) notifyNewFailures(jobs []Job) {
+	names := []string{}
+	byName := map[string]Job{}
+
+	for _, job := range jobs {
+		// Jobs that are building again after failing have the color red_anime
+		if strings.HasPrefix(job.Color, "red") {
+			names = append(names, job.Name)
+			byName[job.Name] = job
+		}
+	}
+
+	for _, name := range widget.failed.Added(names) {
+		jobName, _ := url.QueryUnescape(name)
+
+		widget.Notify(notify.Event{
+			Kind:    "build-failed",
+			Title:   "Jenkins job failed",
+			Message: jobName,
+			URL:     byName[name].Url,
+		})
 	}
 }
 
@@ -14,7 +14,6 @@ type Widget struct {
 
 	result   *SearchResult
 	settings *Settings
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -26,6 +25,7 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 	}
 
 	widget.SetRenderFunction(widget.Render)
+	widget.SetOpenFunction(widget.openItem)
 	widget.initializeKeyboardControls()
 	widget.View.SetInputCapture(widget.InputCapture)
 
@@ -37,21 +37,21 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
+	widget.loadCache()
+
 	searchResult, err := widget.IssuesFor(
 		widget.settings.username,
 		widget.settings.projects,
 		widget.settings.jql,
 	)
 
-	if err != nil {
-		widget.err = err
-		widget.result = nil
-		widget.SetItemCount(0)
-	} else {
-		widget.err = nil
+	if err == nil {
 		widget.result = searchResult
 		widget.SetItemCount(len(searchResult.Issues))
+
+		_ = widget.SaveCache(searchResult)
 	}
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -61,6 +61,19 @@ func (widget *Widget) Render() {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// loadCache displays the search results saved by the last run, if there are any, while the
+// first refresh fetches new ones
+func (widget *Widget) loadCache() {
+	searchResult := &SearchResult{}
+	if !widget.LoadCacheOnce(searchResult) {
+		return
+	}
+
+	widget.result = searchResult
+	widget.SetItemCount(len(searchResult.Issues))
+	widget.Render()
+}
+
 func (widget *Widget) openItem() {
 	sel := widget.GetSelected()
 	if sel >= 0 && widget.result != nil && sel < len(widget.result.Issues) {
@@ -73,13 +86,9 @@ const MaxIssueTypeLength = 7
 const MaxStatusNameLength = 14
 
 func (widget *Widget) content() (string, string, bool) {
-	if widget.err != nil {
-		return widget.CommonSettings().Title, widget.err.Error(), true
-	}
-
 	title := widget.CommonSettings().Title
 
-	str := fmt.Sprintf(" [%s]Assigned Issues[white]\n", widget.settings.common.Colors.Subheading)
+	str := fmt.Sprintf(" [%s]Assigned Issues[-]\n", widget.settings.common.Colors.Subheading)
 
 	if widget.result == nil || len(widget.result.Issues) == 0 {
 		return title, "No results to display", false
@@ -89,13 +98,15 @@ func (widget *Widget) content() (string, string, bool) {
 
 	for idx, issue := range widget.result.Issues {
 		row := fmt.Sprintf(
-			`[%s] [%s]%-*s[white] [green]%-*s[white] [yellow]%-*s[white] [%s]%s`,
+			`[%s] [%s]%-*s[-] [%s]%-*s[-] [%s]%-*s[-] [%s]%s`,
 			widget.RowColor(idx),
 			widget.issueTypeColor(&issue),
 			longestIssueTypeLength+1,
 			trimToMaxLength(issue.IssueFields.IssueType.Name, MaxIssueTypeLength),
+			widget.settings.common.Colors.Title,
 			longestKeyLength+1,
 			issue.Key,
+			widget.settings.common.Colors.Accent,
 			longestStatusNameLength+1,
 			trimToMaxLength(issue.IssueFields.IssueStatus.IName, MaxStatusNameLength),
 			widget.RowColor(idx),
@@ -143,13 +154,13 @@ func getLongestColumnLengths(issues []Issue) (int, int, int) {
 func (widget *Widget) issueTypeColor(issue *Issue) string {
 	switch issue.IssueFields.IssueType.Name {
 	case "Bug":
-		return "red"
+		return widget.settings.common.Colors.Error
 	case "Story":
-		return "blue"
+		return widget.settings.common.Colors.Label
 	case "Task":
-		return "orange"
+		return widget.settings.common.Colors.Accent
 	default:
-		return "white"
+		return widget.settings.common.Colors.Text
 	}
 }
 
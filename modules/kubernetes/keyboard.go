@@ -0,0 +1,18 @@
+package kubernetes
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next pod")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous pod")
+	widget.SetKeyboardChar("f", widget.ShowFilter, "Filter pods")
+	widget.SetKeyboardChar("s", widget.SortNext, "Sort by the next column")
+	widget.SetKeyboardChar("S", widget.SortReverse, "Reverse the sort order")
+	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next pod")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous pod")
+}
@@ -7,7 +7,7 @@ import (
 )
 
 const (
-	defaultFocusable = false
+	defaultFocusable = true
 	defaultTitle     = "Kubernetes"
 )
 
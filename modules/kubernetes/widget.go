@@ -2,18 +2,23 @@ package kubernetes
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Widget contains all the config for the widget
 type Widget struct {
-	view.TextWidget
+	view.KeyboardWidget
+	view.TableWidget
 
+	content    string
+	mu         sync.Mutex
 	objects    []string
 	title      string
 	kubeconfig string
@@ -23,9 +28,10 @@ type Widget struct {
 }
 
 // NewWidget creates a new instance of the widget
-func NewWidget(app *tview.Application, settings *Settings) *Widget {
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(app, settings.common),
+		KeyboardWidget: view.NewKeyboardWidget(app, pages, settings.common),
+		TableWidget:    view.NewTableWidget(app, pages, settings.common, podColumns(settings.namespaces)),
 
 		objects:    settings.objects,
 		title:      settings.title,
@@ -35,30 +41,83 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 		context:    settings.context,
 	}
 
-	widget.View.SetWrap(true)
+	widget.SetRenderFunction(widget.display)
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.KeyboardWidget.SetView(widget.View)
 
 	return &widget
 }
 
 // Refresh executes the command and updates the view with the results
 func (widget *Widget) Refresh() {
-	title := widget.generateTitle()
-	client, err := widget.getInstance()
+	content, pods, err := widget.fetchContent()
+	if err == nil {
+		widget.mu.Lock()
+		widget.content = content
+		widget.SetRows(pods)
+		widget.mu.Unlock()
+	}
+
+	widget.SetRefreshError(err)
+	widget.display()
+}
+
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
+}
+
+// Next selects the next pod
+func (widget *Widget) Next() {
+	widget.ScrollableWidget.Next()
+}
+
+// Prev selects the previous pod
+func (widget *Widget) Prev() {
+	widget.ScrollableWidget.Prev()
+}
 
+// Unselect clears the selection of pods
+func (widget *Widget) Unselect() {
+	widget.ScrollableWidget.Unselect()
+	widget.RenderFunction()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(func() (string, string, bool) {
+		widget.mu.Lock()
+		defer widget.mu.Unlock()
+
+		content := widget.content
+		if utils.Includes(widget.objects, "pods") {
+			content += fmt.Sprintf("[%s]Pods[-]\n", widget.settings.common.Colors.Subheading)
+			content += widget.TableContent()
+		}
+
+		return widget.TableTitle(widget.generateTitle()), content, false
+	})
+}
+
+// fetchContent queries the cluster for each configured object type. Nodes and deployments
+// are returned as text, pods as rows of the pods table
+func (widget *Widget) fetchContent() (string, []view.TableRow, error) {
+	client, err := widget.getInstance()
 	if err != nil {
-		widget.Redraw(func() (string, string, bool) { return title, err.Error(), true })
-		return
+		return "", nil, err
 	}
 
 	var content string
 
 	if utils.Includes(widget.objects, "nodes") {
-		nodeList, nodeError := client.getNodes()
-		if nodeError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting node data [white]\n", true })
-			return
+		nodeList, err := client.getNodes()
+		if err != nil {
+			return "", nil, fmt.Errorf("error getting node data: %w", err)
 		}
-		content += fmt.Sprintf("[%s]Nodes[white]\n", widget.settings.common.Colors.Subheading)
+		content += fmt.Sprintf("[%s]Nodes[-]\n", widget.settings.common.Colors.Subheading)
 		for _, node := range nodeList {
 			content += fmt.Sprintf("%s\n", node)
 		}
@@ -66,36 +125,28 @@ func (widget *Widget) Refresh() {
 	}
 
 	if utils.Includes(widget.objects, "deployments") {
-		deploymentList, deploymentError := client.getDeployments(widget.namespaces)
-		if deploymentError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting deployment data [white]\n", true })
-			return
+		deploymentList, err := client.getDeployments(widget.namespaces)
+		if err != nil {
+			return "", nil, fmt.Errorf("error getting deployment data: %w", err)
 		}
-		content += fmt.Sprintf("[%s]Deployments[white]\n", widget.settings.common.Colors.Subheading)
+		content += fmt.Sprintf("[%s]Deployments[-]\n", widget.settings.common.Colors.Subheading)
 		for _, deployment := range deploymentList {
 			content += fmt.Sprintf("%s\n", deployment)
 		}
 		content += "\n"
 	}
 
+	pods := []view.TableRow{}
 	if utils.Includes(widget.objects, "pods") {
-		podList, podError := client.getPods(widget.namespaces)
-		if podError != nil {
-			widget.Redraw(func() (string, string, bool) { return title, "[red] Error getting pod data [white]\n", false })
-			return
-		}
-		content += fmt.Sprintf("[%s]Pods[white]\n", widget.settings.common.Colors.Subheading)
-		for _, pod := range podList {
-			content += fmt.Sprintf("%s\n", pod)
+		pods, err = client.getPods(widget.namespaces)
+		if err != nil {
+			return "", nil, fmt.Errorf("error getting pod data: %w", err)
 		}
-		content += "\n"
 	}
 
-	widget.Redraw(func() (string, string, bool) { return title, content, false })
+	return content, pods, nil
 }
 
-/* -------------------- Unexported Functions -------------------- */
-
 // generateTitle generates a title for the widget
 func (widget *Widget) generateTitle() string {
 	if len(widget.title) != 0 {
@@ -115,9 +166,24 @@ func (widget *Widget) generateTitle() string {
 	return title
 }
 
-// getPods returns a slice of pod strings
-func (client *clientInstance) getPods(namespaces []string) ([]string, error) {
-	var podList []string
+// podColumns returns the columns of the pods table. The namespace is left out when only one
+// namespace is watched, as it is in the title
+func podColumns(namespaces []string) []view.TableColumn {
+	columns := []view.TableColumn{
+		{Name: "Name", Type: view.ColumnText},
+		{Name: "Status", Type: view.ColumnText},
+	}
+
+	if len(namespaces) == 1 {
+		return columns
+	}
+
+	return append([]view.TableColumn{{Name: "Namespace", Type: view.ColumnText}}, columns...)
+}
+
+// getPods returns a row of the pods table for each pod
+func (client *clientInstance) getPods(namespaces []string) ([]view.TableRow, error) {
+	var podList []corev1.Pod
 	if len(namespaces) != 0 {
 		for _, namespace := range namespaces {
 			pods, err := client.Client.CoreV1().Pods(namespace).List(metav1.ListOptions{})
@@ -125,30 +191,32 @@ func (client *clientInstance) getPods(namespaces []string) ([]string, error) {
 				return nil, err
 			}
 
-			for _, pod := range pods.Items {
-				var podString string
-				status := pod.Status.Phase
-				name := pod.ObjectMeta.Name
-				if len(namespaces) == 1 {
-					podString = fmt.Sprintf("%-50s %s", name, status)
-				} else {
-					podString = fmt.Sprintf("%-20s %-50s %s", namespace, name, status)
-				}
-				podList = append(podList, podString)
-			}
+			podList = append(podList, pods.Items...)
 		}
 	} else {
 		pods, err := client.Client.CoreV1().Pods("").List(metav1.ListOptions{})
 		if err != nil {
 			return nil, err
 		}
-		for _, pod := range pods.Items {
-			podString := fmt.Sprintf("%-20s %-50s %s", pod.ObjectMeta.Namespace, pod.ObjectMeta.Name, pod.Status.Phase)
-			podList = append(podList, podString)
+
+		podList = pods.Items
+	}
+
+	rows := []view.TableRow{}
+	for _, pod := range podList {
+		cells := []view.TableCell{
+			{Text: pod.ObjectMeta.Name},
+			{Text: string(pod.Status.Phase)},
 		}
+
+		if len(namespaces) != 1 {
+			cells = append([]view.TableCell{{Text: pod.ObjectMeta.Namespace}}, cells...)
+		}
+
+		rows = append(rows, view.TableRow{Cells: cells})
 	}
 
-	return podList, nil
+	return rows, nil
 }
 
 // get Deployments returns a string slice of pod strings
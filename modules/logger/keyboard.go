@@ -0,0 +1,64 @@
+package logger
+
+import (
+	"github.com/gdamore/tcell"
+	log "github.com/wtfutil/wtf/logger"
+)
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next entry")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous entry")
+	widget.SetKeyboardChar("l", widget.nextLevel, "Show entries from the next level up")
+	widget.SetKeyboardChar("m", widget.nextModule, "Show entries from the next module")
+	widget.SetKeyboardChar("a", widget.showAll, "Show all entries")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next entry")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous entry")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+}
+
+// nextLevel raises the lowest level of entry displayed, wrapping around from error to debug
+func (widget *Widget) nextLevel() {
+	widget.level++
+	if widget.level > log.LevelError {
+		widget.level = log.LevelDebug
+	}
+
+	widget.applyFilters()
+	widget.Render()
+}
+
+// nextModule displays only the entries from the next module, in alphabetical order, and
+// then entries from every module again after the last one
+func (widget *Widget) nextModule() {
+	modules := widget.modules()
+
+	next := ""
+	for idx, module := range modules {
+		if widget.module == "" {
+			next = module
+			break
+		}
+
+		if module == widget.module && idx < len(modules)-1 {
+			next = modules[idx+1]
+			break
+		}
+	}
+
+	widget.module = next
+
+	widget.applyFilters()
+	widget.Render()
+}
+
+// showAll clears the level and module filters
+func (widget *Widget) showAll() {
+	widget.level = log.LevelDebug
+	widget.module = ""
+
+	widget.applyFilters()
+	widget.Render()
+}
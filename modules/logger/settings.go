@@ -3,20 +3,30 @@ package logger
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
-	defaultFocusable = true
-	defaultTitle     = "Logger"
+	defaultFocusable  = true
+	defaultMaxEntries = 500
+	defaultTitle      = "Logger"
 )
 
 type Settings struct {
 	common *cfg.Common
+
+	level      string   `help:"The lowest level of entry displayed. Default is debug" values:"debug, info, warn, error" optional:"true"`
+	maxEntries int      `help:"How many of the most recent entries in the log file are read. Default is 500" optional:"true"`
+	modules    []string `help:"Only entries from these modules are displayed. Default is all modules" optional:"true"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
 	settings := Settings{
 		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		level:      ymlConfig.UString("level", "debug"),
+		maxEntries: ymlConfig.UInt("maxEntries", defaultMaxEntries),
+		modules:    utils.ToStrs(ymlConfig.UList("modules")),
 	}
 
 	return &settings
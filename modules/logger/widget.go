@@ -1,103 +1,257 @@
 package logger
 
 import (
+	"bufio"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/rivo/tview"
 	log "github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
 
 const (
-	maxBufferSize int64 = 1024
+	// maxLineSize is the longest line read from the log file
+	maxLineSize = 1024 * 1024
 )
 
+// Widget displays the most recent entries in the log file, newest first. The entries can be
+// filtered by level and by module
 type Widget struct {
-	view.TextWidget
+	view.KeyboardWidget
+	view.ScrollableWidget
 
-	app      *tview.Application
-	filePath string
-	settings *Settings
+	allEntries []log.Entry
+	entries    []log.Entry
+	level      log.Level
+	module     string
+	settings   *Settings
 }
 
-func NewWidget(app *tview.Application, settings *Settings) *Widget {
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
+	level, err := log.ParseLevel(settings.level)
+	if err != nil {
+		level = log.LevelDebug
+	}
+
 	widget := Widget{
-		TextWidget: view.NewTextWidget(app, settings.common),
+		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
+		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
-		app:      app,
-		filePath: log.LogFilePath(),
+		level:    level,
 		settings: settings,
 	}
 
+	widget.SetRenderFunction(widget.Render)
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.KeyboardWidget.SetView(widget.View)
+
 	return &widget
 }
 
-// Refresh updates the onscreen contents of the widget
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh re-reads the log file and updates the onscreen contents of the widget
 func (widget *Widget) Refresh() {
+	entries, err := readEntries(log.LogFilePath(), widget.settings.maxEntries)
+	if err == nil {
+		widget.allEntries = entries
+		widget.applyFilters()
+	}
+
+	widget.SetRefreshError(err)
+
+	widget.Render()
+}
+
+// Render sets up the widget data for redrawing to the screen
+func (widget *Widget) Render() {
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
+	title := widget.title()
+
 	if log.LogFileMissing() {
-		return widget.CommonSettings().Title, "File missing", false
+		return title, "File missing", false
 	}
 
-	logLines := widget.tailFile()
+	if len(widget.entries) == 0 {
+		return title, "No log entries to display", false
+	}
+
+	colors := widget.settings.common.Colors
 	str := ""
 
-	for _, line := range logLines {
-		chunks := strings.Split(line, " ")
+	for idx, entry := range widget.entries {
+		at := strings.Repeat(" ", 8)
+		if !entry.Time.IsZero() {
+			at = entry.Time.Format("15:04:05")
+		}
+
+		// The visible width of the row: its time, level, module and message
+		width := len(at) + 7 + len(entry.Message)
 
-		if len(chunks) >= 4 {
-			str += fmt.Sprintf(
-				"[green]%s[white] [yellow]%s[white] %s\n",
-				chunks[0],
-				chunks[1],
-				strings.Join(chunks[3:], " "),
-			)
+		module := ""
+		if entry.Module != "" {
+			module = fmt.Sprintf("[%s]%s[%s] ", colors.Accent, tview.Escape(entry.Module), widget.RowColor(idx))
+			width += len(entry.Module) + 1
 		}
+
+		row := fmt.Sprintf(
+			"[%s]%s [%s]%-5s[%s] %s%s",
+			widget.RowColor(idx),
+			at,
+			widget.levelColor(entry.Level),
+			entry.Level,
+			widget.RowColor(idx),
+			module,
+			tview.Escape(entry.Message),
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, width)
 	}
 
-	return widget.CommonSettings().Title, str, false
+	return title, str, false
 }
 
-func (widget *Widget) tailFile() []string {
-	file, err := os.Open(widget.filePath)
-	if err != nil {
-		return []string{}
+// applyFilters selects the entries to display from those read from the log file
+func (widget *Widget) applyFilters() {
+	widget.entries = filterEntries(widget.allEntries, widget.level, widget.moduleFilter())
+	widget.SetItemCount(len(widget.entries))
+
+	if widget.Selected >= len(widget.entries) {
+		widget.Selected = len(widget.entries) - 1
 	}
-	defer func() { _ = file.Close() }()
+}
 
-	stat, err := file.Stat()
-	if err != nil {
-		return []string{}
+func (widget *Widget) levelColor(level log.Level) string {
+	colors := widget.settings.common.Colors
+
+	switch level {
+	case log.LevelDebug:
+		return colors.Muted
+	case log.LevelWarn:
+		return colors.Warning
+	case log.LevelError:
+		return colors.Error
+	default:
+		return colors.Text
+	}
+}
+
+// moduleFilter returns the modules whose entries are displayed, or nil for all of them
+func (widget *Widget) moduleFilter() []string {
+	if widget.module != "" {
+		return []string{widget.module}
 	}
 
-	bufferSize := maxBufferSize
-	if maxBufferSize > stat.Size() {
-		bufferSize = stat.Size()
+	if len(widget.settings.modules) > 0 {
+		return widget.settings.modules
 	}
 
-	startPos := stat.Size() - bufferSize
+	return nil
+}
+
+// modules returns the names of the modules that entries can be filtered by, sorted
+func (widget *Widget) modules() []string {
+	if len(widget.settings.modules) > 0 {
+		return widget.settings.modules
+	}
 
-	buff := make([]byte, bufferSize)
-	_, err = file.ReadAt(buff, startPos)
+	found := map[string]bool{}
+	for _, entry := range widget.allEntries {
+		if entry.Module != "" {
+			found[entry.Module] = true
+		}
+	}
+
+	modules := []string{}
+	for module := range found {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	return modules
+}
+
+func (widget *Widget) title() string {
+	title := widget.CommonSettings().Title
+
+	if widget.level > log.LevelDebug {
+		title = fmt.Sprintf("%s - %s+", title, widget.level)
+	}
+
+	if widget.module != "" {
+		title = fmt.Sprintf("%s - %s", title, widget.module)
+	}
+
+	return title
+}
+
+// filterEntries returns the entries at or above the level, from any of the modules, or from
+// every module if modules is nil
+func filterEntries(entries []log.Entry, level log.Level, modules []string) []log.Entry {
+	filtered := []log.Entry{}
+
+	for _, entry := range entries {
+		if entry.Level < level {
+			continue
+		}
+
+		if modules != nil && !utils.Includes(modules, entry.Module) {
+			continue
+		}
+
+		filtered = append(filtered, entry)
+	}
+
+	return filtered
+}
+
+// readEntries returns the last maxEntries entries in the log file, newest first. A log file
+// that hasn't been written to yet has no entries
+func readEntries(path string, maxEntries int) ([]log.Entry, error) {
+	file, err := os.Open(path)
 	if err != nil {
-		return []string{}
+		if os.IsNotExist(err) {
+			return []log.Entry{}, nil
+		}
+		return nil, err
 	}
+	defer func() { _ = file.Close() }()
+
+	lines := []string{}
 
-	logLines := strings.Split(string(buff), "\n")
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	for scanner.Scan() {
+		if scanner.Text() == "" {
+			continue
+		}
+
+		lines = append(lines, scanner.Text())
+		if maxEntries > 0 && len(lines) > maxEntries {
+			lines = lines[1:]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
 
-	// Reverse the array of lines
-	// Offset by two to account for the blank line at the end
-	last := len(logLines) - 2
-	for i := 0; i < len(logLines)/2; i++ {
-		logLines[i], logLines[last-i] = logLines[last-i], logLines[i]
+	entries := make([]log.Entry, len(lines))
+	for idx, line := range lines {
+		entries[len(lines)-1-idx] = log.ParseEntry(line)
 	}
 
-	return logLines
+	return entries, nil
 }
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	log "github.com/wtfutil/wtf/logger"
+)
+
+func Test_filterEntries(t *testing.T) {
+	entries := []log.Entry{
+		{Level: log.LevelDebug, Module: "github", Message: "one"},
+		{Level: log.LevelWarn, Module: "github", Message: "two"},
+		{Level: log.LevelError, Module: "jira", Message: "three"},
+		{Level: log.LevelInfo, Message: "four"},
+	}
+
+	tests := []struct {
+		name     string
+		level    log.Level
+		modules  []string
+		expected []string
+	}{
+		{name: "everything", level: log.LevelDebug, modules: nil, expected: []string{"one", "two", "three", "four"}},
+		{name: "by level", level: log.LevelWarn, modules: nil, expected: []string{"two", "three"}},
+		{name: "by module", level: log.LevelDebug, modules: []string{"github"}, expected: []string{"one", "two"}},
+		{name: "by level and module", level: log.LevelError, modules: []string{"github"}, expected: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := []string{}
+			for _, entry := range filterEntries(entries, tt.level, tt.modules) {
+				messages = append(messages, entry.Message)
+			}
+
+			assert.Equal(t, tt.expected, messages)
+		})
+	}
+}
+
+func Test_readEntries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wtf-logger")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "log.txt")
+
+	entries, err := readEntries(path, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []log.Entry{}, entries)
+
+	contents := "2020-06-14 09:15:02 INFO -: one\n\n2020-06-14 09:15:03 WARN github: two\n2020-06-14 09:15:04 ERROR jira: three\n"
+	assert.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+
+	entries, err = readEntries(path, 2)
+	assert.NoError(t, err)
+
+	if assert.Equal(t, 2, len(entries)) {
+		assert.Equal(t, "three", entries[0].Message)
+		assert.Equal(t, "two", entries[1].Message)
+	}
+}
@@ -19,27 +19,36 @@ type MercurialRepo struct {
 	Path         string
 }
 
-func NewMercurialRepo(repoPath string, commitCount int, commitFormat string) *MercurialRepo {
+// NewMercurialRepo reads the state of the repository at repoPath. It returns an error if any
+// of the hg commands it runs fails
+func NewMercurialRepo(repoPath string, commitCount int, commitFormat string) (*MercurialRepo, error) {
 	repo := MercurialRepo{Path: repoPath}
 
-	repo.Branch = strings.TrimSpace(repo.branch())
+	branch, err := repo.branch()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
+	repo.Branch = strings.TrimSpace(branch)
 	repo.Bookmark = strings.TrimSpace(repo.bookmark())
-	repo.ChangedFiles = repo.changedFiles()
-	repo.Commits = repo.commits(commitCount, commitFormat)
+
+	if repo.ChangedFiles, err = repo.changedFiles(); err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
+	if repo.Commits, err = repo.commits(commitCount, commitFormat); err != nil {
+		return nil, fmt.Errorf("%s: %w", repoPath, err)
+	}
 	repo.Repository = strings.TrimSpace(repo.Path)
 
-	return &repo
+	return &repo, nil
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (repo *MercurialRepo) branch() string {
+func (repo *MercurialRepo) branch() (string, error) {
 	arg := []string{"branch", repo.repoPath()}
 
 	cmd := exec.Command("hg", arg...)
-	str := utils.ExecuteCommand(cmd)
-
-	return str
+	return utils.RunCommand(cmd)
 }
 
 func (repo *MercurialRepo) bookmark() string {
@@ -50,29 +59,35 @@ func (repo *MercurialRepo) bookmark() string {
 	return string(bookmark)
 }
 
-func (repo *MercurialRepo) changedFiles() []string {
+func (repo *MercurialRepo) changedFiles() ([]string, error) {
 	arg := []string{"status", repo.repoPath()}
 
 	cmd := exec.Command("hg", arg...)
-	str := utils.ExecuteCommand(cmd)
+	str, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	data := strings.Split(str, "\n")
 
-	return data
+	return data, nil
 }
 
-func (repo *MercurialRepo) commits(commitCount int, commitFormat string) []string {
+func (repo *MercurialRepo) commits(commitCount int, commitFormat string) ([]string, error) {
 	numStr := fmt.Sprintf("-l %d", commitCount)
 	commitStr := fmt.Sprintf("--template=\"%s\n\"", commitFormat)
 
 	arg := []string{"log", repo.repoPath(), numStr, commitStr}
 
 	cmd := exec.Command("hg", arg...)
-	str := utils.ExecuteCommand(cmd)
+	str, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	data := strings.Split(str, "\n")
 
-	return data
+	return data, nil
 }
 
 func (repo *MercurialRepo) pull() string {
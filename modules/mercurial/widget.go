@@ -54,8 +54,10 @@ func (widget *Widget) Checkout() {
 
 	checkoutFctn := func() {
 		text := form.GetFormItem(0).(*tview.InputField).GetText()
-		repoToCheckout := widget.Data[widget.Idx]
-		repoToCheckout.checkout(text)
+		repoToCheckout := widget.currentData()
+		if repoToCheckout != nil {
+			repoToCheckout.checkout(text)
+		}
 		widget.pages.RemovePage("modal")
 		widget.app.SetFocus(widget.View)
 
@@ -69,7 +71,11 @@ func (widget *Widget) Checkout() {
 }
 
 func (widget *Widget) Pull() {
-	repoToPull := widget.Data[widget.Idx]
+	repoToPull := widget.currentData()
+	if repoToPull == nil {
+		return
+	}
+
 	repoToPull.pull()
 	widget.Refresh()
 }
@@ -77,8 +83,12 @@ func (widget *Widget) Pull() {
 func (widget *Widget) Refresh() {
 	repoPaths := utils.ToStrs(widget.settings.repositories)
 
-	widget.Data = widget.mercurialRepos(repoPaths)
+	repos, err := widget.mercurialRepos(repoPaths)
+	if err == nil {
+		widget.Data = repos
+	}
 
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -155,13 +165,17 @@ func (widget *Widget) currentData() *MercurialRepo {
 	return widget.Data[widget.Idx]
 }
 
-func (widget *Widget) mercurialRepos(repoPaths []string) []*MercurialRepo {
+func (widget *Widget) mercurialRepos(repoPaths []string) ([]*MercurialRepo, error) {
 	repos := []*MercurialRepo{}
 
 	for _, repoPath := range repoPaths {
-		repo := NewMercurialRepo(repoPath, widget.settings.commitCount, widget.settings.commitFormat)
+		repo, err := NewMercurialRepo(repoPath, widget.settings.commitCount, widget.settings.commitFormat)
+		if err != nil {
+			return nil, err
+		}
+
 		repos = append(repos, repo)
 	}
 
-	return repos
+	return repos, nil
 }
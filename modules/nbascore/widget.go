@@ -20,8 +20,9 @@ type Widget struct {
 	view.KeyboardWidget
 	view.TextWidget
 
-	language string
-	settings *Settings
+	language      string
+	displayBuffer string
+	settings      *Settings
 }
 
 // NewWidget creates a new instance of a widget
@@ -44,45 +45,50 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 }
 
 func (widget *Widget) Refresh() {
-	widget.Redraw(widget.nbascore)
+	scores, err := widget.nbascore()
+	if err == nil {
+		widget.displayBuffer = scores
+	}
+
+	widget.SetRefreshError(err)
+	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.displayBuffer, false })
 }
 
 func (widget *Widget) HelpText() string {
 	return widget.KeyboardWidget.HelpText()
 }
 
-func (widget *Widget) nbascore() (string, string, bool) {
-	title := widget.CommonSettings().Title
+func (widget *Widget) nbascore() (string, error) {
 	cur := time.Now().AddDate(0, 0, offset) // Go back/forward offset days
 	curString := cur.Format("20060102")     // Need 20060102 format to feed to api
 	client := &http.Client{}
 	req, err := http.NewRequest("GET", "http://data.nba.net/10s/prod/v1/"+curString+"/scoreboard.json", nil)
 	if err != nil {
-		return title, err.Error(), true
+		return "", err
 	}
 
 	req.Header.Set("Accept-Language", widget.language)
 	req.Header.Set("User-Agent", "curl")
 	response, err := client.Do(req)
 	if err != nil {
-		return title, err.Error(), true
+		return "", err
 	}
 	defer func() { _ = response.Body.Close() }()
 	if response.StatusCode != 200 {
-		return title, err.Error(), true
+		return "", err
 	} // Get data from data.nba.net and check if successful
 
 	contents, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		return title, err.Error(), true
+		return "", err
 	}
 	result := map[string]interface{}{}
 	err = json.Unmarshal(contents, &result)
 	if err != nil {
-		return title, err.Error(), true
+		return "", err
 	}
 
-	allGame := fmt.Sprintf(" [%s]", widget.settings.common.Colors.Subheading) + (cur.Format(utils.FriendlyDateFormat) + "\n\n") + "[white]"
+	allGame := fmt.Sprintf(" [%s]", widget.settings.common.Colors.Subheading) + (cur.Format(utils.FriendlyDateFormat) + "\n\n") + "[-]"
 
 	for _, game := range result["games"].([]interface{}) {
 		vTeam, hTeam, vScore, hScore := "", "", "", ""
@@ -120,24 +126,26 @@ func (widget *Widget) nbascore() (string, string, bool) {
 		hNum, _ := strconv.Atoi(hScore)
 		hColor := ""
 		if quarter != 0 { // Compare the score
+			leader := "[" + widget.settings.common.Colors.Accent + "]"
+
 			switch {
 			case vNum > hNum:
-				vTeam = "[orange]" + vTeam
+				vTeam = leader + vTeam
 			case hNum > vNum:
-				// hScore = "[orange]" + hScore
-				hColor = "[orange]" // For correct padding
-				hTeam += "[white]"
+				// hScore = leader + hScore
+				hColor = leader // For correct padding
+				hTeam += "[-]"
 			default:
-				vTeam = "[orange]" + vTeam
-				hColor = "[orange]"
-				hTeam += "[white]"
+				vTeam = leader + vTeam
+				hColor = leader
+				hTeam += "[-]"
 			}
 		}
-		qColor := "[white]"
+		qColor := "[-]"
 		if activate {
 			qColor = "[sandybrown]"
 		}
-		allGame += fmt.Sprintf("%s%5s%v[white] %s %3s [white]vs %s%-3s %s\n", qColor, "Q", quarter, vTeam, vScore, hColor, hScore, hTeam) // Format the score and store in allgame
+		allGame += fmt.Sprintf("%s%5s%v[-] %s %3s [-]vs %s%-3s %s\n", qColor, "Q", quarter, vTeam, vScore, hColor, hScore, hTeam) // Format the score and store in allgame
 	}
-	return title, allGame, false
+	return allGame, nil
 }
@@ -13,32 +13,22 @@ func (widget *Widget) content() (string, string, bool) {
 	if client == nil {
 		return widget.CommonSettings().Title, " NewRelic data unavailable ", false
 	}
-	app, appErr := client.Application()
-	deploys, depErr := client.Deployments()
 
-	appName := "error"
-	if appErr == nil {
-		appName = app.Name
+	app, ok := widget.applications[client.applicationId]
+	if !ok {
+		return widget.CommonSettings().Title, "", false
 	}
 
-	var content string
-	title := fmt.Sprintf("%s - [green]%s[white]", widget.CommonSettings().Title, appName)
-	wrap := false
-	if depErr != nil {
-		wrap = true
-		content = depErr.Error()
-	} else {
-		content = widget.contentFrom(deploys)
-	}
+	title := fmt.Sprintf("%s - [%s]%s[-]", widget.CommonSettings().Title, widget.settings.common.Colors.Title, app.Name)
 
-	return title, content, wrap
+	return title, widget.contentFrom(widget.deployments[client.applicationId]), false
 }
 
 func (widget *Widget) contentFrom(deploys []nr.ApplicationDeployment) string {
 	str := fmt.Sprintf(
 		" %s\n",
 		fmt.Sprintf(
-			"[%s]Latest Deploys[white]",
+			"[%s]Latest Deploys[-]",
 			widget.settings.common.Colors.Subheading,
 		),
 	)
@@ -47,9 +37,9 @@ func (widget *Widget) contentFrom(deploys []nr.ApplicationDeployment) string {
 
 	for _, deploy := range deploys {
 		if (deploy.Revision != "") && utils.DoesNotInclude(revisions, deploy.Revision) {
-			lineColor := "white"
+			lineColor := widget.settings.common.Colors.Text
 			if wtf.IsToday(deploy.Timestamp) {
-				lineColor = "lightblue"
+				lineColor = widget.settings.common.Colors.Label
 			}
 
 			revLen := 8
@@ -58,7 +48,8 @@ func (widget *Widget) contentFrom(deploys []nr.ApplicationDeployment) string {
 			}
 
 			str += fmt.Sprintf(
-				" [green]%s[%s] %s %-.16s[white]\n",
+				" [%s]%s[%s] %s %-.16s[-]\n",
+				widget.settings.common.Colors.Title,
 				deploy.Revision[0:revLen],
 				lineColor,
 				deploy.Timestamp.Format("Jan 02 15:04 MST"),
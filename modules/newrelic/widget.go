@@ -4,6 +4,7 @@ import (
 	"sort"
 
 	"github.com/rivo/tview"
+	nr "github.com/wtfutil/wtf/modules/newrelic/client"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -15,7 +16,9 @@ type Widget struct {
 
 	Clients []*Client2
 
-	settings *Settings
+	applications map[int]*nr.Application
+	deployments  map[int][]nr.ApplicationDeployment
+	settings     *Settings
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -24,7 +27,9 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.common, "applicationID", "applicationIDs"),
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
-		settings: settings,
+		applications: map[int]*nr.Application{},
+		deployments:  map[int][]nr.ApplicationDeployment{},
+		settings:     settings,
 	}
 
 	widget.initializeKeyboardControls()
@@ -48,6 +53,9 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
+	err := widget.refreshCurrent()
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -59,6 +67,30 @@ func (widget *Widget) HelpText() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// refreshCurrent fetches the application and deployments for the displayed client,
+// keeping the previous data if either request fails
+func (widget *Widget) refreshCurrent() error {
+	client := widget.currentData()
+	if client == nil {
+		return nil
+	}
+
+	app, err := client.Application()
+	if err != nil {
+		return err
+	}
+
+	deploys, err := client.Deployments()
+	if err != nil {
+		return err
+	}
+
+	widget.applications[client.applicationId] = app
+	widget.deployments[client.applicationId] = deploys
+
+	return nil
+}
+
 func (widget *Widget) currentData() *Client2 {
 	if len(widget.Clients) == 0 {
 		return nil
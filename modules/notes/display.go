@@ -36,7 +36,7 @@ func (widget *Widget) formattedItemLine(item *Item, selectedItem *Item, maxLen i
 	}
 
 	str := fmt.Sprintf(
-		"[%s:%s] - %s [white]",
+		"[%s:%s] - %s [-]",
 		foreColor,
 		backColor,
 		item.Text,
@@ -66,7 +66,9 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	widget.load()
+	err := widget.load()
+
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -150,19 +152,25 @@ func (widget *Widget) editItem() {
 
 	err := runCommand.Start()
 	if err != nil {
-		logger.Log(fmt.Sprintf("Failed to run command: %v", err))
+		logger.Errorf(widget.Name(), "Failed to run command: %v", err)
 	}
 }
 
 // Loads the todo list from Yaml file
-func (widget *Widget) load() {
+func (widget *Widget) load() error {
 	confDir, _ := cfg.WtfConfigDir()
 	filePath := fmt.Sprintf("%s/%s/", confDir, widget.filePath)
 
-	files, _ := ioutil.ReadDir(filePath)
+	files, err := ioutil.ReadDir(filePath)
+	if err != nil {
+		return err
+	}
+
 	for _, file := range files {
 		widget.list.Add(file.Name())
 	}
+
+	return nil
 }
 
 func (widget *Widget) newItem() {
@@ -204,11 +212,12 @@ func (widget *Widget) persistNewFile(filename string) {
 	confDir, _ := cfg.WtfConfigDir()
 	filePath := fmt.Sprintf("%s/%s/%s", confDir, widget.filePath, filename)
 
-	_, err := os.Create(filePath)
-
-	if err != nil {
-		panic(err)
+	file, err := os.Create(filePath)
+	if err == nil {
+		_ = file.Close()
 	}
+
+	widget.SetRefreshError(err)
 }
 
 func (widget *Widget) persistFileContent(content string) {
@@ -218,9 +227,7 @@ func (widget *Widget) persistFileContent(content string) {
 
 	err := ioutil.WriteFile(filePath, []byte(content), 0644)
 
-	if err != nil {
-		panic(err)
-	}
+	widget.SetRefreshError(err)
 }
 
 func (widget *Widget) deleteFile() {
@@ -231,9 +238,10 @@ func (widget *Widget) deleteFile() {
 	err := os.Remove(filePath)
 
 	if err != nil {
-		logger.Log(fmt.Sprintf("Error deleting file: %+v", err))
-		panic(err)
+		logger.Errorf(widget.Name(), "Error deleting file: %+v", err)
 	}
+
+	widget.SetRefreshError(err)
 }
 
 func (widget *Widget) renameFile(text string) {
@@ -245,9 +253,10 @@ func (widget *Widget) renameFile(text string) {
 	err := os.Rename(filePath, newFilePath)
 
 	if err != nil {
-		logger.Log(fmt.Sprintf("ERROR: %+v", err))
-		panic(err)
+		logger.Errorf(widget.Name(), "Error renaming file: %+v", err)
 	}
+
+	widget.SetRefreshError(err)
 }
 
 func (widget *Widget) showHelp() {
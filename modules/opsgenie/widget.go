@@ -12,7 +12,8 @@ import (
 type Widget struct {
 	view.TextWidget
 
-	settings *Settings
+	onCallResponses []*OnCallResponse
+	settings        *Settings
 }
 
 func NewWidget(app *tview.Application, settings *Settings) *Widget {
@@ -28,46 +29,44 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
+	onCallResponses, err := widget.Fetch(
+		widget.settings.scheduleIdentifierType,
+		widget.settings.schedule,
+	)
+	if err == nil {
+		widget.onCallResponses = onCallResponses
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
-	onCallResponses, err := widget.Fetch(
-		widget.settings.scheduleIdentifierType,
-		widget.settings.schedule,
-	)
 	title := widget.CommonSettings().Title
 
 	var content string
-	wrap := false
-	if err != nil {
-		wrap = true
-		content = err.Error()
-	} else {
-
-		for _, data := range onCallResponses {
-			if (len(data.OnCallData.Recipients) == 0) && !widget.settings.displayEmpty {
-				continue
-			}
-
-			var msg string
-			if len(data.OnCallData.Recipients) == 0 {
-				msg = " [gray]no one[white]\n\n"
-			} else {
-				msg = fmt.Sprintf(" %s\n\n", strings.Join(utils.NamesFromEmails(data.OnCallData.Recipients), ", "))
-			}
-
-			content += widget.cleanScheduleName(data.OnCallData.Parent.Name)
-			content += msg
+	for _, data := range widget.onCallResponses {
+		if (len(data.OnCallData.Recipients) == 0) && !widget.settings.displayEmpty {
+			continue
 		}
+
+		var msg string
+		if len(data.OnCallData.Recipients) == 0 {
+			msg = fmt.Sprintf(" [%s]no one[-]\n\n", widget.settings.common.Colors.Muted)
+		} else {
+			msg = fmt.Sprintf(" %s\n\n", strings.Join(utils.NamesFromEmails(data.OnCallData.Recipients), ", "))
+		}
+
+		content += widget.cleanScheduleName(data.OnCallData.Parent.Name)
+		content += msg
 	}
 
-	return title, content, wrap
+	return title, content, false
 }
 
 func (widget *Widget) cleanScheduleName(schedule string) string {
 	cleanedName := strings.Replace(schedule, "_", " ", -1)
-	return fmt.Sprintf(" [green]%s[white]\n", cleanedName)
+	return fmt.Sprintf(" [%s]%s[-]\n", widget.settings.common.Colors.Title, cleanedName)
 }
@@ -0,0 +1,20 @@
+package pagerduty
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next incident")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous incident")
+	widget.SetKeyboardChar("f", widget.ShowFilter, "Filter incidents")
+	widget.SetKeyboardChar("o", widget.OpenSelected, "Open the selected incident in a browser")
+	widget.SetKeyboardChar("s", widget.SortNext, "Sort by the next column")
+	widget.SetKeyboardChar("S", widget.SortReverse, "Reverse the sort order")
+	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next incident")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.OpenSelected, "Open the selected incident in a browser")
+	widget.SetKeyboardKey(tcell.KeyEsc, widget.Unselect, "Clear selection")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous incident")
+}
@@ -8,7 +8,7 @@ import (
 )
 
 const (
-	defaultFocusable = false
+	defaultFocusable = true
 	defaultTitle     = "PagerDuty"
 )
 
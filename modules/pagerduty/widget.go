@@ -3,10 +3,12 @@ package pagerduty
 import (
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/notify"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -16,62 +18,184 @@ const (
 	onCallTimeDisplayLayout = "Jan 2, 2006"
 )
 
+// columns are the columns of the incidents table
+var columns = []view.TableColumn{
+	{Name: "Incident", Type: view.ColumnText},
+	{Name: "Status", Type: view.ColumnText},
+	{Name: "Service", Type: view.ColumnText},
+	{Name: "Escalation", Type: view.ColumnText},
+}
+
 type Widget struct {
-	view.TextWidget
+	view.KeyboardWidget
+	view.TableWidget
+
+	data      cachedData
+	incidents *notify.Changes
+	mu        sync.Mutex
+	settings  *Settings
+}
 
-	settings *Settings
+// cachedData is the data fetched from PagerDuty that is saved between runs
+type cachedData struct {
+	Incidents []pagerduty.Incident
+	OnCalls   []pagerduty.OnCall
 }
 
 // NewWidget creates and returns an instance of PagerDuty widget
-func NewWidget(app *tview.Application, settings *Settings) *Widget {
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(app, settings.common),
+		KeyboardWidget: view.NewKeyboardWidget(app, pages, settings.common),
+		TableWidget:    view.NewTableWidget(app, pages, settings.common, columns),
 
-		settings: settings,
+		incidents: notify.NewChanges(),
+		settings:  settings,
 	}
 
+	widget.SetRenderFunction(widget.render)
+	widget.SetOpenFunction(widget.openIncident)
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.KeyboardWidget.SetView(widget.View)
+
 	return &widget
 }
 
 /* -------------------- Exported Functions -------------------- */
 
 func (widget *Widget) Refresh() {
-	var onCalls []pagerduty.OnCall
-	var incidents []pagerduty.Incident
+	widget.loadCache()
+
+	data, err := widget.fetch()
+	if err == nil {
+		widget.setData(data)
 
-	var err1 error
-	var err2 error
+		if widget.settings.showIncidents {
+			widget.notifyNewIncidents(data.Incidents)
+		}
+
+		_ = widget.SaveCache(data)
+	}
+
+	widget.SetRefreshError(err)
+	widget.render()
+}
+
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
+}
+
+// Next selects the next incident
+func (widget *Widget) Next() {
+	widget.ScrollableWidget.Next()
+}
+
+// Prev selects the previous incident
+func (widget *Widget) Prev() {
+	widget.ScrollableWidget.Prev()
+}
+
+// Unselect clears the selection of incidents
+func (widget *Widget) Unselect() {
+	widget.ScrollableWidget.Unselect()
+	widget.RenderFunction()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fetch gets the incidents and on-call schedules the widget is configured to show
+func (widget *Widget) fetch() (cachedData, error) {
+	data := cachedData{}
 
 	if widget.settings.showIncidents {
 		teamIDs := utils.ToStrs(widget.settings.teamIDs)
 		userIDs := utils.ToStrs(widget.settings.userIDs)
-		incidents, err2 = GetIncidents(widget.settings.apiKey, teamIDs, userIDs)
+
+		incidents, err := GetIncidents(widget.settings.apiKey, teamIDs, userIDs)
+		if err != nil {
+			return data, err
+		}
+		data.Incidents = incidents
 	}
 
 	if widget.settings.showSchedules {
 		scheduleIDs := utils.ToStrs(widget.settings.scheduleIDs)
-		onCalls, err1 = GetOnCalls(widget.settings.apiKey, scheduleIDs)
-	}
 
-	var content string
-	wrap := false
-	if err1 != nil || err2 != nil {
-		wrap = true
-		if err1 != nil {
-			content += err1.Error()
-		}
-		if err2 != nil {
-			content += err2.Error()
+		onCalls, err := GetOnCalls(widget.settings.apiKey, scheduleIDs)
+		if err != nil {
+			return data, err
 		}
-	} else {
-		widget.View.SetWrap(false)
-		content = widget.contentFrom(onCalls, incidents)
+		data.OnCalls = onCalls
 	}
 
-	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, content, wrap })
+	return data, nil
 }
 
-/* -------------------- Unexported Functions -------------------- */
+// loadCache displays the data saved by the last run, if there is any, while the first
+// refresh fetches new data
+func (widget *Widget) loadCache() {
+	data := cachedData{}
+	if !widget.LoadCacheOnce(&data) {
+		return
+	}
+
+	widget.setData(data)
+	widget.render()
+}
+
+// openIncident opens the selected incident in the browser
+func (widget *Widget) openIncident() {
+	row := widget.SelectedRow()
+	if row == nil {
+		return
+	}
+
+	utils.OpenFile(row.Item.(pagerduty.Incident).HTMLURL)
+}
+
+func (widget *Widget) render() {
+	widget.ScrollableWidget.Redraw(func() (string, string, bool) {
+		widget.mu.Lock()
+		defer widget.mu.Unlock()
+
+		content := widget.contentFrom(widget.data.OnCalls, widget.data.Incidents)
+
+		return widget.TableTitle(widget.CommonSettings().Title), content, false
+	})
+}
+
+// setData replaces the displayed incidents and on-call schedules
+func (widget *Widget) setData(data cachedData) {
+	widget.mu.Lock()
+	defer widget.mu.Unlock()
+
+	widget.data = data
+	widget.SetRows(widget.incidentRows(data.Incidents))
+}
+
+// notifyNewIncidents raises a notification for each incident that was not open at the last refresh
+func (widget *Widget) notifyNewIncidents(incidents []pagerduty.Incident) {
+	ids := []string{}
+	byID := map[string]pagerduty.Incident{}
+
+	for _, incident := range incidents {
+		ids = append(ids, incident.ID)
+		byID[incident.ID] = incident
+	}
+
+	for _, id := range widget.incidents.Added(ids) {
+		incident := byID[id]
+
+		widget.Notify(notify.Event{
+			Kind:    "incident",
+			Title:   "New PagerDuty incident",
+			Message: incident.Summary,
+			URL:     incident.HTMLURL,
+		})
+	}
+}
 
 func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerduty.Incident) string {
 	var str string
@@ -79,16 +203,10 @@ func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerd
 	// Incidents
 
 	if widget.settings.showIncidents {
-		str += fmt.Sprintf("[%s] Incidents[white]\n", widget.settings.common.Colors.Subheading)
+		str += fmt.Sprintf("[%s] Incidents[-]\n", widget.settings.common.Colors.Subheading)
 
 		if len(incidents) > 0 {
-			for _, incident := range incidents {
-				str += fmt.Sprintf("\n [%s]%s[white]\n", widget.settings.common.Colors.Label, tview.Escape(incident.Summary))
-				str += fmt.Sprintf("     Status: %s\n", incident.Status)
-				str += fmt.Sprintf("    Service: %s\n", incident.Service.Summary)
-				str += fmt.Sprintf(" Escalation: %s\n", incident.EscalationPolicy.Summary)
-				str += fmt.Sprintf("       Link: %s\n", incident.HTMLURL)
-			}
+			str += widget.TableContent()
 		} else {
 			str += "\n No open incidents\n"
 		}
@@ -121,7 +239,7 @@ func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerd
 	sort.Strings(keys)
 
 	if len(keys) > 0 {
-		str += fmt.Sprintf("[%s] Schedules[white]\n", widget.settings.common.Colors.Subheading)
+		str += fmt.Sprintf("[%s] Schedules[-]\n", widget.settings.common.Colors.Subheading)
 
 		// Print out policies, and escalation order of users
 		for _, key := range keys {
@@ -156,6 +274,25 @@ func (widget *Widget) contentFrom(onCalls []pagerduty.OnCall, incidents []pagerd
 	return str
 }
 
+// incidentRows returns a row of the incidents table for each incident
+func (widget *Widget) incidentRows(incidents []pagerduty.Incident) []view.TableRow {
+	rows := []view.TableRow{}
+
+	for _, incident := range incidents {
+		rows = append(rows, view.TableRow{
+			Cells: []view.TableCell{
+				{Color: widget.settings.common.Colors.Label, Text: incident.Summary},
+				{Text: incident.Status},
+				{Text: incident.Service.Summary},
+				{Text: incident.EscalationPolicy.Summary},
+			},
+			Item: incident,
+		})
+	}
+
+	return rows
+}
+
 // onCallEndSummary may or may not return the date that the specified onCall schedule ends
 func (widget *Widget) onCallEndSummary(onCall pagerduty.OnCall) string {
 	if !widget.settings.showOnCallEnd {
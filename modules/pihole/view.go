@@ -12,27 +12,29 @@ import (
 	"github.com/olekukonko/tablewriter"
 )
 
-func getSummaryView(c http.Client, settings *Settings) string {
+func getSummaryView(c http.Client, settings *Settings) (string, error) {
 	var err error
 
 	var s Status
 
 	s, err = getStatus(c, settings.apiUrl)
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
 
 	var sb strings.Builder
 
 	buf := new(bytes.Buffer)
 
+	colors := settings.common.Colors
+
 	switch strings.ToLower(s.Status) {
 	case "disabled":
-		sb.WriteString(" [white]Status [red]DISABLED\n")
+		sb.WriteString(fmt.Sprintf(" [-]Status [%s]DISABLED\n", colors.Error))
 	case "enabled":
-		sb.WriteString(" [white]Status [green]ENABLED\n")
+		sb.WriteString(fmt.Sprintf(" [-]Status [%s]ENABLED\n", colors.Success))
 	default:
-		sb.WriteString(" [white]Status [yellow]UNKNOWN\n")
+		sb.WriteString(fmt.Sprintf(" [-]Status [%s]UNKNOWN\n", colors.Warning))
 	}
 
 	summaryTable := createTable([]string{}, buf)
@@ -44,17 +46,17 @@ func getSummaryView(c http.Client, settings *Settings) string {
 
 	sb.WriteString(buf.String())
 
-	return sb.String()
+	return sb.String(), nil
 }
 
-func getTopItemsView(c http.Client, settings *Settings) string {
+func getTopItemsView(c http.Client, settings *Settings) (string, error) {
 	var err error
 
 	var ti TopItems
 
 	ti, err = getTopItems(c, settings)
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
 
 	buf := new(bytes.Buffer)
@@ -90,20 +92,20 @@ func getTopItemsView(c http.Client, settings *Settings) string {
 	tiTable.Render()
 	sb.WriteString(buf.String())
 
-	return sb.String()
+	return sb.String(), nil
 }
 
-func getTopClientsView(c http.Client, settings *Settings) string {
+func getTopClientsView(c http.Client, settings *Settings) (string, error) {
 	tc, err := getTopClients(c, settings)
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
 
 	var tq QueryTypes
 
 	tq, err = getQueryTypes(c, settings)
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
 
 	buf := new(bytes.Buffer)
@@ -146,7 +148,7 @@ func getTopClientsView(c http.Client, settings *Settings) string {
 
 	sb.WriteString(buf.String())
 
-	return sb.String()
+	return sb.String(), nil
 }
 
 func shorten(s string, limit int) string {
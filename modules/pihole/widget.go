@@ -1,6 +1,7 @@
 package pihole
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/rivo/tview"
@@ -12,7 +13,8 @@ type Widget struct {
 	view.MultiSourceWidget
 	view.TextWidget
 
-	settings *Settings
+	displayBuffer string
+	settings      *Settings
 }
 
 // NewWidget creates a new instance of a widget
@@ -43,7 +45,15 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
-	widget.Redraw(widget.content)
+	content, err := widget.fetchContent()
+	if err == nil {
+		widget.displayBuffer = content
+	}
+
+	widget.SetRefreshError(err)
+	widget.Redraw(func() (string, string, bool) {
+		return widget.CommonSettings().Title, widget.displayBuffer, widget.settings.wrapText
+	})
 }
 
 func (widget *Widget) HelpText() string {
@@ -52,32 +62,39 @@ func (widget *Widget) HelpText() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) content() (string, string, bool) {
-	title := widget.CommonSettings().Title
-
+func (widget *Widget) fetchContent() (string, error) {
 	c := getClient()
 
 	if err := checkServer(c, widget.settings.apiUrl); err != nil {
-		return title, err.Error(), widget.settings.wrapText
+		return "", err
 	}
 
 	var sb strings.Builder
 
+	views := []func(http.Client, *Settings) (string, error){}
+
 	if widget.settings.showSummary {
-		sb.WriteString(getSummaryView(c, widget.settings))
+		views = append(views, getSummaryView)
 	}
 
 	if widget.settings.showTopItems > 0 {
-		sb.WriteString(getTopItemsView(c, widget.settings))
+		views = append(views, getTopItemsView)
 	}
 
 	if widget.settings.showTopClients > 0 {
-		sb.WriteString(getTopClientsView(c, widget.settings))
+		views = append(views, getTopClientsView)
 	}
 
-	output := sb.String()
+	for _, getView := range views {
+		str, err := getView(c, widget.settings)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(str)
+	}
 
-	return title, output, widget.settings.wrapText
+	return sb.String(), nil
 }
 
 func (widget *Widget) disable() {
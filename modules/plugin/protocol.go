@@ -0,0 +1,237 @@
+// Package plugin runs a module as an external executable that WTF talks to over its standard
+// input and output.
+//
+// WTF starts the executable when the module is first refreshed and sends it requests, one JSON object
+// per line on its stdin. The plugin must reply to each request, in order, with one JSON object
+// per line on its stdout. Anything the plugin writes to stderr is ignored.
+//
+// Requests:
+//
+//	{"type": "init", "settings": {...}, "width": 40, "height": 10}
+//	{"type": "refresh"}
+//	{"type": "key", "key": "o", "selected": 2}
+//
+// "init" is always the first request, and is sent again if the plugin exits and is restarted.
+// "settings" holds the module's entire config. "refresh" is sent on every refresh interval and
+// when the user refreshes the widget. "key" is sent when the user presses one of the keys the
+// plugin binds. "selected" is the index of the selected item, or -1 if there is none.
+//
+// Replies:
+//
+//	{
+//	  "title": "My Service",
+//	  "content": "[green]all systems go",
+//	  "items": ["first item", "second item"],
+//	  "keys": [{"key": "o", "description": "Open item in browser"}],
+//	  "notifications": [{"kind": "alert", "title": "Disk full", "message": "/ is 98% full", "url": ""}],
+//	  "error": ""
+//	}
+//
+// Every field is optional. "title" replaces the widget's title. "content" is displayed above
+// the list of "items", which the user can select and scroll through. Both may contain tview
+// color tags. "keys" is only read from the reply to "init", and binds each key sequence, e.g.
+// "o", "ctrl-d" or "g g", to a "key" request. Binding "enter" also opens an item when it is
+// double-clicked. "notifications" are raised as the module's notifications, and are sent
+// if the notification settings allow it. "error" reports that the request failed, and is displayed in place of the
+// content.
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/wtfutil/wtf/notify"
+)
+
+const (
+	initRequest    = "init"
+	keyRequest     = "key"
+	refreshRequest = "refresh"
+)
+
+// request is a message sent from WTF to the plugin
+type request struct {
+	Type     string      `json:"type"`
+	Settings interface{} `json:"settings,omitempty"`
+	Width    int         `json:"width,omitempty"`
+	Height   int         `json:"height,omitempty"`
+	Key      string      `json:"key,omitempty"`
+	Selected *int        `json:"selected,omitempty"`
+}
+
+// response is a message sent from the plugin to WTF in reply to a request
+type response struct {
+	Title         string         `json:"title"`
+	Content       string         `json:"content"`
+	Items         []string       `json:"items"`
+	Keys          []keyBinding   `json:"keys"`
+	Notifications []notify.Event `json:"notifications"`
+	Error         string         `json:"error"`
+}
+
+// keyBinding is a key sequence the plugin wants to be told about when it is pressed
+type keyBinding struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+// reply is a response read from the plugin, or the error that prevented reading one
+type reply struct {
+	response *response
+	err      error
+}
+
+// client runs the plugin executable and exchanges requests and responses with it. If the
+// plugin fails it is stopped, and restarted by the next request
+type client struct {
+	args    []string
+	cmd     string
+	init    request
+	timeout time.Duration
+
+	mutex   sync.Mutex
+	process *exec.Cmd
+	stdin   io.WriteCloser
+	replies chan reply
+	done    chan struct{}
+}
+
+func newClient(cmd string, args []string, init request, timeout time.Duration) *client {
+	return &client{
+		args:    args,
+		cmd:     cmd,
+		init:    init,
+		timeout: timeout,
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// call sends the request to the plugin and returns its response, starting the plugin first
+// if it is not running
+func (client *client) call(req request) (*response, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	if client.process == nil {
+		if err := client.start(); err != nil {
+			return nil, err
+		}
+
+		if req.Type != initRequest {
+			if _, err := client.roundTrip(client.init); err != nil {
+				client.stop()
+				return nil, err
+			}
+		}
+	}
+
+	resp, err := client.roundTrip(req)
+	if err != nil {
+		client.stop()
+	}
+
+	return resp, err
+}
+
+// close stops the plugin
+func (client *client) close() {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.stop()
+}
+
+func (client *client) roundTrip(req request) (*response, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := client.stdin.Write(append(data, '\n')); err != nil {
+		return nil, fmt.Errorf("could not send %s request to plugin: %w", req.Type, err)
+	}
+
+	select {
+	case reply := <-client.replies:
+		if reply.err != nil {
+			return nil, fmt.Errorf("could not read reply to %s request from plugin: %w", req.Type, reply.err)
+		}
+		return reply.response, nil
+	case <-time.After(client.timeout):
+		return nil, fmt.Errorf("plugin did not reply to %s request within %s", req.Type, client.timeout)
+	}
+}
+
+func (client *client) start() error {
+	if client.cmd == "" {
+		return errors.New("no plugin cmd is configured")
+	}
+
+	process := exec.Command(client.cmd, client.args...)
+
+	stdin, err := process.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	stdout, err := process.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := process.Start(); err != nil {
+		return err
+	}
+
+	client.process = process
+	client.stdin = stdin
+	client.replies = make(chan reply, 1)
+	client.done = make(chan struct{})
+
+	go readReplies(stdout, client.replies, client.done)
+
+	return nil
+}
+
+func (client *client) stop() {
+	if client.process == nil {
+		return
+	}
+
+	close(client.done)
+	_ = client.stdin.Close()
+	_ = client.process.Process.Kill()
+	_ = client.process.Wait()
+
+	client.process = nil
+}
+
+// readReplies decodes each response the plugin writes and passes it on, until the plugin's
+// output ends or the client stops it
+func readReplies(stdout io.Reader, replies chan<- reply, done <-chan struct{}) {
+	decoder := json.NewDecoder(stdout)
+
+	for {
+		resp := &response{}
+		err := decoder.Decode(resp)
+		if err != nil {
+			resp = nil
+		}
+
+		select {
+		case replies <- reply{response: resp, err: err}:
+		case <-done:
+			return
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
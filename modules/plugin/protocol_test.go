@@ -0,0 +1,140 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHelperProcess is not a real test. It is run as the plugin executable by the other tests
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("WTF_PLUGIN_HELPER") != "1" {
+		return
+	}
+
+	decoder := json.NewDecoder(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+
+	refreshes := 0
+	settings := map[string]interface{}{}
+
+	for {
+		req := struct {
+			request
+			Settings map[string]interface{} `json:"settings"`
+		}{}
+		if err := decoder.Decode(&req); err != nil {
+			os.Exit(0)
+		}
+
+		resp := response{Title: "Helper"}
+
+		switch req.Type {
+		case initRequest:
+			settings = req.Settings
+			resp.Keys = []keyBinding{{Key: "o", Description: "Open item"}, {Key: "enter"}}
+		case refreshRequest:
+			refreshes++
+			resp.Content = fmt.Sprintf("refreshed %d", refreshes)
+			resp.Items = []string{"first", "second"}
+		case keyRequest:
+			resp.Content = fmt.Sprintf("%s on %d", req.Key, *req.Selected)
+		}
+
+		switch settings["behaviour"] {
+		case "exit":
+			if req.Type != initRequest {
+				os.Exit(1)
+			}
+		case "hang":
+			if req.Type != initRequest {
+				time.Sleep(time.Minute)
+			}
+		case "fail":
+			resp.Error = "something went wrong"
+		}
+
+		_ = encoder.Encode(resp)
+	}
+}
+
+func helperClient(behaviour string) *client {
+	init := request{
+		Type:     initRequest,
+		Settings: map[string]interface{}{"behaviour": behaviour},
+	}
+
+	return newClient(os.Args[0], []string{"-test.run=TestHelperProcess"}, init, time.Second)
+}
+
+func Test_ClientCall(t *testing.T) {
+	os.Setenv("WTF_PLUGIN_HELPER", "1")
+	defer os.Unsetenv("WTF_PLUGIN_HELPER")
+
+	client := helperClient("")
+	defer client.close()
+
+	resp, err := client.call(client.init)
+	assert.NoError(t, err)
+	assert.Equal(t, "Helper", resp.Title)
+	assert.Equal(t, []keyBinding{{Key: "o", Description: "Open item"}, {Key: "enter"}}, resp.Keys)
+
+	resp, err = client.call(request{Type: refreshRequest})
+	assert.NoError(t, err)
+	assert.Equal(t, "refreshed 1", resp.Content)
+	assert.Equal(t, []string{"first", "second"}, resp.Items)
+
+	selected := 1
+	resp, err = client.call(request{Type: keyRequest, Key: "o", Selected: &selected})
+	assert.NoError(t, err)
+	assert.Equal(t, "o on 1", resp.Content)
+
+	// A stopped plugin is restarted, and initialized again, by the next request
+	client.close()
+
+	resp, err = client.call(request{Type: refreshRequest})
+	assert.NoError(t, err)
+	assert.Equal(t, "refreshed 1", resp.Content)
+}
+
+func Test_ClientCallErrors(t *testing.T) {
+	os.Setenv("WTF_PLUGIN_HELPER", "1")
+	defer os.Unsetenv("WTF_PLUGIN_HELPER")
+
+	tests := []struct {
+		name      string
+		client    *client
+		expectErr string
+	}{
+		{
+			name:      "no cmd",
+			client:    newClient("", nil, request{Type: initRequest}, time.Second),
+			expectErr: "no plugin cmd is configured",
+		},
+		{
+			name:      "plugin exits",
+			client:    helperClient("exit"),
+			expectErr: "could not read reply to refresh request from plugin: EOF",
+		},
+		{
+			name:      "plugin hangs",
+			client:    helperClient("hang"),
+			expectErr: "plugin did not reply to refresh request within 1s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.client.close()
+
+			resp, err := tt.client.call(request{Type: refreshRequest})
+			assert.Nil(t, resp)
+			assert.EqualError(t, err, tt.expectErr)
+			assert.Nil(t, tt.client.process)
+		})
+	}
+}
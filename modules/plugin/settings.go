@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "Plugin"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	common *cfg.Common
+
+	args    []string      `help:"The arguments to the plugin executable, with each item as an element in an array." optional:"true"`
+	cmd     string        `help:"The plugin executable. It must speak the JSON-over-stdio plugin protocol."`
+	timeout time.Duration `help:"The number of seconds to wait for the plugin to reply to a request." values:"A positive integer, 0..n." optional:"true"`
+
+	// The module's entire config, which is sent to the plugin
+	config interface{}
+
+	// The dimensions of the module
+	width  int
+	height int
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, moduleConfig, globalConfig),
+
+		args:    utils.ToStrs(moduleConfig.UList("args")),
+		cmd:     moduleConfig.UString("cmd"),
+		timeout: time.Duration(moduleConfig.UInt("timeout", 5)) * time.Second,
+
+		config: moduleConfig.Root,
+	}
+
+	width, height, err := utils.CalculateDimensions(moduleConfig, globalConfig)
+	if err == nil {
+		settings.width = width
+		settings.height = height
+	}
+
+	return &settings
+}
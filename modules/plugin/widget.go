@@ -0,0 +1,201 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+)
+
+// Widget is a module whose data comes from an external plugin executable
+type Widget struct {
+	view.KeyboardWidget
+	view.ScrollableWidget
+
+	app      *tview.Application
+	client   *client
+	settings *Settings
+
+	// initMutex is held while the plugin is being initialized, so that it is only done once
+	initMutex   sync.Mutex
+	initialized bool
+
+	mutex   sync.Mutex
+	title   string
+	content string
+	items   []string
+}
+
+// NewWidget creates a new instance of a widget. The plugin is started by the widget's first
+// refresh, and describes the keys the widget responds to in its reply
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
+	widget := &Widget{
+		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
+		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
+
+		app:      app,
+		settings: settings,
+	}
+
+	widget.client = newClient(
+		settings.cmd,
+		settings.args,
+		request{
+			Type:     initRequest,
+			Settings: settings.config,
+			Width:    settings.width,
+			Height:   settings.height,
+		},
+		settings.timeout,
+	)
+
+	widget.SetRenderFunction(widget.Render)
+	widget.initializeKeyboardControls()
+
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.KeyboardWidget.SetView(widget.View)
+
+	return widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh asks the plugin for its latest data and displays it, initializing the plugin first
+// if it has not yet replied to the init request
+func (widget *Widget) Refresh() {
+	if widget.initialize() {
+		widget.update(widget.client.call(request{Type: refreshRequest}))
+	}
+
+	widget.Render()
+}
+
+// Render displays the plugin's data
+func (widget *Widget) Render() {
+	widget.Redraw(widget.display)
+}
+
+// Stop stops the widget and its plugin
+func (widget *Widget) Stop() {
+	widget.ScrollableWidget.Stop()
+	widget.client.close()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// bindPluginKeys binds each key the plugin asked for to a request telling the plugin the
+// key was pressed
+func (widget *Widget) bindPluginKeys(bindings []keyBinding) {
+	for _, binding := range bindings {
+		key := binding.Key
+
+		fn := func() {
+			selected := widget.GetSelected()
+			go widget.sendKey(key, selected)
+		}
+
+		description := binding.Description
+		if description == "" {
+			description = fmt.Sprintf("Send %s to plugin", key)
+		}
+
+		widget.SetKeyboardSequence(key, fn, description)
+
+		if strings.ToLower(key) == "enter" {
+			widget.SetOpenFunction(fn)
+		}
+	}
+}
+
+// initialize sends the init request to the plugin until it replies, and binds the keys the
+// plugin asks for in its reply. The keys are bound on the app's event loop, which reads them.
+// Returns FALSE if the plugin did not reply or reported an error
+func (widget *Widget) initialize() bool {
+	widget.initMutex.Lock()
+	defer widget.initMutex.Unlock()
+
+	if widget.initialized {
+		return true
+	}
+
+	resp, err := widget.client.call(widget.client.init)
+	widget.update(resp, err)
+
+	if err != nil {
+		return false
+	}
+
+	widget.initialized = true
+
+	widget.app.QueueUpdate(func() {
+		widget.bindPluginKeys(resp.Keys)
+	})
+
+	return resp.Error == ""
+}
+
+func (widget *Widget) display() (string, string, bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	title := widget.CommonSettings().Title
+	if widget.title != "" {
+		title = widget.title
+	}
+
+	str := widget.content
+	if len(widget.items) == 0 {
+		return title, str, true
+	}
+
+	if str != "" {
+		str += "\n"
+	}
+
+	for idx, item := range widget.items {
+		row := fmt.Sprintf("[%s]%s", widget.RowColor(idx), item)
+		str += utils.HighlightableHelper(widget.View, row, idx, len(tview.Escape(item)))
+	}
+
+	return title, str, false
+}
+
+// sendKey tells the plugin that the key was pressed and displays its reply
+func (widget *Widget) sendKey(key string, selected int) {
+	widget.update(widget.client.call(request{Type: keyRequest, Key: key, Selected: &selected}))
+	widget.Render()
+}
+
+// update stores the plugin's reply to a request for display
+func (widget *Widget) update(resp *response, err error) {
+	if err == nil && resp.Error != "" {
+		err = errors.New(resp.Error)
+	}
+
+	widget.SetRefreshError(err)
+
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	if err != nil {
+		return
+	}
+
+	widget.title = resp.Title
+	widget.content = resp.Content
+	widget.items = resp.Items
+
+	for _, event := range resp.Notifications {
+		widget.Notify(event)
+	}
+
+	widget.SetItemCount(len(widget.items))
+	if widget.Selected >= len(widget.items) {
+		widget.Selected = len(widget.items) - 1
+	}
+}
@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+// testWidget creates a plugin widget in an app whose event loop is running, so that the
+// plugin's keys are bound
+func testWidget(behaviour string) *Widget {
+	moduleConfig, _ := config.ParseYaml(`
+type: plugin
+args: ["-test.run=TestHelperProcess"]
+behaviour: ` + behaviour)
+	moduleConfig.Set("cmd", os.Args[0])
+
+	globalConfig, _ := config.ParseYaml("wtf:\n  colors:\n")
+
+	settings := NewSettingsFromYAML("plugin", moduleConfig, globalConfig)
+	settings.timeout = time.Second
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	app := tview.NewApplication().SetScreen(screen).SetRoot(tview.NewBox(), true)
+
+	go func() { _ = app.Run() }()
+
+	return NewWidget(app, tview.NewPages(), settings)
+}
+
+// helpText returns the widget's help text once the updates queued on the app's event loop
+// have run
+func helpText(widget *Widget) string {
+	text := make(chan string)
+	widget.app.QueueUpdate(func() { text <- widget.HelpText() })
+	return <-text
+}
+
+func Test_NewWidget(t *testing.T) {
+	os.Setenv("WTF_PLUGIN_HELPER", "1")
+	defer os.Unsetenv("WTF_PLUGIN_HELPER")
+
+	widget := testWidget("")
+	defer widget.app.Stop()
+	defer widget.Stop()
+
+	// The plugin is not started until the widget is refreshed
+	assert.Nil(t, widget.client.process)
+	assert.NotContains(t, helpText(widget), "Open item")
+
+	widget.Refresh()
+
+	assert.Nil(t, widget.RefreshError())
+	assert.Contains(t, helpText(widget), "Open item")
+	assert.Contains(t, helpText(widget), "Send enter to plugin")
+	assert.Empty(t, widget.KeyValidations())
+
+	title, content, wrap := widget.display()
+	assert.Equal(t, "Helper", title)
+	assert.Contains(t, content, "refreshed 1")
+	assert.Contains(t, content, `["1"][""][lightblue]second`)
+	assert.False(t, wrap)
+
+	widget.Selected = 1
+	widget.sendKey("o", widget.GetSelected())
+
+	_, content, wrap = widget.display()
+	assert.Equal(t, "o on 1", content)
+	assert.Equal(t, -1, widget.Selected)
+	assert.True(t, wrap)
+}
+
+func Test_WidgetErrors(t *testing.T) {
+	os.Setenv("WTF_PLUGIN_HELPER", "1")
+	defer os.Unsetenv("WTF_PLUGIN_HELPER")
+
+	widget := testWidget("fail")
+	defer widget.app.Stop()
+	defer widget.Stop()
+
+	widget.Refresh()
+
+	assert.EqualError(t, widget.RefreshError(), "something went wrong")
+
+	_, content, _ := widget.display()
+	assert.Empty(t, content)
+}
@@ -54,7 +54,10 @@ func (widget *Widget) Refresh() {
 	if widget.client.accessToken == nil {
 		metaData, err := readMetaDataFromDisk()
 		if err != nil || metaData.AccessToken == nil {
-			widget.Redraw(widget.authorizeWorkFlow)
+			content, err := widget.authorizeWorkFlow()
+
+			widget.SetRefreshError(err)
+			widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, content, true })
 			return
 		}
 		widget.client.accessToken = metaData.AccessToken
@@ -65,12 +68,12 @@ func (widget *Widget) Refresh() {
 		state = Read
 	}
 	response, err := widget.client.GetLinks(state)
-	if err != nil {
-		widget.SetItemCount(0)
+	if err == nil {
+		widget.items = orderItemResponseByKey(response)
+		widget.SetItemCount(len(widget.items))
 	}
 
-	widget.items = orderItemResponseByKey(response)
-	widget.SetItemCount(len(widget.items))
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -127,31 +130,28 @@ func readMetaDataFromDisk() (pocketMetaData, error) {
 		4- Receive the callback from Pocket, this wont be used
 		5- Convert a request token into a Pocket access token
 */
-func (widget *Widget) authorizeWorkFlow() (string, string, bool) {
-	title := widget.CommonSettings().Title
-
+func (widget *Widget) authorizeWorkFlow() (string, error) {
 	if widget.settings.requestKey == nil {
 		requestToken, err := widget.client.ObtainRequestToken()
 
 		if err != nil {
-			logger.Log(err.Error())
-			return title, err.Error(), true
+			logger.Errorf(widget.Name(), "%s", err.Error())
+			return "", err
 		}
 		widget.settings.requestKey = &requestToken
 		redirectURL := widget.client.CreateAuthLink(requestToken)
 		content := fmt.Sprintf("Please click on %s to Authorize the app", redirectURL)
-		return title, content, true
+		return content, nil
 	}
 
 	if widget.settings.accessToken == nil {
 		accessToken, err := widget.client.GetAccessToken(*widget.settings.requestKey)
 		if err != nil {
-			logger.Log(err.Error())
+			logger.Errorf(widget.Name(), "%s", err.Error())
 			redirectURL := widget.client.CreateAuthLink(*widget.settings.requestKey)
 			content := fmt.Sprintf("Please click on %s to Authorize the app", redirectURL)
-			return title, content, true
+			return content, nil
 		}
-		content := "Authorized"
 		widget.settings.accessToken = &accessToken
 
 		metaData := pocketMetaData{
@@ -160,14 +160,14 @@ func (widget *Widget) authorizeWorkFlow() (string, string, bool) {
 
 		err = writeMetaDataToDisk(metaData)
 		if err != nil {
-			content = err.Error()
+			return "", err
 		}
 
-		return title, content, true
+		return "Authorized", nil
 	}
 
 	content := "Authorized"
-	return title, content, true
+	return content, nil
 
 }
 
@@ -195,7 +195,7 @@ func (widget *Widget) toggleLink() {
 		item := &widget.items[sel]
 		_, err := widget.client.ModifyLink(action, item.ItemID)
 		if err != nil {
-			logger.Log(err.Error())
+			logger.Errorf(widget.Name(), "%s", err.Error())
 		}
 	}
 
@@ -211,7 +211,7 @@ func (widget *Widget) formatItem(item Item, isSelected bool) string {
 
 	}
 
-	return fmt.Sprintf("[%s:%s]%s[white]", foreColor, backColor, tview.Escape(text))
+	return fmt.Sprintf("[%s:%s]%s[-]", foreColor, backColor, tview.Escape(text))
 }
 
 func (widget *Widget) content() (string, string, bool) {
@@ -10,12 +10,14 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
 const TimeRegExp = "^(?:\\d|[01]\\d|2[0-3]):[0-5]\\d"
 
 type Battery struct {
+	colors cfg.ColorTheme
 	args   []string
 	cmd    string
 	result string
@@ -24,10 +26,11 @@ type Battery struct {
 	Remaining string
 }
 
-func NewBattery() *Battery {
+func NewBattery(colors cfg.ColorTheme) *Battery {
 	battery := Battery{
-		args: []string{"-g", "batt"},
-		cmd:  "pmset",
+		colors: colors,
+		args:   []string{"-g", "batt"},
+		cmd:    "pmset",
 	}
 
 	return &battery
@@ -35,9 +38,15 @@ func NewBattery() *Battery {
 
 /* -------------------- Exported Functions -------------------- */
 
-func (battery *Battery) Refresh() {
-	data := battery.execute()
+func (battery *Battery) Refresh() error {
+	data, err := battery.execute()
+	if err != nil {
+		return err
+	}
+
 	battery.result = battery.parse(data)
+
+	return nil
 }
 
 func (battery *Battery) String() string {
@@ -46,9 +55,9 @@ func (battery *Battery) String() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (battery *Battery) execute() string {
+func (battery *Battery) execute() (string, error) {
 	cmd := exec.Command(battery.cmd, battery.args...)
-	return utils.ExecuteCommand(cmd)
+	return utils.RunCommand(cmd)
 }
 
 func (battery *Battery) parse(data string) string {
@@ -82,14 +91,14 @@ func (battery *Battery) formatCharge(data string) string {
 
 	switch {
 	case percent >= 70:
-		color = "[green]"
+		color = "[" + battery.colors.Success + "]"
 	case percent >= 35:
-		color = "[yellow]"
+		color = "[" + battery.colors.Warning + "]"
 	default:
-		color = "[red]"
+		color = "[" + battery.colors.Error + "]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
 
 func (battery *Battery) formatRemaining(data string) string {
@@ -108,12 +117,12 @@ func (battery *Battery) formatState(data string) string {
 
 	switch data {
 	case "charging":
-		color = "[green]"
+		color = "[" + battery.colors.Success + "]"
 	case "discharging":
-		color = "[yellow]"
+		color = "[" + battery.colors.Warning + "]"
 	default:
-		color = "[white]"
+		color = "[-]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
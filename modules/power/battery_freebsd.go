@@ -8,12 +8,14 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
 var batteryState string
 
 type Battery struct {
+	colors cfg.ColorTheme
 	args   []string
 	cmd    string
 	result string
@@ -22,15 +24,21 @@ type Battery struct {
 	Remaining string
 }
 
-func NewBattery() *Battery {
-	return &Battery{}
+func NewBattery(colors cfg.ColorTheme) *Battery {
+	return &Battery{colors: colors}
 }
 
 /* -------------------- Exported Functions -------------------- */
 
-func (battery *Battery) Refresh() {
-	data := battery.execute()
+func (battery *Battery) Refresh() error {
+	data, err := battery.execute()
+	if err != nil {
+		return err
+	}
+
 	battery.result = battery.parse(data)
+
+	return nil
 }
 
 func (battery *Battery) String() string {
@@ -43,9 +51,9 @@ func (battery *Battery) String() string {
 //   1/0   = AC/battery
 //   c     = battery charge percentage
 //   -1/s  = charging / seconds to empty
-func (battery *Battery) execute() string {
+func (battery *Battery) execute() (string, error) {
 	cmd := exec.Command("apm", "-alt")
-	return utils.ExecuteCommand(cmd)
+	return utils.RunCommand(cmd)
 }
 
 func (battery *Battery) parse(data string) string {
@@ -80,14 +88,14 @@ func (battery *Battery) formatCharge(data string) string {
 
 	switch {
 	case percent >= 70:
-		color = "[green]"
+		color = "[" + battery.colors.Success + "]"
 	case percent >= 35:
-		color = "[yellow]"
+		color = "[" + battery.colors.Warning + "]"
 	default:
-		color = "[red]"
+		color = "[" + battery.colors.Error + "]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
 
 func (battery *Battery) formatState(data string) string {
@@ -95,12 +103,12 @@ func (battery *Battery) formatState(data string) string {
 
 	switch data {
 	case "1":
-		color = "[green]charging"
+		color = "[" + battery.colors.Success + "]charging"
 	case "0":
-		color = "[yellow]discharging"
+		color = "[" + battery.colors.Warning + "]discharging"
 	default:
-		color = "[white]unknown"
+		color = "[-]unknown"
 	}
 
-	return color + "[white]"
+	return color + "[-]"
 }
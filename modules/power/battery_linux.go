@@ -8,27 +8,35 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
 var batteryState string
 
 type Battery struct {
+	colors cfg.ColorTheme
 	result string
 
 	Charge    string
 	Remaining string
 }
 
-func NewBattery() *Battery {
-	return &Battery{}
+func NewBattery(colors cfg.ColorTheme) *Battery {
+	return &Battery{colors: colors}
 }
 
 /* -------------------- Exported Functions -------------------- */
 
-func (battery *Battery) Refresh() {
-	data := battery.execute()
+func (battery *Battery) Refresh() error {
+	data, err := battery.execute()
+	if err != nil {
+		return err
+	}
+
 	battery.result = battery.parse(data)
+
+	return nil
 }
 
 func (battery *Battery) String() string {
@@ -37,9 +45,14 @@ func (battery *Battery) String() string {
 
 /* -------------------- Unexported Functions -------------------- */
 
-func (battery *Battery) execute() string {
+func (battery *Battery) execute() (string, error) {
 	cmd := exec.Command("upower", "-e")
-	lines := strings.Split(utils.ExecuteCommand(cmd), "\n")
+	out, err := utils.RunCommand(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(out, "\n")
 	var target string
 	for _, l := range lines {
 		if strings.Contains(l, "/battery") {
@@ -48,7 +61,7 @@ func (battery *Battery) execute() string {
 		}
 	}
 	cmd = exec.Command("upower", "-i", target)
-	return utils.ExecuteCommand(cmd)
+	return utils.RunCommand(cmd)
 }
 
 func (battery *Battery) parse(data string) string {
@@ -83,14 +96,14 @@ func (battery *Battery) formatCharge(data string) string {
 
 	switch {
 	case percent >= 70:
-		color = "[green]"
+		color = "[" + battery.colors.Success + "]"
 	case percent >= 35:
-		color = "[yellow]"
+		color = "[" + battery.colors.Warning + "]"
 	default:
-		color = "[red]"
+		color = "[" + battery.colors.Error + "]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
 
 func (battery *Battery) formatState(data string) string {
@@ -98,12 +111,12 @@ func (battery *Battery) formatState(data string) string {
 
 	switch data {
 	case "charging":
-		color = "[green]"
+		color = "[" + battery.colors.Success + "]"
 	case "discharging":
-		color = "[yellow]"
+		color = "[" + battery.colors.Warning + "]"
 	default:
-		color = "[white]"
+		color = "[-]"
 	}
 
-	return color + data + "[white]"
+	return color + data + "[-]"
 }
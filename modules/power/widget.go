@@ -19,7 +19,7 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 	widget := Widget{
 		TextWidget: view.NewTextWidget(app, settings.common),
 
-		Battery: NewBattery(),
+		Battery: NewBattery(settings.common.Colors),
 
 		settings: settings,
 	}
@@ -37,6 +37,8 @@ func (widget *Widget) content() (string, string, bool) {
 }
 
 func (widget *Widget) Refresh() {
-	widget.Battery.Refresh()
+	err := widget.Battery.Refresh()
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
@@ -3,6 +3,7 @@ package resourceusage
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
 )
 
 const (
@@ -12,6 +13,8 @@ const (
 
 type Settings struct {
 	common      *cfg.Common
+	chartLength int
+	chartStyle  string
 	cpuCombined bool
 	showCPU     bool
 	showMem     bool
@@ -21,11 +24,15 @@ type Settings struct {
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
 	settings := Settings{
 		common:      cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+		chartLength: ymlConfig.UInt("chartLength", 10),
+		chartStyle:  ymlConfig.UString("chartStyle", view.SparklineBlocks),
 		cpuCombined: ymlConfig.UBool("cpuCombined", false),
 		showCPU:     ymlConfig.UBool("showCPU", true),
 		showMem:     ymlConfig.UBool("showMem", true),
 		showSwp:     ymlConfig.UBool("showSwp", true),
 	}
 
+	settings.common.ValidateSetting("chartStyle", view.ValidateSparklineStyle(settings.chartStyle))
+
 	return &settings
 }
@@ -17,6 +17,9 @@ type Widget struct {
 	view.BarGraph
 
 	app      *tview.Application
+	cpuStats []float64
+	history  map[string]*view.Sparkline
+	memInfo  mem.VirtualMemoryStat
 	settings *Settings
 }
 
@@ -26,6 +29,7 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 		BarGraph: view.NewBarGraph(app, settings.common.Name, settings.common),
 
 		app:      app,
+		history:  map[string]*view.Sparkline{},
 		settings: settings,
 	}
 
@@ -39,7 +43,49 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 
 // MakeGraph - Load the dead drop stats
 func MakeGraph(widget *Widget) {
-	cpuStats, memInfo := getDataFromSystem(widget)
+	cpuStats, memInfo, err := getDataFromSystem(widget)
+
+	widget.SetRefreshError(err)
+	if err != nil {
+		widget.RedrawTitle()
+		return
+	}
+
+	widget.cpuStats = cpuStats
+	widget.memInfo = memInfo
+
+	widget.drawGraph(true)
+}
+
+// Refresh & update after interval time
+func (widget *Widget) Refresh() {
+	if widget.Disabled() {
+		return
+	}
+
+	widget.app.QueueUpdateDraw(func() {
+		display(widget)
+	})
+}
+
+// Render redraws the last stats that were loaded, without adding them to the charts again
+func (widget *Widget) Render() {
+	widget.app.QueueUpdateDraw(func() {
+		widget.drawGraph(false)
+	})
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func display(widget *Widget) {
+	MakeGraph(widget)
+}
+
+// drawGraph builds the bars from the last stats that were loaded. If sample is true the stats
+// are added to the charts' history first
+func (widget *Widget) drawGraph(sample bool) {
+	cpuStats := widget.cpuStats
+	memInfo := widget.memInfo
 
 	var itemsCount = 0
 	if widget.settings.showCPU {
@@ -57,12 +103,23 @@ func MakeGraph(widget *Widget) {
 	var stats = make([]view.Bar, itemsCount)
 	var nextIndex = 0
 
+	// The values rules can be written against: cpu, cpu.0, cpu.1, etc., mem and swap, all
+	// as percentages
+	values := map[string]interface{}{}
+
 	if widget.settings.showCPU && len(cpuStats) > 0 {
+		var cpuTotal float64
+
 		for i, stat := range cpuStats {
 			// Stats sometimes jump outside the 0-100 range, possibly due to timing
 			stat = math.Min(100, stat)
 			stat = math.Max(0, stat)
 
+			cpuTotal += stat
+			if !widget.settings.cpuCombined {
+				values[fmt.Sprintf("cpu.%d", i)] = stat
+			}
+
 			var label string
 			if widget.settings.cpuCombined {
 				label = "CPU"
@@ -72,14 +129,17 @@ func MakeGraph(widget *Widget) {
 
 			bar := view.Bar{
 				Label:      label,
-				Percent:    int(stat),
-				ValueLabel: fmt.Sprintf("%d%%", int(stat)),
-				LabelColor: "red",
+				Percent:    int(math.Round(stat)),
+				LabelColor: widget.settings.common.Colors.Subheading,
+				Trend:      widget.trend(label, stat, sample),
+				ValueLabel: widget.withSummary(label, fmt.Sprintf("%.0f%%", stat)),
 			}
 
 			stats[nextIndex] = bar
 			nextIndex++
 		}
+
+		values["cpu"] = cpuTotal / float64(len(cpuStats))
 	}
 
 	if widget.settings.showMem {
@@ -90,11 +150,14 @@ func MakeGraph(widget *Widget) {
 			usedMemLabel = usedMemLabel[:len(usedMemLabel)-1]
 		}
 
+		values["mem"] = memInfo.UsedPercent
+
 		stats[nextIndex] = view.Bar{
 			Label:      "Mem",
-			Percent:    int(memInfo.UsedPercent),
-			ValueLabel: fmt.Sprintf("%s/%s", usedMemLabel, totalMemLabel),
-			LabelColor: "green",
+			Percent:    int(math.Round(memInfo.UsedPercent)),
+			LabelColor: widget.settings.common.Colors.Title,
+			Trend:      widget.trend("Mem", memInfo.UsedPercent, sample),
+			ValueLabel: widget.withSummary("Mem", fmt.Sprintf("%s/%s", usedMemLabel, totalMemLabel)),
 		}
 		nextIndex++
 	}
@@ -113,50 +176,71 @@ func MakeGraph(widget *Widget) {
 			usedSwapLabel = usedSwapLabel[:len(usedSwapLabel)-1]
 		}
 
+		values["swap"] = swapPercent * 100
+
 		stats[nextIndex] = view.Bar{
 			Label:      "Swp",
-			Percent:    int(swapPercent * 100),
-			ValueLabel: fmt.Sprintf("%s/%s", usedSwapLabel, totalSwapLabel),
-			LabelColor: "yellow",
+			Percent:    int(math.Round(swapPercent * 100)),
+			LabelColor: widget.settings.common.Colors.Accent,
+			Trend:      widget.trend("Swp", swapPercent*100, sample),
+			ValueLabel: widget.withSummary("Swp", fmt.Sprintf("%s/%s", usedSwapLabel, totalSwapLabel)),
 		}
 	}
 
 	widget.BarGraph.BuildBars(stats)
 
+	widget.SetValues(values)
+	widget.RedrawTitle()
 }
 
-// Refresh & update after interval time
-func (widget *Widget) Refresh() {
-	if widget.Disabled() {
-		return
+// trend adds the percentage to the history of the row with the label, if sample is true, and
+// returns the chart of that history. Returns an empty string if the charts are turned off
+func (widget *Widget) trend(label string, percent float64, sample bool) string {
+	if widget.settings.chartLength <= 0 {
+		return ""
 	}
 
-	widget.app.QueueUpdateDraw(func() {
-		widget.View.Clear()
-		display(widget)
-	})
+	sparkline, ok := widget.history[label]
+	if !ok {
+		sparkline = view.NewSparkline(widget.settings.chartLength)
+		sparkline.SetRange(0, 100)
+		widget.history[label] = sparkline
+	}
+
+	if sample {
+		sparkline.Add(percent)
+	}
+
+	width := view.SparklineWidth(widget.settings.chartStyle, widget.settings.chartLength)
+	return sparkline.Render(widget.settings.chartStyle, width, 1)
 }
 
-/* -------------------- Unexported Functions -------------------- */
+// withSummary appends the range and average of the history of the row with the label to the
+// row's value label, if the row has a chart
+func (widget *Widget) withSummary(label string, valueLabel string) string {
+	sparkline, ok := widget.history[label]
+	if !ok || widget.settings.chartLength <= 0 {
+		return valueLabel
+	}
 
-func display(widget *Widget) {
-	MakeGraph(widget)
+	return fmt.Sprintf("%s %s", valueLabel, sparkline.Summary("%.0f%%"))
 }
 
-func getDataFromSystem(widget *Widget) (cpuStats []float64, memInfo mem.VirtualMemoryStat) {
+func getDataFromSystem(widget *Widget) (cpuStats []float64, memInfo mem.VirtualMemoryStat, err error) {
 	if widget.settings.showCPU {
-		rCPUStats, err := cpu.Percent(time.Duration(0), !widget.settings.cpuCombined)
-		if err == nil {
-			cpuStats = rCPUStats
+		cpuStats, err = cpu.Percent(time.Duration(0), !widget.settings.cpuCombined)
+		if err != nil {
+			return nil, memInfo, err
 		}
 	}
 
 	if widget.settings.showMem || widget.settings.showSwp {
 		rMemInfo, err := mem.VirtualMemory()
-		if err == nil {
-			memInfo = *rMemInfo
+		if err != nil {
+			return nil, memInfo, err
 		}
+		memInfo = *rMemInfo
 	}
 
-	return cpuStats, memInfo
+	return cpuStats, memInfo, nil
 }
@@ -15,7 +15,6 @@ type Widget struct {
 
 	items    *Result
 	settings *Settings
-	err      error
 }
 
 // NewWidget creates a new instance of a widget
@@ -49,15 +48,12 @@ func (widget *Widget) Refresh() {
 		widget.settings.activeOnly,
 	)
 
-	if err != nil {
-		widget.err = err
-		widget.items = nil
-		widget.SetItemCount(0)
-	} else {
+	if err == nil {
 		widget.items = &items.Results
 		widget.SetItemCount(len(widget.items.Items))
 	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -69,9 +65,6 @@ func (widget *Widget) Render() {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf("%s - %s", widget.CommonSettings().Title, widget.settings.projectName)
-	if widget.err != nil {
-		return widget.CommonSettings().Title, widget.err.Error(), true
-	}
 	result := widget.items
 	if result == nil || len(result.Items) == 0 {
 		return title, "No results", false
@@ -85,13 +78,13 @@ func (widget *Widget) content() (string, string, bool) {
 		row := fmt.Sprintf(
 			"[%s] [%s] %s [%s] %s [%s]count: %d [%s]%s",
 			widget.RowColor(idx),
-			levelColor(&item),
+			widget.levelColor(&item),
 			item.Level,
-			statusColor(&item),
+			widget.statusColor(&item),
 			item.Title,
 			widget.RowColor(idx),
 			item.TotalOccurrences,
-			"blue",
+			widget.settings.common.Colors.Label,
 			item.Environment,
 		)
 		str += utils.HighlightableHelper(widget.View, row, idx, len(item.Title))
@@ -100,26 +93,25 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
-func statusColor(item *Item) string {
+func (widget *Widget) statusColor(item *Item) string {
 	switch item.Status {
 	case "active":
-		return "red"
+		return widget.settings.common.Colors.Error
 	case "resolved":
-		return "green"
+		return widget.settings.common.Colors.Success
 	default:
-		return "red"
+		return widget.settings.common.Colors.Error
 	}
 }
-func levelColor(item *Item) string {
+
+func (widget *Widget) levelColor(item *Item) string {
 	switch item.Level {
-	case "error":
-		return "red"
-	case "critical":
-		return "green"
+	case "error", "critical":
+		return widget.settings.common.Colors.Error
 	case "warning":
-		return "yellow"
+		return widget.settings.common.Colors.Warning
 	default:
-		return "grey"
+		return widget.settings.common.Colors.Muted
 	}
 }
 
@@ -10,7 +10,7 @@ import (
 
 /* -------------------- Exported Functions -------------------- */
 
-func DnsServers() []string {
+func DnsServers() ([]string, error) {
 	switch runtime.GOOS {
 	case "linux":
 		return dnsLinux()
@@ -19,16 +19,19 @@ func DnsServers() []string {
 	case "windows":
 		return dnsWindows()
 	default:
-		return []string{runtime.GOOS}
+		return []string{runtime.GOOS}, nil
 	}
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func dnsLinux() []string {
+func dnsLinux() ([]string, error) {
 	// This may be very Ubuntu specific
 	cmd := exec.Command("nmcli", "device", "show")
-	out := utils.ExecuteCommand(cmd)
+	out, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	lines := strings.Split(out, "\n")
 
@@ -40,26 +43,34 @@ func dnsLinux() []string {
 			dns = append(dns, strings.TrimSpace(parts[1]))
 		}
 	}
-	return dns
+	return dns, nil
 }
 
-func dnsMacOS() []string {
+func dnsMacOS() ([]string, error) {
 	cmdString := `scutil --dns | head -n 7 | grep -o '[0-9]\{1,3\}\.[0-9]\{1,3\}\.[0-9]\{1,3\}\.[0-9]\{1,3\}'`
 	cmd := exec.Command("sh", "-c", cmdString)
-	out := utils.ExecuteCommand(cmd)
+	out, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	lines := strings.Split(out, "\n")
 
 	if len(lines) > 0 {
-		return lines
+		return lines, nil
 	}
 
-	return []string{}
+	return []string{}, nil
 }
 
-func dnsWindows() []string {
+func dnsWindows() ([]string, error) {
 
 	cmd := exec.Command("powershell.exe", "-NoProfile", "Get-DnsClientServerAddress | Select-Object –ExpandProperty ServerAddresses")
 
-	return []string{utils.ExecuteCommand(cmd)}
+	out, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{out}, nil
 }
@@ -7,6 +7,7 @@ import (
 	"runtime"
 	"strings"
 
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -14,35 +15,35 @@ const osxFirewallCmd = "/usr/libexec/ApplicationFirewall/socketfilterfw"
 
 /* -------------------- Exported Functions -------------------- */
 
-func FirewallState() string {
+func FirewallState(colors cfg.ColorTheme) (string, error) {
 	switch runtime.GOOS {
 	case "linux":
-		return firewallStateLinux()
+		return firewallStateLinux(colors), nil
 	case "darwin":
 		return firewallStateMacOS()
 	case "windows":
-		return firewallStateWindows()
+		return firewallStateWindows(colors)
 	default:
-		return ""
+		return "", nil
 	}
 }
 
-func FirewallStealthState() string {
+func FirewallStealthState() (string, error) {
 	switch runtime.GOOS {
 	case "linux":
-		return firewallStealthStateLinux()
+		return firewallStealthStateLinux(), nil
 	case "darwin":
 		return firewallStealthStateMacOS()
 	case "windows":
-		return firewallStealthStateWindows()
+		return firewallStealthStateWindows(), nil
 	default:
-		return ""
+		return "", nil
 	}
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func firewallStateLinux() string { // might be very Ubuntu specific
+func firewallStateLinux(colors cfg.ColorTheme) string { // might be very Ubuntu specific
 	user, _ := user.Current()
 
 	if strings.Contains(user.Username, "root") {
@@ -51,27 +52,30 @@ func firewallStateLinux() string { // might be very Ubuntu specific
 		var o bytes.Buffer
 		cmd.Stdout = &o
 		if err := cmd.Run(); err != nil {
-			return "[red]NA[white]"
+			return "[" + colors.Error + "]NA[-]"
 		}
 
 		if strings.Contains(o.String(), "inactive") {
-			return "[red]Disabled[white]"
+			return "[" + colors.Error + "]Disabled[-]"
 		} else {
-			return "[green]Enabled[white]"
+			return "[" + colors.Success + "]Enabled[-]"
 		}
 	} else {
-		return "[red]N/A[white]"
+		return "[" + colors.Error + "]N/A[-]"
 	}
 }
 
-func firewallStateMacOS() string {
+func firewallStateMacOS() (string, error) {
 	cmd := exec.Command(osxFirewallCmd, "--getglobalstate")
-	str := utils.ExecuteCommand(cmd)
+	str, err := utils.RunCommand(cmd)
+	if err != nil {
+		return "", err
+	}
 
-	return statusLabel(str)
+	return statusLabel(str), nil
 }
 
-func firewallStateWindows() string {
+func firewallStateWindows(colors cfg.ColorTheme) (string, error) {
 	// The raw way to do this in PS, not using netsh, nor registry, is the following:
 	//   if (((Get-NetFirewallProfile | select name,enabled)
 	//                                | where { $_.Enabled -eq $True } | measure ).Count -eq 3)
@@ -80,21 +84,24 @@ func firewallStateWindows() string {
 	cmd := exec.Command("powershell.exe", "-NoProfile",
 		"-Command", "& { ((Get-NetFirewallProfile | select name,enabled) | where { $_.Enabled -eq $True } | measure ).Count }")
 
-	fwStat := utils.ExecuteCommand(cmd)
+	fwStat, err := utils.RunCommand(cmd)
+	if err != nil {
+		return "", err
+	}
 	fwStat = strings.TrimSpace(fwStat) // Always sanitize PowerShell output:  "3\r\n"
 	//fmt.Printf("%d %q\n", len(fwStat), fwStat)
 
 	switch fwStat {
 	case "3":
-		return "[green]Good[white] (3/3)"
+		return "[" + colors.Success + "]Good[-] (3/3)", nil
 	case "2":
-		return "[orange]Poor[white] (2/3)"
+		return "[" + colors.Warning + "]Poor[-] (2/3)", nil
 	case "1":
-		return "[yellow]Bad[white] (1/3)"
+		return "[" + colors.Warning + "]Bad[-] (1/3)", nil
 	case "0":
-		return "[red]Disabled[white]"
+		return "[" + colors.Error + "]Disabled[-]", nil
 	default:
-		return "[white]N/A[white]"
+		return "[-]N/A[-]", nil
 	}
 }
 
@@ -102,18 +109,21 @@ func firewallStateWindows() string {
 // "Stealth": Not responding to pings from unauthorized devices
 
 func firewallStealthStateLinux() string {
-	return "[white]N/A[white]"
+	return "[-]N/A[-]"
 }
 
-func firewallStealthStateMacOS() string {
+func firewallStealthStateMacOS() (string, error) {
 	cmd := exec.Command(osxFirewallCmd, "--getstealthmode")
-	str := utils.ExecuteCommand(cmd)
+	str, err := utils.RunCommand(cmd)
+	if err != nil {
+		return "", err
+	}
 
-	return statusLabel(str)
+	return statusLabel(str), nil
 }
 
 func firewallStealthStateWindows() string {
-	return "[white]N/A[white]"
+	return "[-]N/A[-]"
 }
 
 func statusLabel(str string) string {
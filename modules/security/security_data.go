@@ -1,5 +1,9 @@
 package security
 
+import (
+	"github.com/wtfutil/wtf/cfg"
+)
+
 type SecurityData struct {
 	Dns             []string
 	FirewallEnabled string
@@ -20,11 +24,23 @@ func (data SecurityData) DnsAt(idx int) string {
 	return ""
 }
 
-func (data *SecurityData) Fetch() {
-	data.Dns = DnsServers()
-	data.FirewallEnabled = FirewallState()
-	data.FirewallStealth = FirewallStealthState()
-	data.LoggedInUsers = LoggedInUsers()
-	data.WifiName = WifiName()
-	data.WifiEncryption = WifiEncryption()
+// Fetch gathers as much of the security data as it can. A failing check leaves its field
+// empty and doesn't stop the others; the first failure is returned
+func (data *SecurityData) Fetch(colors cfg.ColorTheme) error {
+	errs := make([]error, 6)
+
+	data.Dns, errs[0] = DnsServers()
+	data.FirewallEnabled, errs[1] = FirewallState(colors)
+	data.FirewallStealth, errs[2] = FirewallStealthState()
+	data.LoggedInUsers, errs[3] = LoggedInUsers()
+	data.WifiName, errs[4] = WifiName()
+	data.WifiEncryption, errs[5] = WifiEncryption()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -12,7 +12,7 @@ import (
 
 /* -------------------- Exported Functions -------------------- */
 
-func LoggedInUsers() []string {
+func LoggedInUsers() ([]string, error) {
 	switch runtime.GOOS {
 	case "linux":
 		return loggedInUsersLinux()
@@ -21,7 +21,7 @@ func LoggedInUsers() []string {
 	case "windows":
 		return loggedInUsersWindows()
 	default:
-		return []string{}
+		return []string{}, nil
 	}
 }
 
@@ -49,9 +49,12 @@ func cleanUsers(users []string) []string {
 	return cleaned
 }
 
-func loggedInUsersLinux() []string {
+func loggedInUsersLinux() ([]string, error) {
 	cmd := exec.Command("who", "-us")
-	users := utils.ExecuteCommand(cmd)
+	users, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	cleaned := []string{}
 
@@ -72,17 +75,20 @@ func loggedInUsersLinux() []string {
 		}
 	}
 
-	return cleaned
+	return cleaned, nil
 }
 
-func loggedInUsersMacOs() []string {
+func loggedInUsersMacOs() ([]string, error) {
 	cmd := exec.Command("dscl", []string{".", "-list", "/Users"}...)
-	users := utils.ExecuteCommand(cmd)
+	users, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
-	return cleanUsers(strings.Split(users, "\n"))
+	return cleanUsers(strings.Split(users, "\n")), nil
 }
 
-func loggedInUsersWindows() []string {
+func loggedInUsersWindows() ([]string, error) {
 	// We can use either one:
 	// 		(Get-WMIObject -class Win32_ComputerSystem | select username).username
 	// 		[System.Security.Principal.WindowsIdentity]::GetCurrent().Name
@@ -95,6 +101,9 @@ func loggedInUsersWindows() []string {
 	cmd := exec.Command("powershell.exe", "-NoProfile", "-Command", "& { [System.Security.Principal.WindowsIdentity]::GetCurrent().Name }")
 	// ToDo:  Make list for multi-user systems
 
-	users := utils.ExecuteCommand(cmd)
-	return cleanUsers(strings.Split(users, "\n"))
+	users, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return cleanUsers(strings.Split(users, "\n")), nil
 }
@@ -11,6 +11,7 @@ import (
 type Widget struct {
 	view.TextWidget
 
+	data     *SecurityData
 	settings *Settings
 }
 
@@ -32,29 +33,36 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
+	widget.data = NewSecurityData()
+	err := widget.data.Fetch(widget.settings.common.Colors)
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
-	data := NewSecurityData()
-	data.Fetch()
-	str := fmt.Sprintf(" [%s]WiFi[white]\n", widget.settings.common.Colors.Subheading)
+	data := widget.data
+	if data == nil {
+		return widget.CommonSettings().Title, "", false
+	}
+
+	str := fmt.Sprintf(" [%s]WiFi[-]\n", widget.settings.common.Colors.Subheading)
 	str += fmt.Sprintf(" %8s: %s\n", "Network", data.WifiName)
 	str += fmt.Sprintf(" %8s: %s\n", "Crypto", data.WifiEncryption)
 	str += "\n"
 
-	str += fmt.Sprintf(" [%s]Firewall[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Firewall[-]\n", widget.settings.common.Colors.Subheading)
 	str += fmt.Sprintf(" %8s: %4s\n", "Status", data.FirewallEnabled)
 	str += fmt.Sprintf(" %8s: %4s\n", "Stealth", data.FirewallStealth)
 	str += "\n"
 
-	str += fmt.Sprintf(" [%s]Users[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]Users[-]\n", widget.settings.common.Colors.Subheading)
 	str += fmt.Sprintf("  %s", strings.Join(data.LoggedInUsers, "\n  "))
 	str += "\n\n"
 
-	str += fmt.Sprintf(" [%s]DNS[white]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf(" [%s]DNS[-]\n", widget.settings.common.Colors.Subheading)
 	str += fmt.Sprintf("  %12s\n", data.DnsAt(0))
 	str += fmt.Sprintf("  %12s\n", data.DnsAt(1))
 	str += "\n"
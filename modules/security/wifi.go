@@ -14,55 +14,63 @@ const osxWifiArg = "-I"
 
 /* -------------------- Exported Functions -------------------- */
 
-func WifiEncryption() string {
+func WifiEncryption() (string, error) {
 	switch runtime.GOOS {
 	case "linux":
 		return wifiEncryptionLinux()
 	case "darwin":
 		return wifiEncryptionMacOS()
 	case "windows":
-		return wifiEncryptionWindows()
+		return wifiEncryptionWindows(), nil
 	default:
-		return ""
+		return "", nil
 	}
 }
 
-func WifiName() string {
+func WifiName() (string, error) {
 	switch runtime.GOOS {
 	case "linux":
-		return wifiNameLinux()
+		return wifiNameLinux(), nil
 	case "darwin":
 		return wifiNameMacOS()
 	case "windows":
-		return wifiNameWindows()
+		return wifiNameWindows(), nil
 	default:
-		return ""
+		return "", nil
 	}
 }
 
 /* -------------------- Unexported Functions -------------------- */
 
-func wifiEncryptionLinux() string {
+func wifiEncryptionLinux() (string, error) {
 	cmd := exec.Command("nmcli", "-t", "-f", "in-use,security", "dev", "wifi")
-	out := utils.ExecuteCommand(cmd)
+	out, err := utils.RunCommand(cmd)
+	if err != nil {
+		return "", err
+	}
 
 	name := utils.FindMatch(`\*:(.+)`, out)
 
 	if len(name) > 0 {
-		return name[0][1]
+		return name[0][1], nil
 	}
 
-	return ""
+	return "", nil
 }
 
-func wifiEncryptionMacOS() string {
-	name := utils.FindMatch(`s*auth: (.+)s*`, wifiInfo())
-	return matchStr(name)
+func wifiEncryptionMacOS() (string, error) {
+	info, err := wifiInfo()
+	if err != nil {
+		return "", err
+	}
+
+	name := utils.FindMatch(`s*auth: (.+)s*`, info)
+	return matchStr(name), nil
 }
 
-func wifiInfo() string {
+func wifiInfo() (string, error) {
 	cmd := exec.Command(osxWifiCmd, osxWifiArg)
-	return utils.ExecuteCommand(cmd)
+	return utils.RunCommand(cmd)
 }
 
 func wifiNameLinux() string {
@@ -70,9 +78,14 @@ func wifiNameLinux() string {
 	return string(cmd)
 }
 
-func wifiNameMacOS() string {
-	name := utils.FindMatch(`s*SSID: (.+)s*`, wifiInfo())
-	return matchStr(name)
+func wifiNameMacOS() (string, error) {
+	info, err := wifiInfo()
+	if err != nil {
+		return "", err
+	}
+
+	name := utils.FindMatch(`s*SSID: (.+)s*`, info)
+	return matchStr(name), nil
 }
 
 func matchStr(data [][]string) string {
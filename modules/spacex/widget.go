@@ -12,7 +12,7 @@ import (
 type Widget struct {
 	view.TextWidget
 	settings *Settings
-	err      error
+	launch   *Launch
 }
 
 func NewWidget(app *tview.Application, settings *Settings) *Widget {
@@ -27,6 +27,13 @@ func (widget *Widget) Refresh() {
 	if widget.Disabled() {
 		return
 	}
+
+	launch, err := NextLaunch()
+	if err == nil {
+		widget.launch = launch
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -40,32 +47,27 @@ func (widget *Widget) content() (string, string, bool) {
 		title = widget.CommonSettings().Title
 	}
 
-	launch, err := NextLaunch()
-	var str string
-	if err != nil {
-		handleError(widget, err)
-	} else {
+	launch := widget.launch
+	if launch == nil {
+		return title, "", true
+	}
 
-		str = fmt.Sprintf("[%s]Mission[white]\n", widget.settings.common.Colors.Subheading)
-		str += fmt.Sprintf("%s: %s\n", "Name", launch.MissionName)
-		str += fmt.Sprintf("%s: %s\n", "Date", wtf.UnixTime(launch.LaunchDate).Format(time.RFC822))
-		str += fmt.Sprintf("%s: %s\n", "Site", launch.LaunchSite.Name)
-		str += "\n"
+	str := fmt.Sprintf("[%s]Mission[-]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf("%s: %s\n", "Name", launch.MissionName)
+	str += fmt.Sprintf("%s: %s\n", "Date", wtf.UnixTime(launch.LaunchDate).Format(time.RFC822))
+	str += fmt.Sprintf("%s: %s\n", "Site", launch.LaunchSite.Name)
+	str += "\n"
 
-		str += fmt.Sprintf("[%s]Links[white]\n", widget.settings.common.Colors.Subheading)
-		str += fmt.Sprintf("%s: %s\n", "YouTube", launch.Links.YouTubeLink)
-		str += fmt.Sprintf("%s: %s\n", "Reddit", launch.Links.RedditLink)
+	str += fmt.Sprintf("[%s]Links[-]\n", widget.settings.common.Colors.Subheading)
+	str += fmt.Sprintf("%s: %s\n", "YouTube", launch.Links.YouTubeLink)
+	str += fmt.Sprintf("%s: %s\n", "Reddit", launch.Links.RedditLink)
 
-		if widget.CommonSettings().Height >= 2 {
-			str += "\n"
-			str += fmt.Sprintf("[%s]Details[white]\n", widget.settings.common.Colors.Subheading)
-			str += fmt.Sprintf("%s: %s\n", "RocketName", launch.Rocket.Name)
-			str += fmt.Sprintf("%s: %s\n", "Details", launch.Details)
-		}
+	if widget.CommonSettings().Height >= 2 {
+		str += "\n"
+		str += fmt.Sprintf("[%s]Details[-]\n", widget.settings.common.Colors.Subheading)
+		str += fmt.Sprintf("%s: %s\n", "RocketName", launch.Rocket.Name)
+		str += fmt.Sprintf("%s: %s\n", "Details", launch.Details)
 	}
-	return title, str, true
-}
 
-func handleError(widget *Widget, err error) {
-	widget.err = err
+	return title, str, true
 }
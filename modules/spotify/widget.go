@@ -46,11 +46,18 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 func (w *Widget) refreshSpotifyInfos() error {
 	info, err := w.client.GetInfo()
+	if err != nil {
+		return err
+	}
+
 	w.Info = info
-	return err
+	return nil
 }
 
 func (w *Widget) Refresh() {
+	err := w.refreshSpotifyInfos()
+
+	w.SetRefreshError(err)
 	w.Redraw(w.createOutput)
 }
 
@@ -60,10 +67,7 @@ func (widget *Widget) HelpText() string {
 
 func (w *Widget) createOutput() (string, string, bool) {
 	var content string
-	err := w.refreshSpotifyInfos()
-	if err != nil {
-		content = err.Error()
-	} else {
+	if w.Info.Status != "" {
 		labelColor := w.settings.colors.label
 		textColor := w.settings.colors.text
 
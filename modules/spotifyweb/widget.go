@@ -1,10 +1,8 @@
 package spotifyweb
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
-	"os"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
@@ -95,20 +93,8 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		// wait for auth to complete
 		client = <-tempClientChan
 
-		// use the client to make calls that require authorization
-		_, err := client.CurrentUser()
-		if err != nil {
-			panic(err)
-		}
-
-		playerState, err = client.PlayerState()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
-		}
-
+		// The player state is fetched, and any failure reported, by the refresh
 		widget.client = client
-		widget.playerState = playerState
 		widget.Refresh()
 	}()
 
@@ -135,14 +121,11 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 }
 
 func (w *Widget) refreshSpotifyInfos() error {
-	if w.client == nil || w.playerState == nil {
-		return errors.New("authentication failed! Please log in to Spotify by visiting the following page in your browser: " + authURL)
-	}
-	var err error
-	w.playerState, err = w.client.PlayerState()
+	playerState, err := w.client.PlayerState()
 	if err != nil {
-		return errors.New("extracting player state failed! Please refresh or restart WTF")
+		return fmt.Errorf("extracting player state failed: %w", err)
 	}
+	w.playerState = playerState
 	w.Info.Album = fmt.Sprint(w.playerState.CurrentlyPlaying.Item.Album.Name)
 	artists := ""
 	for _, artist := range w.playerState.CurrentlyPlaying.Item.Artists {
@@ -162,6 +145,16 @@ func (w *Widget) refreshSpotifyInfos() error {
 
 // Refresh refreshes the current view of the widget
 func (w *Widget) Refresh() {
+	if w.client == nil {
+		w.Redraw(func() (string, string, bool) {
+			return w.CommonSettings().Title, "Please log in to Spotify by visiting the following page in your browser: " + authURL, true
+		})
+		return
+	}
+
+	err := w.refreshSpotifyInfos()
+
+	w.SetRefreshError(err)
 	w.Redraw(w.createOutput)
 }
 
@@ -172,18 +165,17 @@ func (widget *Widget) HelpText() string {
 func (w *Widget) createOutput() (string, string, bool) {
 	var output string
 
-	err := w.refreshSpotifyInfos()
-	if err != nil {
-		output = err.Error()
-	} else {
-		output += utils.CenterText(fmt.Sprintf("[green]Now %v [white]\n", w.Info.Status), w.CommonSettings().Width)
-		output += utils.CenterText(fmt.Sprintf("[green]Title:[white] %v\n", w.Info.Title), w.CommonSettings().Width)
-		output += utils.CenterText(fmt.Sprintf("[green]Artist:[white] %v\n", w.Info.Artists), w.CommonSettings().Width)
-		output += utils.CenterText(fmt.Sprintf("[green]Album:[white] %v\n", w.Info.Album), w.CommonSettings().Width)
+	if w.playerState != nil {
+		color := w.CommonSettings().Colors.Title
+
+		output += utils.CenterText(fmt.Sprintf("[%s]Now %v [-]\n", color, w.Info.Status), w.CommonSettings().Width)
+		output += utils.CenterText(fmt.Sprintf("[%s]Title:[-] %v\n", color, w.Info.Title), w.CommonSettings().Width)
+		output += utils.CenterText(fmt.Sprintf("[%s]Artist:[-] %v\n", color, w.Info.Artists), w.CommonSettings().Width)
+		output += utils.CenterText(fmt.Sprintf("[%s]Album:[-] %v\n", color, w.Info.Album), w.CommonSettings().Width)
 		if w.playerState.ShuffleState {
-			output += utils.CenterText("[green]Shuffle:[white] on\n", w.CommonSettings().Width)
+			output += utils.CenterText(fmt.Sprintf("[%s]Shuffle:[-] on\n", color), w.CommonSettings().Width)
 		} else {
-			output += utils.CenterText("[green]Shuffle:[white] off\n", w.CommonSettings().Width)
+			output += utils.CenterText(fmt.Sprintf("[%s]Shuffle:[-] off\n", color), w.CommonSettings().Width)
 		}
 	}
 	return w.CommonSettings().Title, output, true
@@ -13,7 +13,6 @@ type Widget struct {
 	view.ScrollableWidget
 
 	settings *Settings
-	err      error
 	links    []Link
 }
 
@@ -39,21 +38,16 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 func (widget *Widget) Refresh() {
 	links, err := GetLinks(widget.settings.subreddit, widget.settings.sortOrder, widget.settings.topTimePeriod)
-	if err != nil {
-		widget.err = err
-		widget.links = nil
-		widget.SetItemCount(0)
-	} else {
+	if err == nil {
 		if len(links) <= widget.settings.numberOfPosts {
 			widget.links = links
-			widget.SetItemCount(len(widget.links))
-			widget.err = nil
 		} else {
 			widget.links = links[:widget.settings.numberOfPosts]
-			widget.SetItemCount(len(widget.links))
-			widget.err = nil
 		}
+		widget.SetItemCount(len(widget.links))
 	}
+
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -65,10 +59,6 @@ func (widget *Widget) Render() {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := "/r/" + widget.settings.subreddit + " - " + widget.settings.sortOrder
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	var content string
 	for idx, link := range widget.links {
 		row := fmt.Sprintf(
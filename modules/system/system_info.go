@@ -16,7 +16,7 @@ type SystemInfo struct {
 	BuildVersion   string
 }
 
-func NewSystemInfo() *SystemInfo {
+func NewSystemInfo() (*SystemInfo, error) {
 	m := make(map[string]string)
 
 	arg := []string{}
@@ -32,7 +32,10 @@ func NewSystemInfo() *SystemInfo {
 		cmd = exec.Command("sw_vers", arg...)
 	}
 
-	raw := utils.ExecuteCommand(cmd)
+	raw, err := utils.RunCommand(cmd)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, row := range strings.Split(raw, "\n") {
 		parts := strings.Split(row, ":")
@@ -59,5 +62,5 @@ func NewSystemInfo() *SystemInfo {
 		}
 
 	}
-	return sysInfo
+	return sysInfo, nil
 }
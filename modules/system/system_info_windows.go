@@ -3,6 +3,7 @@
 package system
 
 import (
+	"fmt"
 	"os/exec"
 	"strings"
 )
@@ -13,15 +14,18 @@ type SystemInfo struct {
 	BuildVersion   string
 }
 
-func NewSystemInfo() *SystemInfo {
+func NewSystemInfo() (*SystemInfo, error) {
 	m := make(map[string]string)
 
 	cmd := exec.Command("powershell.exe", "(Get-CimInstance Win32_OperatingSystem).version")
 	out, err := cmd.Output()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	s := strings.Split(string(out), ".")
+	if len(s) < 3 {
+		return nil, fmt.Errorf("unexpected Windows version: %q", strings.TrimSpace(string(out)))
+	}
 	m["ProductName"] = "Windows"
 	m["ProductVersion"] = "Windows " + s[0] + "." + s[1]
 	m["BuildVersion"] = s[2]
@@ -32,5 +36,5 @@ func NewSystemInfo() *SystemInfo {
 		BuildVersion:   m["BuildVersion"],
 	}
 
-	return &sysInfo
+	return &sysInfo, nil
 }
@@ -29,12 +29,15 @@ func NewWidget(app *tview.Application, date, version string, settings *Settings)
 		Version:  version,
 	}
 
-	widget.systemInfo = NewSystemInfo()
-
 	return &widget
 }
 
 func (widget *Widget) display() (string, string, bool) {
+	sysInfo := widget.systemInfo
+	if sysInfo == nil {
+		sysInfo = &SystemInfo{}
+	}
+
 	content := fmt.Sprintf(
 		"%8s: %s\n%8s: %s\n\n%8s: %s\n%8s: %s",
 		"Built",
@@ -42,15 +45,22 @@ func (widget *Widget) display() (string, string, bool) {
 		"Vers",
 		widget.Version,
 		"OS",
-		widget.systemInfo.ProductVersion,
+		sysInfo.ProductVersion,
 		"Build",
-		widget.systemInfo.BuildVersion,
+		sysInfo.BuildVersion,
 	)
 
 	return widget.CommonSettings().Title, content, false
 }
 
 func (widget *Widget) Refresh() {
+	// The system info doesn't change while running, so once it's been read it's kept
+	var err error
+	if widget.systemInfo == nil {
+		widget.systemInfo, err = NewSystemInfo()
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.display)
 }
 
@@ -58,7 +68,8 @@ func (widget *Widget) prettyDate() string {
 	str, err := time.Parse(utils.TimestampFormat, widget.Date)
 
 	if err != nil {
-		return err.Error()
+		// Builds without a build date, e.g. from source, show whatever was set
+		return widget.Date
 	}
 
 	return str.Format("Jan _2, 15:04")
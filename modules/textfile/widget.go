@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/alecthomas/chroma/formatters"
@@ -13,6 +14,7 @@ import (
 	"github.com/alecthomas/chroma/styles"
 	"github.com/radovskyb/watcher"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 )
@@ -27,6 +29,10 @@ type Widget struct {
 	view.TextWidget
 
 	settings *Settings
+
+	// texts is the last text read from each file. The watcher refreshes off the main loop
+	textsMutex sync.Mutex
+	texts      map[string]string
 }
 
 // NewWidget creates a new instance of a widget
@@ -37,6 +43,7 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
 		settings: settings,
+		texts:    map[string]string{},
 	}
 
 	// Don't use a timer for this widget, watch for filesystem changes instead
@@ -61,6 +68,23 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 // Refresh is only called once on start-up. Its job is to display the
 // text files that first time. After that, the watcher takes over
 func (widget *Widget) Refresh() {
+	source := widget.CurrentSource()
+
+	var text string
+	var err error
+	if widget.settings.format {
+		text, err = widget.formattedText(source)
+	} else {
+		text, err = widget.plainText(source)
+	}
+
+	if err == nil {
+		widget.textsMutex.Lock()
+		widget.texts[source] = text
+		widget.textsMutex.Unlock()
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -72,29 +96,26 @@ func (widget *Widget) HelpText() string {
 
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf(
-		"[%s]%s[white]",
+		"[%s]%s[-]",
 		widget.settings.common.Colors.TextTheme.Title,
 		widget.CurrentSource(),
 	)
 
 	_, _, width, _ := widget.View.GetRect()
 	text := widget.settings.common.SigilStr(len(widget.Sources), widget.Idx, width) + "\n"
-
-	if widget.settings.format {
-		text += widget.formattedText()
-	} else {
-		text += widget.plainText()
-	}
+	widget.textsMutex.Lock()
+	text += widget.texts[widget.CurrentSource()]
+	widget.textsMutex.Unlock()
 
 	return title, text, widget.settings.wrapText
 }
 
-func (widget *Widget) formattedText() string {
-	filePath, _ := utils.ExpandHomeDir(widget.CurrentSource())
+func (widget *Widget) formattedText(source string) (string, error) {
+	filePath, _ := utils.ExpandHomeDir(source)
 
 	file, err := os.Open(filepath.Clean(filePath))
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
 	defer func() { _ = file.Close() }()
 
@@ -118,20 +139,20 @@ func (widget *Widget) formattedText() string {
 	var buf bytes.Buffer
 	err = formatter.Format(&buf, style, iterator)
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
 
-	return tview.TranslateANSI(buf.String())
+	return tview.TranslateANSI(buf.String()), nil
 }
 
-func (widget *Widget) plainText() string {
-	filePath, _ := utils.ExpandHomeDir(filepath.Clean(widget.CurrentSource()))
+func (widget *Widget) plainText(source string) (string, error) {
+	filePath, _ := utils.ExpandHomeDir(filepath.Clean(source))
 
 	text, err := ioutil.ReadFile(filepath.Clean(filePath))
 	if err != nil {
-		return err.Error()
+		return "", err
 	}
-	return string(text)
+	return string(text), nil
 }
 
 func (widget *Widget) watchForFileChanges() {
@@ -144,8 +165,7 @@ func (widget *Widget) watchForFileChanges() {
 			case <-watch.Event:
 				widget.Refresh()
 			case err := <-watch.Error:
-				fmt.Println(err)
-				os.Exit(1)
+				logger.Errorf(widget.Name(), "watching files: %s", err)
 			case <-watch.Closed:
 				return
 			}
@@ -158,15 +178,13 @@ func (widget *Widget) watchForFileChanges() {
 		if err == nil {
 			e := watch.Add(fullPath)
 			if e != nil {
-				fmt.Println(e)
-				os.Exit(1)
+				logger.Errorf(widget.Name(), "watching %s: %s", fullPath, e)
 			}
 		}
 	}
 
 	// Start the watching process - it'll check for changes every pollingIntervalms.
 	if err := watch.Start(time.Millisecond * pollingIntervalms); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		logger.Errorf(widget.Name(), "watching files: %s", err)
 	}
 }
@@ -53,7 +53,7 @@ func (widget *Widget) formattedItemLine(idx int, currItem *checklist.ChecklistIt
 	}
 
 	row := fmt.Sprintf(
-		` [%s]|%s| %s[white]`,
+		` [%s]|%s| %s[-]`,
 		rowColor,
 		currItem.CheckMark(),
 		tview.Escape(currItem.Text),
@@ -78,7 +78,9 @@ func (widget *Widget) SelectedItem() *checklist.ChecklistItem {
 
 // Refresh updates the data for this widget and displays it onscreen
 func (widget *Widget) Refresh() {
-	widget.load()
+	err := widget.load()
+
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -101,19 +103,24 @@ func (widget *Widget) isItemSelected() bool {
 }
 
 // Loads the todo list from3 Yaml file
-func (widget *Widget) load() {
+func (widget *Widget) load() error {
 	confDir, _ := cfg.WtfConfigDir()
 	filePath := fmt.Sprintf("%s/%s", confDir, widget.filePath)
 
-	fileData, _ := utils.ReadFileBytes(filePath)
+	fileData, err := utils.ReadFileBytes(filePath)
+	if err != nil {
+		return err
+	}
 
-	err := yaml.Unmarshal(fileData, &widget.list)
+	err = yaml.Unmarshal(fileData, &widget.list)
 	if err != nil {
-		return
+		return err
 	}
 
 	widget.ScrollableWidget.SetItemCount(len(widget.list.Items))
 	widget.setItemChecks()
+
+	return nil
 }
 
 func (widget *Widget) newItem() {
@@ -138,7 +145,7 @@ func (widget *Widget) newItem() {
 	})
 }
 
-// persist writes the todo list to Yaml file
+// persist writes the todo list to Yaml file. A failed write is shown as the widget's refresh error
 func (widget *Widget) persist() {
 	confDir, _ := cfg.WtfConfigDir()
 	filePath := fmt.Sprintf("%s/%s", confDir, widget.filePath)
@@ -147,9 +154,7 @@ func (widget *Widget) persist() {
 
 	err := ioutil.WriteFile(filePath, fileData, 0644)
 
-	if err != nil {
-		panic(err)
-	}
+	widget.SetRefreshError(err)
 }
 
 // setItemChecks rolls through the checklist and ensures that all checklist
@@ -7,8 +7,8 @@ import (
 type Backend interface {
 	Title() string
 	Setup(*config.Config)
-	BuildProjects() []*Project
-	GetProject(string) *Project
+	BuildProjects() ([]*Project, error)
+	GetProject(string) (*Project, error)
 	LoadTasks(string) ([]Task, error)
 	CloseTask(*Task) error
 	DeleteTask(*Task) error
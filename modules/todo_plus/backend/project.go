@@ -12,7 +12,6 @@ type Project struct {
 
 	Index   int
 	Tasks   []Task
-	Err     error
 	backend Backend
 }
 
@@ -20,10 +19,14 @@ func (proj *Project) IsLast() bool {
 	return proj.Index >= len(proj.Tasks)-1
 }
 
-func (proj *Project) loadTasks() {
-	Tasks, err := proj.backend.LoadTasks(proj.ID)
-	proj.Err = err
-	proj.Tasks = Tasks
+func (proj *Project) loadTasks() error {
+	tasks, err := proj.backend.LoadTasks(proj.ID)
+	if err != nil {
+		return err
+	}
+
+	proj.Tasks = tasks
+	return nil
 }
 
 func (proj *Project) LongestLine() int {
@@ -46,21 +49,27 @@ func (proj *Project) currentTask() *Task {
 	return &proj.Tasks[proj.Index]
 }
 
-func (proj *Project) CloseSelectedTask() {
+func (proj *Project) CloseSelectedTask() error {
 	currTask := proj.currentTask()
 
 	if currTask != nil {
-		_ = proj.backend.CloseTask(currTask)
-		proj.loadTasks()
+		if err := proj.backend.CloseTask(currTask); err != nil {
+			return err
+		}
+		return proj.loadTasks()
 	}
+	return nil
 }
 
-func (proj *Project) DeleteSelectedTask() {
+func (proj *Project) DeleteSelectedTask() error {
 	currTask := proj.currentTask()
 
 	if currTask != nil {
-		_ = proj.backend.DeleteTask(currTask)
+		if err := proj.backend.DeleteTask(currTask); err != nil {
+			return err
+		}
 
-		proj.loadTasks()
+		return proj.loadTasks()
 	}
+	return nil
 }
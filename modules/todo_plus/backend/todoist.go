@@ -20,20 +20,21 @@ func (todo *Todoist) Setup(config *config.Config) {
 	todo.projects = config.UList("projects")
 }
 
-func (todo *Todoist) BuildProjects() []*Project {
+func (todo *Todoist) BuildProjects() ([]*Project, error) {
 	projects := []*Project{}
 
 	for _, id := range todo.projects {
 		i := strconv.Itoa(id.(int))
-		proj := todo.GetProject(i)
+		proj, err := todo.GetProject(i)
+		if err != nil {
+			return nil, err
+		}
 		projects = append(projects, proj)
 	}
-	return projects
+	return projects, nil
 }
 
-func (todo *Todoist) GetProject(id string) *Project {
-	// Todoist seems to experience a lot of network issues on their side
-	// If we can't connect, handle it with an empty project until we can
+func (todo *Todoist) GetProject(id string) (*Project, error) {
 	proj := &Project{
 		Index:   -1,
 		backend: todo,
@@ -42,18 +43,19 @@ func (todo *Todoist) GetProject(id string) *Project {
 	i := uint(i64)
 	project, err := todoist.GetProject(i)
 	if err != nil {
-		proj.Err = err
-		return proj
+		return nil, err
 	}
 
 	proj.ID = strconv.FormatUint(uint64(project.ID), 10)
 	proj.Name = project.Name
 
 	tasks, err := todo.LoadTasks(proj.ID)
-	proj.Err = err
+	if err != nil {
+		return nil, err
+	}
 	proj.Tasks = tasks
 
-	return proj
+	return proj, nil
 }
 
 func toTask(task todoist.Task) Task {
@@ -2,7 +2,6 @@ package backend
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/adlio/trello"
 	"github.com/olebedev/config"
@@ -27,11 +26,6 @@ func (todo *Trello) Setup(config *config.Config) {
 		config.UString("apiKey"),
 		config.UString("accessToken"),
 	)
-	board, err := getBoardID(todo.client, todo.username, todo.boardName)
-	if err != nil {
-		log.Fatal(err)
-	}
-	todo.board = board
 	todo.projects = config.UList("lists")
 }
 
@@ -89,17 +83,30 @@ func getCardsOnList(client *trello.Client, listID string) ([]*trello.Card, error
 	return cards, nil
 }
 
-func (todo *Trello) BuildProjects() []*Project {
+func (todo *Trello) BuildProjects() ([]*Project, error) {
+	// The board is looked up on the first build rather than in Setup, so that
+	// a network failure is reported by the widget instead of ending the app
+	if todo.board == "" {
+		board, err := getBoardID(todo.client, todo.username, todo.boardName)
+		if err != nil {
+			return nil, err
+		}
+		todo.board = board
+	}
+
 	projects := []*Project{}
 
 	for _, id := range todo.projects {
-		proj := todo.GetProject(id.(string))
+		proj, err := todo.GetProject(id.(string))
+		if err != nil {
+			return nil, err
+		}
 		projects = append(projects, proj)
 	}
-	return projects
+	return projects, nil
 }
 
-func (todo *Trello) GetProject(id string) *Project {
+func (todo *Trello) GetProject(id string) (*Project, error) {
 	proj := &Project{
 		Index:   -1,
 		backend: todo,
@@ -107,17 +114,18 @@ func (todo *Trello) GetProject(id string) *Project {
 
 	listId, err := getListId(todo.client, todo.board, id)
 	if err != nil {
-		proj.Err = err
-		return proj
+		return nil, err
 	}
 	proj.ID = listId
 	proj.Name = id
 
 	tasks, err := todo.LoadTasks(listId)
-	proj.Err = err
+	if err != nil {
+		return nil, err
+	}
 	proj.Tasks = tasks
 
-	return proj
+	return proj, nil
 }
 
 func fromTrello(task *trello.Card) Task {
@@ -14,12 +14,8 @@ func (widget *Widget) content() (string, string, bool) {
 		return widget.CommonSettings().Title, "", false
 	}
 
-	if proj.Err != nil {
-		return widget.CommonSettings().Title, proj.Err.Error(), true
-	}
-
 	title := fmt.Sprintf(
-		"[%s]%s[white]",
+		"[%s]%s[-]",
 		widget.settings.common.Colors.TextTheme.Title,
 		proj.Name)
 
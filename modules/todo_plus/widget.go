@@ -77,9 +77,14 @@ func (widget *Widget) Refresh() {
 		return
 	}
 
-	widget.projects = widget.backend.BuildProjects()
-	widget.Sources = widget.backend.Sources()
-	widget.SetItemCount(len(widget.CurrentProject().Tasks))
+	projects, err := widget.backend.BuildProjects()
+	if err == nil {
+		widget.projects = projects
+		widget.Sources = widget.backend.Sources()
+		widget.SetItemCount(len(widget.CurrentProject().Tasks))
+	}
+
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -121,7 +126,8 @@ func (widget *Widget) Unselect() {
 
 // Close closes the currently-selected task in the currently-selected project
 func (w *Widget) Close() {
-	w.CurrentProject().CloseSelectedTask()
+	err := w.CurrentProject().CloseSelectedTask()
+	w.SetRefreshError(err)
 	w.SetItemCount(len(w.CurrentProject().Tasks))
 
 	if w.CurrentProject().IsLast() {
@@ -134,7 +140,8 @@ func (w *Widget) Close() {
 
 // Delete deletes the currently-selected task in the currently-selected project
 func (w *Widget) Delete() {
-	w.CurrentProject().DeleteSelectedTask()
+	err := w.CurrentProject().DeleteSelectedTask()
+	w.SetRefreshError(err)
 	w.SetItemCount(len(w.CurrentProject().Tasks))
 
 	if w.CurrentProject().IsLast() {
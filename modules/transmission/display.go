@@ -5,10 +5,16 @@ import (
 	"strings"
 
 	"github.com/hekmon/transmissionrpc"
-	"github.com/rivo/tview"
-	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
 )
 
+// columns are the columns of the torrents table
+var columns = []view.TableColumn{
+	{Name: "Done", Type: view.ColumnNumber},
+	{Name: "Ratio", Type: view.ColumnNumber},
+	{Name: "Name", Type: view.ColumnText},
+}
+
 func (widget *Widget) display() {
 	widget.ScrollableWidget.Redraw(widget.content)
 }
@@ -17,33 +23,12 @@ func (widget *Widget) content() (string, string, bool) {
 	widget.mu.Lock()
 	defer widget.mu.Unlock()
 
-	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
+	title := widget.TableTitle(widget.CommonSettings().Title)
 	if len(widget.torrents) == 0 {
 		return title, "No data", false
 	}
 
-	str := ""
-
-	for idx, torrent := range widget.torrents {
-		torrName := *torrent.Name
-
-		row := fmt.Sprintf(
-			"[%s] %s %s %s%s[white]",
-			widget.RowColor(idx),
-			widget.torrentPercentDone(torrent),
-			widget.torrentSeedRatio(torrent),
-			widget.torrentState(torrent),
-			tview.Escape(widget.prettyTorrentName(torrName)),
-		)
-
-		str += utils.HighlightableHelper(widget.View, row, idx, len(torrName))
-	}
-
-	return title, str, false
+	return title, widget.TableContent(), false
 }
 
 func (widget *Widget) prettyTorrentName(name string) string {
@@ -53,43 +38,70 @@ func (widget *Widget) prettyTorrentName(name string) string {
 	return str
 }
 
-func (widget *Widget) torrentPercentDone(torrent *transmissionrpc.Torrent) string {
+// torrentRows returns a row of the torrents table for each torrent
+func (widget *Widget) torrentRows(torrents []*transmissionrpc.Torrent) []view.TableRow {
+	rows := []view.TableRow{}
+
+	for _, torrent := range torrents {
+		rows = append(rows, view.TableRow{
+			Cells: []view.TableCell{
+				widget.torrentPercentDone(torrent),
+				widget.torrentSeedRatio(torrent),
+				{Color: widget.torrentState(torrent), Text: widget.prettyTorrentName(*torrent.Name)},
+			},
+			Item: torrent,
+		})
+	}
+
+	return rows
+}
+
+func (widget *Widget) torrentPercentDone(torrent *transmissionrpc.Torrent) view.TableCell {
 	pctDone := *torrent.PercentDone
-	str := fmt.Sprintf("%3d%%↓", int(pctDone*100))
+	colors := widget.settings.common.Colors
 
+	color := colors.Label
 	switch pctDone {
 	case 0.0:
-		str = "[gray::b]" + str
+		color = colors.Muted
 	case 1.0:
-		str = "[green::b]" + str
-	default:
-		str = "[lightblue::b]" + str
+		color = colors.Success
 	}
 
-	return str + "[white]"
+	return view.TableCell{
+		Color: color + "::b",
+		Text:  fmt.Sprintf("%d%%↓", int(pctDone*100)),
+		Value: pctDone,
+	}
 }
 
-func (widget *Widget) torrentSeedRatio(torrent *transmissionrpc.Torrent) string {
+func (widget *Widget) torrentSeedRatio(torrent *transmissionrpc.Torrent) view.TableCell {
 	seedRatio := *torrent.UploadRatio
 
 	if seedRatio < 0 {
 		seedRatio = 0
 	}
 
-	return fmt.Sprintf("[green]%3d%%↑", int(seedRatio*100))
+	return view.TableCell{
+		Color: widget.settings.common.Colors.Success,
+		Text:  fmt.Sprintf("%d%%↑", int(seedRatio*100)),
+		Value: seedRatio,
+	}
 }
 
+// torrentState returns the color of the torrent's name, which shows whether it is stopped,
+// downloading or seeding
 func (widget *Widget) torrentState(torrent *transmissionrpc.Torrent) string {
-	str := ""
+	colors := widget.settings.common.Colors
 
 	switch *torrent.Status {
 	case transmissionrpc.TorrentStatusStopped:
-		str += "[gray]"
+		return colors.Muted
 	case transmissionrpc.TorrentStatusDownload:
-		str += "[lightblue]"
+		return colors.Label
 	case transmissionrpc.TorrentStatusSeed:
-		str += "[green]"
+		return colors.Success
 	}
 
-	return str
+	return ""
 }
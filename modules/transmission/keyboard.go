@@ -7,6 +7,9 @@ func (widget *Widget) initializeKeyboardControls() {
 
 	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("f", widget.ShowFilter, "Filter torrents")
+	widget.SetKeyboardChar("s", widget.SortNext, "Sort by the next column")
+	widget.SetKeyboardChar("S", widget.SortReverse, "Reverse the sort order")
 	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
 
 	widget.SetKeyboardKey(tcell.KeyCtrlD, widget.deleteSelectedTorrent, "Delete the selected torrent")
@@ -12,20 +12,19 @@ import (
 // Widget is the container for transmission data
 type Widget struct {
 	view.KeyboardWidget
-	view.ScrollableWidget
+	view.TableWidget
 
 	client   *transmissionrpc.Client
 	settings *Settings
 	mu       sync.Mutex
 	torrents []*transmissionrpc.Torrent
-	err      error
 }
 
 // NewWidget creates a new instance of a widget
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
-		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
+		KeyboardWidget: view.NewKeyboardWidget(app, pages, settings.common),
+		TableWidget:    view.NewTableWidget(app, pages, settings.common, columns),
 
 		settings: settings,
 	}
@@ -73,18 +72,15 @@ func (widget *Widget) Fetch() ([]*transmissionrpc.Torrent, error) {
 // Refresh updates the data for this widget and displays it onscreen
 func (widget *Widget) Refresh() {
 	torrents, err := widget.Fetch()
-	count := 0
 
 	if err == nil {
-		count = len(torrents)
+		widget.mu.Lock()
+		widget.torrents = torrents
+		widget.SetRows(widget.torrentRows(torrents))
+		widget.mu.Unlock()
 	}
 
-	widget.mu.Lock()
-	widget.err = err
-	widget.torrents = torrents
-	widget.SetItemCount(count)
-	widget.mu.Unlock()
-
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -128,15 +124,12 @@ func buildClient(widget *Widget) {
 }
 
 func (widget *Widget) currentTorrent() *transmissionrpc.Torrent {
-	if len(widget.torrents) == 0 {
-		return nil
-	}
-
-	if len(widget.torrents) <= widget.Selected {
+	row := widget.SelectedRow()
+	if row == nil {
 		return nil
 	}
 
-	return widget.torrents[widget.Selected]
+	return row.Item.(*transmissionrpc.Torrent)
 }
 
 // deleteSelected removes the selected torrent from transmission
@@ -15,7 +15,6 @@ type Widget struct {
 
 	builds   *Builds
 	settings *Settings
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -43,16 +42,12 @@ func (widget *Widget) Refresh() {
 	}
 
 	builds, err := BuildsFor(widget.settings)
-
-	if err != nil {
-		widget.err = err
-		widget.builds = nil
-		widget.SetItemCount(0)
-	} else {
-		widget.err = nil
+	if err == nil {
 		widget.builds = builds
 		widget.SetItemCount(len(builds.Builds))
 	}
+
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -65,10 +60,8 @@ func (widget *Widget) Render() {
 func (widget *Widget) content() (string, string, bool) {
 	title := fmt.Sprintf("%s - Builds", widget.CommonSettings().Title)
 	var str string
-	if widget.err != nil {
-		str = widget.err.Error()
-	} else {
-		var rowFormat = "[%s] [%s] %s-%s (%s) [%s]%s - [blue]%s"
+	if widget.builds != nil {
+		var rowFormat = "[%s] [%s] %s-%s (%s) [%s]%s - [%s]%s"
 		if !widget.settings.compact {
 			rowFormat += "\n"
 		}
@@ -77,12 +70,13 @@ func (widget *Widget) content() (string, string, bool) {
 			row := fmt.Sprintf(
 				rowFormat,
 				widget.RowColor(idx),
-				buildColor(build),
+				widget.buildColor(build),
 				build.Repository.Name,
 				build.Number,
 				build.Branch.Name,
 				widget.RowColor(idx),
 				strings.Split(build.Commit.Message, "\n")[0],
+				widget.settings.common.Colors.Label,
 				build.CreatedBy.Login,
 			)
 			str += utils.HighlightableHelper(widget.View, row, idx, len(build.Branch.Name))
@@ -92,24 +86,26 @@ func (widget *Widget) content() (string, string, bool) {
 	return title, str, false
 }
 
-func buildColor(build Build) string {
+func (widget *Widget) buildColor(build Build) string {
+	colors := widget.settings.common.Colors
+
 	switch build.State {
 	case "broken":
-		return "red"
+		return colors.Error
 	case "failed":
-		return "red"
+		return colors.Error
 	case "failing":
-		return "red"
+		return colors.Error
 	case "pending":
-		return "yellow"
+		return colors.Warning
 	case "started":
-		return "yellow"
+		return colors.Warning
 	case "fixed":
-		return "green"
+		return colors.Success
 	case "passed":
-		return "green"
+		return colors.Success
 	default:
-		return "white"
+		return colors.Text
 	}
 }
 
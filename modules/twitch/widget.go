@@ -15,7 +15,6 @@ type Widget struct {
 	view.ScrollableWidget
 
 	settings   *Settings
-	err        error
 	twitch     *Twitch
 	topStreams []*Stream
 }
@@ -54,14 +53,13 @@ func (widget *Widget) Refresh() {
 		UserLogins: widget.settings.userLogins,
 	})
 
-	if err != nil {
-		handleError(widget, err)
-	} else if response.ErrorMessage != "" {
-		handleError(widget, errors.New(response.ErrorMessage))
-	} else {
+	if err == nil && response.ErrorMessage != "" {
+		err = errors.New(response.ErrorMessage)
+	}
+
+	if err == nil {
 		streams := makeStreams(response)
 		widget.topStreams = streams
-		widget.err = nil
 		if len(streams) <= widget.settings.numberOfResults {
 			widget.SetItemCount(len(widget.topStreams))
 		} else {
@@ -69,6 +67,8 @@ func (widget *Widget) Refresh() {
 			widget.SetItemCount(len(widget.topStreams))
 		}
 	}
+
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -90,20 +90,11 @@ func makeStreams(response *helix.StreamsResponse) []*Stream {
 	return streams
 }
 
-func handleError(widget *Widget, err error) {
-	widget.err = err
-	widget.topStreams = nil
-	widget.SetItemCount(0)
-}
-
 func (widget *Widget) content() (string, string, bool) {
 	var title = "Top Streams"
 	if widget.CommonSettings().Title != "" {
 		title = widget.CommonSettings().Title
 	}
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
 	if len(widget.topStreams) == 0 {
 		return title, "No data", false
 	}
@@ -111,9 +102,10 @@ func (widget *Widget) content() (string, string, bool) {
 
 	for idx, stream := range widget.topStreams {
 		row := fmt.Sprintf(
-			"[%s]%2d. [red]%s [white]%s",
+			"[%s]%2d. [%s]%s [-]%s",
 			widget.RowColor(idx),
 			idx+1,
+			widget.settings.common.Colors.Subheading,
 			utils.PrettyNumber(float64(stream.ViewerCount)),
 			stream.Streamer,
 		)
@@ -56,13 +56,8 @@ func NewClient(settings *Settings) *Client {
 /* -------------------- Public Functions -------------------- */
 
 // Tweets returns a list of tweets of a user
-func (client *Client) Tweets() []Tweet {
-	tweets, err := client.tweets()
-	if err != nil {
-		return []Tweet{}
-	}
-
-	return tweets
+func (client *Client) Tweets() ([]Tweet, error) {
+	return client.tweets()
 }
 
 /* -------------------- Private Functions -------------------- */
@@ -19,6 +19,7 @@ type Widget struct {
 	client   *Client
 	idx      int
 	settings *Settings
+	tweets   map[string][]Tweet
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -29,6 +30,7 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 		idx:      0,
 		settings: settings,
+		tweets:   map[string][]Tweet{},
 	}
 
 	widget.initializeKeyboardControls()
@@ -51,6 +53,15 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 // Refresh is called on the interval and refreshes the data
 func (widget *Widget) Refresh() {
+	source := widget.CurrentSource()
+
+	widget.client.screenName = source
+	tweets, err := widget.client.Tweets()
+	if err == nil {
+		widget.tweets[source] = tweets
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
@@ -61,13 +72,14 @@ func (widget *Widget) HelpText() string {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) content() (string, string, bool) {
-	widget.client.screenName = widget.CurrentSource()
-	tweets := widget.client.Tweets()
+	tweets := widget.tweets[widget.CurrentSource()]
+
+	colors := widget.settings.common.Colors
 
-	title := fmt.Sprintf("Twitter - [green]@%s[white]", widget.CurrentSource())
+	title := fmt.Sprintf("Twitter - [%s]@%s[-]", colors.Title, widget.CurrentSource())
 
 	if len(tweets) == 0 {
-		str := fmt.Sprintf("\n\n\n%s", utils.CenterText("[lightblue]No Tweets[white]", 50))
+		str := fmt.Sprintf("\n\n\n%s", utils.CenterText(fmt.Sprintf("[%s]No Tweets[-]", colors.Label), 50))
 		return title, str, true
 	}
 
@@ -91,25 +103,26 @@ func (widget *Widget) displayName(tweet Tweet) string {
 
 func (widget *Widget) formatText(text string) string {
 	result := text
+	colors := widget.settings.common.Colors
 
 	// Convert HTML entities
 	result = html.UnescapeString(result)
 
 	// RT indicator
 	rtRegExp := regexp.MustCompile(`^RT`)
-	result = rtRegExp.ReplaceAllString(result, "[olive]${0}[white::-]")
+	result = rtRegExp.ReplaceAllString(result, "["+colors.Muted+"]${0}[-::-]")
 
 	// @name mentions
 	atRegExp := regexp.MustCompile(`@[0-9A-Za-z_]*`)
-	result = atRegExp.ReplaceAllString(result, "[lightblue]${0}[white]")
+	result = atRegExp.ReplaceAllString(result, "["+colors.Label+"]${0}[-]")
 
 	// HTTP(S) links
 	linkRegExp := regexp.MustCompile(`http[s:\/.0-9A-Za-z]*`)
-	result = linkRegExp.ReplaceAllString(result, "[lightblue::u]${0}[white::-]")
+	result = linkRegExp.ReplaceAllString(result, "["+colors.Label+"::u]${0}[-::-]")
 
 	// Hash tags
 	hashRegExp := regexp.MustCompile(`#[0-9A-Za-z_]*`)
-	result = hashRegExp.ReplaceAllString(result, "[yellow]${0}[white]")
+	result = hashRegExp.ReplaceAllString(result, "["+colors.Accent+"]${0}[-]")
 
 	return result
 }
@@ -129,7 +142,7 @@ func (widget *Widget) format(tweet Tweet) string {
 		)
 	}
 
-	return fmt.Sprintf("%s\n[grey]%s[white]\n\n", body, attribution)
+	return fmt.Sprintf("%s\n[%s]%s[-]\n\n", body, widget.settings.common.Colors.Muted, attribution)
 }
 func (widget *Widget) currentSourceURI() string {
 
@@ -64,9 +64,9 @@ func NewClient(settings *Settings) *Client {
 	return &client
 }
 
-// GetStatsForUser Fetches stats for a single user.  If there is an error fetching or parsing the response
-// from the Twitter API, an empty stats struct will be returned.
-func (client *Client) GetStatsForUser(username string) TwitterStats {
+// GetStatsForUser Fetches stats for a single user, or returns the error from fetching or parsing
+// the response from the Twitter API
+func (client *Client) GetStatsForUser(username string) (TwitterStats, error) {
 	stats := TwitterStats{
 		FollowerCount: 0,
 		TweetCount:    0,
@@ -75,32 +75,35 @@ func (client *Client) GetStatsForUser(username string) TwitterStats {
 	url := fmt.Sprintf("%s?screen_name=%s", userTimelineURL, username)
 	resp, err := client.httpClient.Get(url)
 	if err != nil {
-		return stats
+		return stats, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return stats
+		return stats, err
 	}
 
-	// If there is an error while parsing, just discard the error and return the empty stats
 	err = json.Unmarshal(body, &stats)
 	if err != nil {
-		return stats
+		return stats, err
 	}
 
-	return stats
+	return stats, nil
 }
 
 // GetStats Returns a slice of `TwitterStats` structs for each username in `client.screenNames` in the same
 // order of `client.screenNames`
-func (client *Client) GetStats() []TwitterStats {
+func (client *Client) GetStats() ([]TwitterStats, error) {
 	stats := make([]TwitterStats, len(client.screenNames))
 
 	for i, username := range client.screenNames {
-		stats[i] = client.GetStatsForUser(username)
+		userStats, err := client.GetStatsForUser(username)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", username, err)
+		}
+		stats[i] = userStats
 	}
 
-	return stats
+	return stats, nil
 }
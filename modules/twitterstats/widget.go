@@ -12,6 +12,7 @@ type Widget struct {
 
 	client   *Client
 	settings *Settings
+	stats    []TwitterStats
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -30,28 +31,32 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 }
 
 func (widget *Widget) Refresh() {
+	stats, err := widget.client.GetStats()
+	if err == nil {
+		widget.stats = stats
+	}
+
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 func (widget *Widget) content() (string, string, bool) {
 	// Add header row
 	str := fmt.Sprintf(
-		"[%s]%-12s %10s %8s[white]\n",
+		"[%s]%-12s %10s %8s[-]\n",
 		widget.settings.common.Colors.Subheading,
 		"Username",
 		"Followers",
 		"Tweets",
 	)
 
-	stats := widget.client.GetStats()
-
 	// Add rows for each of the followed usernames
-	for i, username := range widget.client.screenNames {
+	for i, stats := range widget.stats {
 		str += fmt.Sprintf(
 			"%-12s %10d %8d\n",
-			username,
-			stats[i].FollowerCount,
-			stats[i].TweetCount,
+			widget.client.screenNames[i],
+			stats.FollowerCount,
+			stats.TweetCount,
 		)
 	}
 
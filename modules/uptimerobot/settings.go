@@ -5,6 +5,7 @@ import (
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/view"
 )
 
 const (
@@ -18,6 +19,9 @@ type Settings struct {
 	apiKey        string `help:"An UptimeRobot API key."`
 	uptimePeriods string `help:"The periods over which to display uptime (in days, dash-separated)." optional:"true"`
 	offlineFirst  bool   `help:"Display offline monitors at the top." optional:"true"`
+
+	chartLength int    `help:"How many recent response times the chart of each monitor shows. 0 hides the charts." optional:"true"`
+	chartStyle  string `help:"How the response time charts are drawn: blocks or braille." optional:"true"`
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -28,8 +32,13 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		apiKey:        ymlConfig.UString("apiKey", os.Getenv("WTF_UPTIMEROBOT_APIKEY")),
 		uptimePeriods: ymlConfig.UString("uptimePeriods", "30"),
 		offlineFirst:  ymlConfig.UBool("offlineFirst", false),
+
+		chartLength: ymlConfig.UInt("chartLength", 20),
+		chartStyle:  ymlConfig.UString("chartStyle", view.SparklineBlocks),
 	}
 
+	settings.common.ValidateSetting("chartStyle", view.ValidateSparklineStyle(settings.chartStyle))
+
 	cfg.ModuleSecret(name, globalConfig, &settings.apiKey).
 		Service("https://api.uptimerobot.com").Load()
 
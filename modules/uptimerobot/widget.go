@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/rules"
 	"github.com/wtfutil/wtf/view"
 )
 
@@ -19,7 +23,6 @@ type Widget struct {
 
 	monitors []Monitor
 	settings *Settings
-	err      error
 }
 
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
@@ -63,10 +66,13 @@ func (widget *Widget) Refresh() {
 		}
 	}
 
-	widget.monitors = monitors
-	widget.err = err
-	widget.SetItemCount(len(monitors))
+	if err == nil {
+		widget.monitors = monitors
+		widget.SetItemCount(len(monitors))
+		widget.SetValues(valuesFrom(monitors))
+	}
 
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -87,10 +93,6 @@ func (widget *Widget) content() (string, string, bool) {
 
 	title := fmt.Sprintf("UptimeRobot (%d/%d)", numUp, len(widget.monitors))
 
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
-
 	if widget.monitors == nil {
 		return title, "No monitors to display", false
 	}
@@ -103,30 +105,94 @@ func (widget *Widget) content() (string, string, bool) {
 func (widget *Widget) contentFrom(monitors []Monitor) string {
 	var str string
 
+	colors := widget.settings.common.Colors
+
 	for _, monitor := range monitors {
 		prefix := ""
 
 		switch monitor.State {
 		case 2:
-			prefix += "[green] + "
+			prefix += "[" + colors.Success + "] + "
 		case 8:
 		case 9:
-			prefix += "[red] - "
+			prefix += "[" + colors.Error + "] - "
 		default:
-			prefix += "[yellow] ~ "
+			prefix += "[" + colors.Warning + "] ~ "
 		}
 
-		str += fmt.Sprintf(`%s%s [gray](%s)[white]
+		str += fmt.Sprintf(`%s%s [%s](%s)%s[-]
 `,
 			prefix,
 			monitor.Name,
+			colors.Muted,
 			formatUptimes(monitor.Uptime),
+			widget.responseTimes(monitor),
 		)
 	}
 
 	return str
 }
 
+// valuesFrom returns the values rules can be written against: up, down, the number of
+// monitors in each state, uptime, the lowest uptime ratio of any monitor over the first
+// uptime period, and uptime.<monitor name>, the uptime ratio of each monitor
+func valuesFrom(monitors []Monitor) map[string]interface{} {
+	up := 0
+	down := 0
+	lowest := 100.0
+
+	values := map[string]interface{}{}
+
+	for _, monitor := range monitors {
+		switch monitor.State {
+		case 2:
+			up++
+		case 8, 9:
+			down++
+		}
+
+		ratio, err := strconv.ParseFloat(strings.Split(monitor.Uptime, "-")[0], 64)
+		if err != nil {
+			continue
+		}
+
+		values[rules.ValueName("uptime", monitor.Name)] = ratio
+		lowest = math.Min(lowest, ratio)
+	}
+
+	values["up"] = up
+	values["down"] = down
+	values["uptime"] = lowest
+
+	return values
+}
+
+// responseTimes returns a chart of the monitor's recent response times, and their range and
+// average, or an empty string if there are none
+func (widget *Widget) responseTimes(monitor Monitor) string {
+	if widget.settings.chartLength <= 0 || len(monitor.ResponseTimes) == 0 {
+		return ""
+	}
+
+	responseTimes := append([]ResponseTime{}, monitor.ResponseTimes...)
+	sort.Slice(responseTimes, func(i, j int) bool {
+		return responseTimes[i].Datetime < responseTimes[j].Datetime
+	})
+
+	sparkline := view.NewSparkline(widget.settings.chartLength)
+	for _, responseTime := range responseTimes {
+		sparkline.Add(responseTime.Value)
+	}
+
+	width := view.SparklineWidth(widget.settings.chartStyle, widget.settings.chartLength)
+
+	return fmt.Sprintf(
+		" %s %s",
+		sparkline.Render(widget.settings.chartStyle, width, 1),
+		sparkline.Summary("%.0fms"),
+	)
+}
+
 func formatUptimes(str string) string {
 	splits := strings.Split(str, "-")
 	str = ""
@@ -148,17 +214,30 @@ type Monitor struct {
 	State int8 `json:"status"`
 	// Uptime ratio, preformatted, e.g.: 100.000-97.233-96.975
 	Uptime string `json:"custom_uptime_ratio"`
+	// The most recent response times, only requested when they're charted
+	ResponseTimes []ResponseTime `json:"response_times"`
+}
+
+// ResponseTime is how long, in milliseconds, a monitor's check took at a point in time
+type ResponseTime struct {
+	Datetime int64   `json:"datetime"`
+	Value    float64 `json:"value"`
 }
 
 func (widget *Widget) getMonitors() ([]Monitor, error) {
 	// See: https://uptimerobot.com/api/#getMonitorsWrap
-	resp, errh := http.PostForm("https://api.uptimerobot.com/v2/getMonitors",
-		url.Values{
-			"api_key":              {widget.settings.apiKey},
-			"format":               {"json"},
-			"custom_uptime_ratios": {widget.settings.uptimePeriods},
-		},
-	)
+	params := url.Values{
+		"api_key":              {widget.settings.apiKey},
+		"format":               {"json"},
+		"custom_uptime_ratios": {widget.settings.uptimePeriods},
+	}
+
+	if widget.settings.chartLength > 0 {
+		params.Set("response_times", "1")
+		params.Set("response_times_limit", strconv.Itoa(widget.settings.chartLength))
+	}
+
+	resp, errh := http.PostForm("https://api.uptimerobot.com/v2/getMonitors", params)
 
 	if errh != nil {
 		return nil, errh
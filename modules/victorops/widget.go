@@ -13,7 +13,6 @@ type Widget struct {
 
 	teams    []OnCallTeam
 	settings *Settings
-	err      error
 }
 
 // NewWidget creates a new widget
@@ -36,18 +35,16 @@ func (widget *Widget) Refresh() {
 	}
 
 	teams, err := Fetch(widget.settings.apiID, widget.settings.apiKey)
+	if err == nil {
+		widget.teams = teams
+	}
 
-	widget.err = err
-	widget.teams = teams
-
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
-	if widget.err != nil {
-		return title, widget.err.Error(), true
-	}
 	teams := widget.teams
 	var str string
 
@@ -60,12 +57,12 @@ func (widget *Widget) content() (string, string, bool) {
 			continue
 		}
 
-		str = fmt.Sprintf("%s[green]%s\n", str, team.Name)
+		str = fmt.Sprintf("%s[%s]%s\n", str, widget.settings.common.Colors.Title, team.Name)
 		if len(team.OnCall) == 0 {
-			str = fmt.Sprintf("%s[grey]no one\n", str)
+			str = fmt.Sprintf("%s[%s]no one\n", str, widget.settings.common.Colors.Muted)
 		}
 		for _, onCall := range team.OnCall {
-			str = fmt.Sprintf("%s[white]%s - %s\n", str, onCall.Policy, onCall.Userlist)
+			str = fmt.Sprintf("%s[-]%s - %s\n", str, onCall.Policy, onCall.Userlist)
 		}
 
 		str = fmt.Sprintf("%s\n", str)
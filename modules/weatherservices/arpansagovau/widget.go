@@ -4,22 +4,19 @@ import (
 	"fmt"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/view"
 )
 
 type Widget struct {
 	view.TextWidget
-	location  *location
-	lastError error
-	settings  *Settings
+	location *location
+	settings *Settings
 }
 
 func NewWidget(app *tview.Application, settings *Settings) *Widget {
-	locationData, err := GetLocationData(settings.city)
 	widget := Widget{
 		TextWidget: view.NewTextWidget(app, settings.common),
-		location:   locationData,
-		lastError:  err,
 		settings:   settings,
 	}
 
@@ -29,34 +26,36 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-
-	locationData, err := GetLocationData(widget.settings.city)
-	widget.location = locationData
-	widget.lastError = err
-
-	if widget.lastError != nil {
-		return widget.CommonSettings().Title, fmt.Sprintf("Err: %s", widget.lastError.Error()), true
+	if widget.location == nil {
+		return widget.CommonSettings().Title, "", true
 	}
 
-	return widget.CommonSettings().Title, formatLocationData(widget.location), true
+	return widget.CommonSettings().Title, formatLocationData(widget.location, widget.settings.common.Colors), true
 }
 
 func (widget *Widget) Refresh() {
+	locationData, err := GetLocationData(widget.settings.city)
+	if err == nil {
+		widget.location = locationData
+	}
 
+	widget.SetRefreshError(err)
 	widget.Redraw(widget.content)
 }
 
-func formatLocationData(location *location) string {
+// formatLocationData colors the UV index using the standard UV index color scale, which is
+// the same whatever the theme
+func formatLocationData(location *location, colors cfg.ColorTheme) string {
 	var level string
 	var color string
 	var content string
 
 	if location.name == "" {
-		return "[red]No data?"
+		return "[" + colors.Error + "]No data?"
 	}
 
 	if location.status != "ok" {
-		content = "[red]Data unavailable for "
+		content = "[" + colors.Error + "]Data unavailable for "
 		content += location.name
 		return content
 	}
@@ -85,7 +84,7 @@ func formatLocationData(location *location) string {
 	content += color
 	content += fmt.Sprintf("%.2f", location.index)
 	content += level
-	content += "[white]\nLocal time: "
+	content += "[-]\nLocal time: "
 	content += location.time
 	content += " "
 	content += location.date
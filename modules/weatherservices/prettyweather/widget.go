@@ -28,13 +28,14 @@ func NewWidget(app *tview.Application, settings *Settings) *Widget {
 }
 
 func (widget *Widget) Refresh() {
-	widget.prettyWeather()
+	err := widget.prettyWeather()
 
+	widget.SetRefreshError(err)
 	widget.Redraw(func() (string, string, bool) { return widget.CommonSettings().Title, widget.result, false })
 }
 
 //this method reads the config and calls wttr.in for pretty weather
-func (widget *Widget) prettyWeather() {
+func (widget *Widget) prettyWeather() error {
 	client := &http.Client{}
 
 	city := widget.settings.city
@@ -43,25 +44,23 @@ func (widget *Widget) prettyWeather() {
 
 	req, err := http.NewRequest("GET", "https://wttr.in/"+city+"?"+view+"?"+unit, nil)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 
 	req.Header.Set("Accept-Language", widget.settings.language)
 	req.Header.Set("User-Agent", "curl")
 	response, err := client.Do(req)
 	if err != nil {
-		widget.result = err.Error()
-		return
-
+		return err
 	}
 	defer func() { _ = response.Body.Close() }()
 
 	contents, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		widget.result = err.Error()
-		return
+		return err
 	}
 
 	widget.result = strings.TrimSpace(wtf.ASCIItoTviewColors(string(contents)))
+
+	return nil
 }
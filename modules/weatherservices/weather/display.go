@@ -68,7 +68,7 @@ func (widget *Widget) temperatures(cityData *owm.CurrentWeatherData) string {
 	str := fmt.Sprintf("%8s: %4.1f° %s\n", "High", cityData.Main.TempMax, widget.settings.tempUnit)
 
 	str += fmt.Sprintf(
-		"%8s: [%s]%4.1f° %s[white]\n",
+		"%8s: [%s]%4.1f° %s[-]\n",
 		"Current",
 		widget.settings.colors.current,
 		cityData.Main.Temp,
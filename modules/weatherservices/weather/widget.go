@@ -1,6 +1,8 @@
 package weather
 
 import (
+	"fmt"
+
 	owm "github.com/briandowns/openweathermap"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
@@ -45,27 +47,35 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 // Fetch retrieves OpenWeatherMap data from the OpenWeatherMap API.
 // It takes a list of OpenWeatherMap city IDs.
-// It returns a list of OpenWeatherMap CurrentWeatherData structs, one per valid city code.
-func (widget *Widget) Fetch(cityIDs []int) []*owm.CurrentWeatherData {
+// It returns a list of OpenWeatherMap CurrentWeatherData structs, one per city code, or the
+// error from the first city that couldn't be fetched.
+func (widget *Widget) Fetch(cityIDs []int) ([]*owm.CurrentWeatherData, error) {
 	data := []*owm.CurrentWeatherData{}
 
 	for _, cityID := range cityIDs {
 		result, err := widget.currentWeather(cityID)
-		if err == nil {
-			data = append(data, result)
+		if err != nil {
+			return nil, fmt.Errorf("city %d: %w", cityID, err)
 		}
+		data = append(data, result)
 	}
 
-	return data
+	return data, nil
 }
 
 // Refresh fetches new data from the OpenWeatherMap API and loads the new data into the.
 // widget's view for rendering
 func (widget *Widget) Refresh() {
+	var err error
 	if widget.apiKeyValid() {
-		widget.Data = widget.Fetch(utils.ToInts(widget.settings.cityIDs))
+		var data []*owm.CurrentWeatherData
+		data, err = widget.Fetch(utils.ToInts(widget.settings.cityIDs))
+		if err == nil {
+			widget.Data = data
+		}
 	}
 
+	widget.SetRefreshError(err)
 	widget.display()
 }
 
@@ -15,7 +15,6 @@ type Widget struct {
 
 	result   *TicketArray
 	settings *Settings
-	err      error
 }
 
 // NewWidget creates a new instance of a widget
@@ -40,9 +39,12 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 func (widget *Widget) Refresh() {
 	ticketArray, err := widget.newTickets()
-	ticketArray.Count = len(ticketArray.Tickets)
-	widget.err = err
-	widget.result = ticketArray
+	if err == nil {
+		ticketArray.Count = len(ticketArray.Tickets)
+		widget.result = ticketArray
+	}
+
+	widget.SetRefreshError(err)
 	widget.Render()
 }
 
@@ -53,11 +55,12 @@ func (widget *Widget) Render() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
-	title := fmt.Sprintf("%s (%d)", widget.CommonSettings().Title, widget.result.Count)
-	if widget.err != nil {
-		return title, widget.err.Error(), true
+	if widget.result == nil {
+		return widget.CommonSettings().Title, "", false
 	}
 
+	title := fmt.Sprintf("%s (%d)", widget.CommonSettings().Title, widget.result.Count)
+
 	items := widget.result.Tickets
 	if len(items) == 0 {
 		return title, "No unassigned tickets in queue - woop!!", false
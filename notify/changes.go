@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"sync"
+)
+
+// Changes remembers the items a widget displayed after its last refresh so that it can tell
+// which items are new after the next one, such as a newly-opened incident. Nothing is new
+// after the first refresh, so that everything already there when the app starts isn't
+// announced
+type Changes struct {
+	mutex  sync.Mutex
+	primed bool
+	seen   map[string]bool
+}
+
+// NewChanges creates and returns an empty Changes
+func NewChanges() *Changes {
+	return &Changes{seen: map[string]bool{}}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Added records the keys of the items currently displayed and returns those that were not
+// displayed last time, in the order given. Items that disappear and then reappear are new again
+func (changes *Changes) Added(keys []string) []string {
+	changes.mutex.Lock()
+	defer changes.mutex.Unlock()
+
+	added := []string{}
+	current := map[string]bool{}
+
+	for _, key := range keys {
+		if changes.primed && !changes.seen[key] && !current[key] {
+			added = append(added, key)
+		}
+
+		current[key] = true
+	}
+
+	changes.primed = true
+	changes.seen = current
+
+	return added
+}
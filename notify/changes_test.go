@@ -0,0 +1,16 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Changes_Added(t *testing.T) {
+	changes := NewChanges()
+
+	assert.Equal(t, []string{}, changes.Added([]string{"a", "b"}))
+	assert.Equal(t, []string{"c"}, changes.Added([]string{"a", "b", "c"}))
+	assert.Equal(t, []string{}, changes.Added([]string{"c"}))
+	assert.Equal(t, []string{"a", "d"}, changes.Added([]string{"a", "c", "d", "d"}))
+}
@@ -0,0 +1,205 @@
+package notify
+
+import (
+	"sync"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/logger"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	// SinkBell rings the terminal bell
+	SinkBell = "bell"
+	// SinkCommand runs the command defined in the config
+	SinkCommand = "command"
+	// SinkTitle flashes the title of the widget that raised the notification
+	SinkTitle = "title"
+	// SinkWebhook posts the notification as JSON to the URL defined in the config
+	SinkWebhook = "webhook"
+)
+
+var (
+	defaultNotifier *Notifier
+	defaultMutex    = &sync.RWMutex{}
+)
+
+// Event is something a widget has noticed change that the user may want to be told about,
+// such as a new incident or a failed build
+type Event struct {
+	Module  string    `json:"module"`
+	Kind    string    `json:"kind"`
+	Title   string    `json:"title"`
+	Message string    `json:"message"`
+	URL     string    `json:"url,omitempty"`
+	At      time.Time `json:"at"`
+}
+
+// Sink delivers notifications to the user
+type Sink interface {
+	Send(event Event) error
+}
+
+// SinkFunc is a function that can be used as a Sink
+type SinkFunc func(event Event) error
+
+// Send calls the function with the event
+func (fn SinkFunc) Send(event Event) error {
+	return fn(event)
+}
+
+// Notifier decides which of the events raised by widgets the user is notified of, and
+// delivers them to the configured sinks:
+//
+//	wtf:
+//	  notifications:
+//	    enabled: true
+//	    sinks: [bell, title]      <- the sinks used for every module without its own
+//	    command: ["notify-send", "{title}", "{message}"]
+//	    webhook: "https://hooks.example.com/wtf"
+//	    quietHours:               <- no notifications are sent between these times
+//	      start: "22:00"
+//	      end: "07:00"
+//	  mods:
+//	    pagerduty:
+//	      notify:
+//	        events: [incident]    <- only these kinds of event are sent. Defaults to all
+//	        sinks: [bell, command, webhook]
+//	        ignoreQuietHours: true
+//
+// The command's arguments can contain the placeholders {module}, {kind}, {title}, {message}
+// and {url}, which are replaced with the values from the event
+type Notifier struct {
+	enabled    bool
+	now        func() time.Time
+	quietHours *QuietHours
+	rules      map[string]*Rule
+	sinkNames  []string
+	sinks      map[string]Sink
+}
+
+// NewNotifier creates and returns a Notifier configured by the notification settings and
+// module rules in the config
+func NewNotifier(config *config.Config) *Notifier {
+	notifier := Notifier{
+		enabled:   config.UBool("wtf.notifications.enabled", false),
+		now:       time.Now,
+		rules:     map[string]*Rule{},
+		sinkNames: utils.ToStrs(config.UList("wtf.notifications.sinks", []interface{}{SinkBell, SinkTitle})),
+		sinks:     map[string]Sink{},
+	}
+
+	if quietConfig, err := config.Get("wtf.notifications.quietHours"); err == nil {
+		notifier.quietHours = NewQuietHoursFromYAML(quietConfig)
+	}
+
+	if command := utils.ToStrs(config.UList("wtf.notifications.command")); len(command) > 0 {
+		notifier.sinks[SinkCommand] = NewCommandSink(command)
+	}
+
+	if url := config.UString("wtf.notifications.webhook"); url != "" {
+		notifier.sinks[SinkWebhook] = NewWebhookSink(url)
+	}
+
+	mods, _ := config.Map("wtf.mods")
+	for name := range mods {
+		ruleConfig, err := config.Get("wtf.mods." + name + ".notify")
+		if err != nil {
+			continue
+		}
+
+		notifier.rules[name] = NewRuleFromYAML(ruleConfig)
+	}
+
+	return &notifier
+}
+
+// SetDefault sets the notifier that events raised with Notify are sent to
+func SetDefault(notifier *Notifier) {
+	defaultMutex.Lock()
+	defer defaultMutex.Unlock()
+
+	defaultNotifier = notifier
+}
+
+// Notify sends the event to the default notifier, if there is one, in the background so
+// that the widget raising it is not held up by slow sinks
+func Notify(event Event) {
+	defaultMutex.RLock()
+	notifier := defaultNotifier
+	defaultMutex.RUnlock()
+
+	if notifier == nil {
+		return
+	}
+
+	go notifier.Notify(event)
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// AddSink registers a sink under the given name, replacing any sink already registered
+// with that name. Used for the sinks that need the running app, such as the terminal bell
+func (notifier *Notifier) AddSink(name string, sink Sink) {
+	notifier.sinks[name] = sink
+}
+
+// Notify sends the event to every sink it should go to, according to the module's rule and
+// the quiet hours. Returns the names of the sinks that delivered it
+func (notifier *Notifier) Notify(event Event) []string {
+	if !notifier.enabled {
+		return []string{}
+	}
+
+	if event.At.IsZero() {
+		event.At = notifier.now()
+	}
+
+	rule := notifier.rules[event.Module]
+	if rule == nil {
+		rule = &Rule{Enabled: true}
+	}
+
+	if !rule.Allows(event.Kind) {
+		return []string{}
+	}
+
+	if !rule.IgnoreQuietHours && notifier.quietHoursFor(rule).Includes(event.At) {
+		return []string{}
+	}
+
+	sinkNames := notifier.sinkNames
+	if rule.Sinks != nil {
+		sinkNames = rule.Sinks
+	}
+
+	sent := []string{}
+	for _, name := range sinkNames {
+		sink, ok := notifier.sinks[name]
+		if !ok {
+			continue
+		}
+
+		if err := sink.Send(event); err != nil {
+			logger.Errorf(event.Module, "Notification sink %s failed: %s", name, err.Error())
+			continue
+		}
+
+		sent = append(sent, name)
+	}
+
+	return sent
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// quietHoursFor returns the quiet hours that apply to the rule's module, which override the
+// global ones if it defines its own
+func (notifier *Notifier) quietHoursFor(rule *Rule) *QuietHours {
+	if rule.QuietHours != nil {
+		return rule.QuietHours
+	}
+
+	return notifier.quietHours
+}
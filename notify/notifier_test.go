@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const notifierConfig = `
+wtf:
+  notifications:
+    enabled: true
+    sinks: [bell, title]
+    quietHours:
+      start: "22:00"
+      end: "07:00"
+  mods:
+    pagerduty:
+      notify:
+        sinks: [bell, webhook]
+        ignoreQuietHours: true
+    jenkins:
+      notify:
+        events: [build-failed]
+    github:
+      notify:
+        enabled: false
+    clocks:
+      enabled: true`
+
+func Test_Notifier_Notify(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    Event
+		at       string
+		expected []string
+	}{
+		{name: "default sinks", event: Event{Module: "clocks", Kind: "tick"}, at: "12:00", expected: []string{"bell", "title"}},
+		{name: "module sinks", event: Event{Module: "pagerduty", Kind: "incident"}, at: "12:00", expected: []string{"bell", "webhook"}},
+		{name: "allowed event", event: Event{Module: "jenkins", Kind: "build-failed"}, at: "12:00", expected: []string{"bell", "title"}},
+		{name: "filtered event", event: Event{Module: "jenkins", Kind: "build-fixed"}, at: "12:00", expected: []string{}},
+		{name: "disabled module", event: Event{Module: "github", Kind: "review-request"}, at: "12:00", expected: []string{}},
+		{name: "quiet hours", event: Event{Module: "clocks", Kind: "tick"}, at: "23:00", expected: []string{}},
+		{name: "ignoring quiet hours", event: Event{Module: "pagerduty", Kind: "incident"}, at: "23:00", expected: []string{"bell", "webhook"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wtfConfig, err := config.ParseYaml(notifierConfig)
+			assert.NoError(t, err)
+
+			notifier := NewNotifier(wtfConfig)
+
+			at, _ := time.Parse(quietHoursLayout, tt.at)
+			notifier.now = func() time.Time { return at }
+
+			received := []Event{}
+			recorder := SinkFunc(func(event Event) error {
+				received = append(received, event)
+				return nil
+			})
+
+			for _, name := range []string{SinkBell, SinkTitle, SinkWebhook} {
+				notifier.AddSink(name, recorder)
+			}
+
+			assert.Equal(t, tt.expected, notifier.Notify(tt.event))
+			assert.Len(t, received, len(tt.expected))
+
+			for _, event := range received {
+				assert.Equal(t, at, event.At)
+			}
+		})
+	}
+}
+
+func Test_Notifier_Disabled(t *testing.T) {
+	wtfConfig, _ := config.ParseYaml("wtf:\n  notifications:\n    enabled: false")
+
+	notifier := NewNotifier(wtfConfig)
+	notifier.AddSink(SinkBell, SinkFunc(func(Event) error { return nil }))
+
+	assert.Equal(t, []string{}, notifier.Notify(Event{Module: "clocks"}))
+}
@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	quietHoursLayout = "15:04"
+)
+
+// Rule decides which of a module's events the user is notified of, and how
+type Rule struct {
+	Enabled          bool
+	Events           []string
+	IgnoreQuietHours bool
+	QuietHours       *QuietHours
+	Sinks            []string
+}
+
+// QuietHours is a daily period during which no notifications are sent. A period whose end
+// is before its start runs overnight
+type QuietHours struct {
+	End   time.Duration
+	Start time.Duration
+}
+
+// NewRuleFromYAML creates and returns the Rule defined by a module's notify settings
+func NewRuleFromYAML(ruleConfig *config.Config) *Rule {
+	rule := Rule{
+		Enabled:          ruleConfig.UBool("enabled", true),
+		Events:           utils.ToStrs(ruleConfig.UList("events")),
+		IgnoreQuietHours: ruleConfig.UBool("ignoreQuietHours", false),
+	}
+
+	if _, err := ruleConfig.Get("sinks"); err == nil {
+		rule.Sinks = utils.ToStrs(ruleConfig.UList("sinks"))
+	}
+
+	if quietConfig, err := ruleConfig.Get("quietHours"); err == nil {
+		rule.QuietHours = NewQuietHoursFromYAML(quietConfig)
+	}
+
+	return &rule
+}
+
+// NewQuietHoursFromYAML creates and returns the QuietHours defined by start and end times
+// of day, such as "22:00". Returns nil if either time is missing or invalid
+func NewQuietHoursFromYAML(quietConfig *config.Config) *QuietHours {
+	start, err := time.Parse(quietHoursLayout, quietConfig.UString("start"))
+	if err != nil {
+		return nil
+	}
+
+	end, err := time.Parse(quietHoursLayout, quietConfig.UString("end"))
+	if err != nil {
+		return nil
+	}
+
+	return &QuietHours{
+		End:   sinceMidnight(end),
+		Start: sinceMidnight(start),
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Allows returns TRUE if events of the given kind should be sent
+func (rule *Rule) Allows(kind string) bool {
+	if !rule.Enabled {
+		return false
+	}
+
+	return len(rule.Events) == 0 || utils.Includes(rule.Events, kind)
+}
+
+// Includes returns TRUE if the given time falls within the quiet hours
+func (quietHours *QuietHours) Includes(at time.Time) bool {
+	if quietHours == nil || quietHours.Start == quietHours.End {
+		return false
+	}
+
+	timeOfDay := sinceMidnight(at)
+
+	if quietHours.Start < quietHours.End {
+		return timeOfDay >= quietHours.Start && timeOfDay < quietHours.End
+	}
+
+	return timeOfDay >= quietHours.Start || timeOfDay < quietHours.End
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func sinceMidnight(at time.Time) time.Duration {
+	return time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute
+}
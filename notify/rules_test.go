@@ -0,0 +1,74 @@
+package notify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Rule_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		yaml     string
+		kind     string
+		expected bool
+	}{
+		{name: "all events by default", yaml: "sinks: [bell]", kind: "incident", expected: true},
+		{name: "listed event", yaml: "events: [incident]", kind: "incident", expected: true},
+		{name: "unlisted event", yaml: "events: [incident]", kind: "build-failed", expected: false},
+		{name: "disabled", yaml: "enabled: false", kind: "incident", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleConfig, err := config.ParseYaml(tt.yaml)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expected, NewRuleFromYAML(ruleConfig).Allows(tt.kind))
+		})
+	}
+}
+
+func Test_NewRuleFromYAML_Sinks(t *testing.T) {
+	ruleConfig, _ := config.ParseYaml("events: [incident]")
+	assert.Nil(t, NewRuleFromYAML(ruleConfig).Sinks)
+
+	ruleConfig, _ = config.ParseYaml("sinks: []")
+	assert.Equal(t, []string{}, NewRuleFromYAML(ruleConfig).Sinks)
+}
+
+func Test_QuietHours_Includes(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    string
+		end      string
+		at       string
+		expected bool
+	}{
+		{name: "during the day", start: "12:00", end: "14:00", at: "13:30", expected: true},
+		{name: "at the end", start: "12:00", end: "14:00", at: "14:00", expected: false},
+		{name: "outside the day", start: "12:00", end: "14:00", at: "09:00", expected: false},
+		{name: "overnight before midnight", start: "22:00", end: "07:00", at: "23:15", expected: true},
+		{name: "overnight after midnight", start: "22:00", end: "07:00", at: "06:59", expected: true},
+		{name: "outside overnight", start: "22:00", end: "07:00", at: "12:00", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quietConfig, _ := config.ParseYaml("start: \"" + tt.start + "\"\nend: \"" + tt.end + "\"")
+			at, _ := time.Parse(quietHoursLayout, tt.at)
+
+			assert.Equal(t, tt.expected, NewQuietHoursFromYAML(quietConfig).Includes(at))
+		})
+	}
+}
+
+func Test_NewQuietHoursFromYAML_Invalid(t *testing.T) {
+	quietConfig, _ := config.ParseYaml("start: late\nend: \"07:00\"")
+
+	var quietHours *QuietHours = NewQuietHoursFromYAML(quietConfig)
+	assert.Nil(t, quietHours)
+	assert.False(t, quietHours.Includes(time.Now()))
+}
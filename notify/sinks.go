@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	// sinkTimeout is how long a command or webhook has to deliver a notification
+	sinkTimeout = 10 * time.Second
+)
+
+// CommandSink delivers notifications by running a command, such as notify-send
+type CommandSink struct {
+	command []string
+}
+
+// WebhookSink delivers notifications by posting them as JSON to a URL
+type WebhookSink struct {
+	client *http.Client
+	url    string
+}
+
+// NewCommandSink creates and returns a CommandSink that runs the given command and arguments
+func NewCommandSink(command []string) *CommandSink {
+	return &CommandSink{command: command}
+}
+
+// NewWebhookSink creates and returns a WebhookSink that posts to the given URL
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		client: &http.Client{Timeout: sinkTimeout},
+		url:    url,
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Send runs the command with the event's values in place of the placeholders in its
+// arguments. The values are also available to the command as WTF_NOTIFY_* environment variables
+func (sink *CommandSink) Send(event Event) error {
+	if len(sink.command) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sinkTimeout)
+	defer cancel()
+
+	args := expandPlaceholders(sink.command, event)
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = append(
+		os.Environ(),
+		"WTF_NOTIFY_MODULE="+event.Module,
+		"WTF_NOTIFY_KIND="+event.Kind,
+		"WTF_NOTIFY_TITLE="+event.Title,
+		"WTF_NOTIFY_MESSAGE="+event.Message,
+		"WTF_NOTIFY_URL="+event.URL,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s: %s", err.Error(), strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// Send posts the event as JSON to the webhook's URL
+func (sink *WebhookSink) Send(event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	resp, err := sink.client.Post(sink.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s responded with %s", sink.url, resp.Status)
+	}
+
+	return nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func expandPlaceholders(args []string, event Event) []string {
+	replacer := strings.NewReplacer(
+		"{module}", event.Module,
+		"{kind}", event.Kind,
+		"{title}", event.Title,
+		"{message}", event.Message,
+		"{url}", event.URL,
+	)
+
+	expanded := make([]string, len(args))
+	for i, arg := range args {
+		expanded[i] = replacer.Replace(arg)
+	}
+
+	return expanded
+}
@@ -0,0 +1,40 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_expandPlaceholders(t *testing.T) {
+	event := Event{Module: "pagerduty", Kind: "incident", Title: "New incident", Message: "Disk full", URL: "https://example.com"}
+
+	expanded := expandPlaceholders([]string{"notify-send", "{module}: {title}", "{message} {url}", "{kind}"}, event)
+
+	assert.Equal(t, []string{"notify-send", "pagerduty: New incident", "Disk full https://example.com", "incident"}, expanded)
+}
+
+func Test_WebhookSink_Send(t *testing.T) {
+	received := Event{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL).Send(Event{Module: "jenkins", Kind: "build-failed", Title: "Job failed"})
+	assert.NoError(t, err)
+	assert.Equal(t, "jenkins", received.Module)
+	assert.Equal(t, "Job failed", received.Title)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	assert.Error(t, NewWebhookSink(failing.URL).Send(Event{}))
+}
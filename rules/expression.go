@@ -0,0 +1,407 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is a condition over a widget's values, such as:
+//
+//	cpu > 90
+//	uptime < 99.5 && down > 0
+//	status == "failed" || !(AAPL.change >= -2)
+//
+// Values are referred to by name, and compared to numbers, "quoted strings", true, false, or
+// other values with ==, !=, <, <=, > and >=. Conditions are combined with &&, || and !, and
+// grouped with parentheses. A name on its own is true if its value is true, a non-zero
+// number, or a non-empty string. Any comparison with a value the widget does not have is false
+type Expression struct {
+	source string
+	root   node
+}
+
+type node interface {
+	eval(values map[string]interface{}) interface{}
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type parser struct {
+	pos    int
+	tokens []token
+}
+
+type andNode struct{ left, right node }
+type compareNode struct {
+	op          string
+	left, right node
+}
+type literalNode struct{ value interface{} }
+type notNode struct{ operand node }
+type orNode struct{ left, right node }
+type valueNode struct{ name string }
+
+// ParseExpression parses and returns the expression in source
+func ParseExpression(source string) (*Expression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEnd {
+		return nil, fmt.Errorf("unexpected %q at position %d", next.text, next.pos+1)
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Matches returns TRUE if the expression is true for the given values
+func (expr *Expression) Matches(values map[string]interface{}) bool {
+	return truthy(expr.root.eval(values))
+}
+
+// String returns the expression's source
+func (expr *Expression) String() string {
+	return expr.source
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (n andNode) eval(values map[string]interface{}) interface{} {
+	return truthy(n.left.eval(values)) && truthy(n.right.eval(values))
+}
+
+func (n orNode) eval(values map[string]interface{}) interface{} {
+	return truthy(n.left.eval(values)) || truthy(n.right.eval(values))
+}
+
+func (n notNode) eval(values map[string]interface{}) interface{} {
+	return !truthy(n.operand.eval(values))
+}
+
+func (n literalNode) eval(values map[string]interface{}) interface{} {
+	return n.value
+}
+
+func (n valueNode) eval(values map[string]interface{}) interface{} {
+	return values[n.name]
+}
+
+func (n compareNode) eval(values map[string]interface{}) interface{} {
+	left := n.left.eval(values)
+	right := n.right.eval(values)
+
+	if left == nil || right == nil {
+		return false
+	}
+
+	if leftNum, ok := toNumber(left); ok {
+		if rightNum, ok := toNumber(right); ok {
+			return compare(n.op, leftNum-rightNum)
+		}
+	}
+
+	leftStr, leftOk := left.(string)
+	rightStr, rightOk := right.(string)
+	if leftOk && rightOk {
+		return compare(n.op, float64(strings.Compare(leftStr, rightStr)))
+	}
+
+	leftBool, leftOk := left.(bool)
+	rightBool, rightOk := right.(bool)
+	if leftOk && rightOk {
+		switch n.op {
+		case "==":
+			return leftBool == rightBool
+		case "!=":
+			return leftBool != rightBool
+		}
+	}
+
+	return false
+}
+
+// parseOr parses: and ("||" and)*
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("||") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses: unary ("&&" unary)*
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept("&&") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseUnary parses: "!" unary | comparison
+func (p *parser) parseUnary() (node, error) {
+	if p.accept("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseComparison parses: operand (comparator operand)?
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	next := p.peek()
+	if next.kind != tokenOperator || !isComparator(next.text) {
+		return left, nil
+	}
+	p.pos++
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	return compareNode{op: next.text, left: left, right: right}, nil
+}
+
+// parseOperand parses: "(" or-expression ")" | number | string | true | false | name
+func (p *parser) parseOperand() (node, error) {
+	next := p.peek()
+	p.pos++
+
+	switch next.kind {
+	case tokenNumber:
+		number, err := strconv.ParseFloat(next.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", next.text, next.pos+1)
+		}
+		return literalNode{value: number}, nil
+	case tokenString:
+		return literalNode{value: next.text}, nil
+	case tokenIdent:
+		switch next.text {
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		}
+		return valueNode{name: next.text}, nil
+	case tokenOperator:
+		if next.text == "(" {
+			inner, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			if !p.accept(")") {
+				return nil, fmt.Errorf("missing ) at position %d", p.peek().pos+1)
+			}
+
+			return inner, nil
+		}
+	case tokenEnd:
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	return nil, fmt.Errorf("unexpected %q at position %d", next.text, next.pos+1)
+}
+
+// accept consumes the next token if it is the given operator
+func (p *parser) accept(operator string) bool {
+	next := p.peek()
+	if next.kind == tokenOperator && next.text == operator {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// peek returns the next token. The tokens always end with a tokenEnd, which is returned
+// once every other token has been consumed
+func (p *parser) peek() token {
+	if p.pos >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+
+	return p.tokens[p.pos]
+}
+
+func tokenize(source string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(source)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", i+1)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[i+1 : end]), pos: i})
+			i = end + 1
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			end := i + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: string(runes[i:end]), pos: i})
+			i = end
+		case unicode.IsLetter(r) || r == '_':
+			end := i + 1
+			for end < len(runes) && isNameRune(runes[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[i:end]), pos: i})
+			i = end
+		default:
+			operator := ""
+			for _, candidate := range []string{"&&", "||", "==", "!=", ">=", "<=", ">", "<", "!", "(", ")"} {
+				if strings.HasPrefix(string(runes[i:]), candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if operator == "" {
+				return nil, fmt.Errorf("unexpected %q at position %d", string(r), i+1)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: operator, pos: i})
+			i += len([]rune(operator))
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEnd, pos: len(runes)})
+
+	return tokens, nil
+}
+
+func compare(op string, difference float64) bool {
+	switch op {
+	case "==":
+		return difference == 0
+	case "!=":
+		return difference != 0
+	case ">":
+		return difference > 0
+	case ">=":
+		return difference >= 0
+	case "<":
+		return difference < 0
+	case "<=":
+		return difference <= 0
+	}
+
+	return false
+}
+
+func isComparator(operator string) bool {
+	switch operator {
+	case "==", "!=", ">", ">=", "<", "<=":
+		return true
+	}
+
+	return false
+}
+
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
+}
+
+// toNumber returns the value as a float64 if it is any kind of number
+func toNumber(value interface{}) (float64, bool) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, true
+	case float32:
+		return float64(typed), true
+	case int:
+		return float64(typed), true
+	case int64:
+		return float64(typed), true
+	case int32:
+		return float64(typed), true
+	case uint64:
+		return float64(typed), true
+	case uint:
+		return float64(typed), true
+	}
+
+	return 0, false
+}
+
+func truthy(value interface{}) bool {
+	switch typed := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return typed
+	case string:
+		return typed != ""
+	}
+
+	if number, ok := toNumber(value); ok {
+		return number != 0
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Expression_Matches(t *testing.T) {
+	values := map[string]interface{}{
+		"cpu":           95.5,
+		"down":          0,
+		"name":          "build",
+		"ok":            true,
+		"AAPL.change":   -3.2,
+		"uptime.my_box": 99.1,
+	}
+
+	tests := []struct {
+		source   string
+		expected bool
+	}{
+		{source: "cpu > 90", expected: true},
+		{source: "cpu <= 90", expected: false},
+		{source: "down == 0", expected: true},
+		{source: "down != 0", expected: false},
+		{source: "name == \"build\"", expected: true},
+		{source: "name == 'deploy'", expected: false},
+		{source: "ok", expected: true},
+		{source: "!ok", expected: false},
+		{source: "ok == true", expected: true},
+		{source: "down", expected: false},
+		{source: "AAPL.change >= -2", expected: false},
+		{source: "!(AAPL.change >= -2)", expected: true},
+		{source: "uptime.my_box < 99.5 && down > 0", expected: false},
+		{source: "uptime.my_box < 99.5 || down > 0", expected: true},
+		{source: "cpu > 90 && (down > 0 || ok)", expected: true},
+		{source: "missing > 0", expected: false},
+		{source: "missing < 0", expected: false},
+		{source: "missing", expected: false},
+		{source: "name > 5", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			expr, err := ParseExpression(tt.source)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.expected, expr.Matches(values))
+		})
+	}
+}
+
+func Test_ParseExpression_Errors(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+	}{
+		{source: "", expected: "unexpected end of expression"},
+		{source: "cpu >", expected: "unexpected end of expression"},
+		{source: "(cpu > 90", expected: "missing ) at position 10"},
+		{source: "cpu > 90)", expected: "unexpected \")\" at position 9"},
+		{source: "cpu = 90", expected: "unexpected \"=\" at position 5"},
+		{source: "name == \"build", expected: "unterminated string at position 9"},
+		{source: "cpu > 1.2.3", expected: "invalid number \"1.2.3\" at position 7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			_, err := ParseExpression(tt.source)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.expected, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_.]*)\}`)
+	unsafeNameChars    = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// Rule changes how a widget is displayed, and can raise a notification, while a condition
+// over the values the widget exposes is true:
+//
+//	mods:
+//	  resourceusage:
+//	    rules:
+//	      - name: High CPU
+//	        when: cpu > 90
+//	        border: red              <- the widget's border color while the rule matches
+//	        badge: "[red]HOT[-]"     <- shown after the widget's title while the rule matches
+//	        notify: true             <- raises a notification when the rule starts matching
+//	        message: "CPU is at {cpu}%"
+//
+// The message can contain any of the widget's values by name, in braces
+type Rule struct {
+	Badge   string
+	Border  string
+	Message string
+	Name    string
+	Notify  bool
+	When    *Expression
+}
+
+// NewRulesFromYAML creates and returns the rules defined in the module's config, and an error
+// for each rule that could not be parsed. Rules that could not be parsed are left out
+func NewRulesFromYAML(moduleConfig *config.Config) ([]*Rule, []error) {
+	rules := []*Rule{}
+	errs := []error{}
+
+	for idx := range moduleConfig.UList("rules") {
+		ruleConfig, err := moduleConfig.Get(fmt.Sprintf("rules.%d", idx))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %s", idx+1, err.Error()))
+			continue
+		}
+
+		rule, err := NewRuleFromYAML(ruleConfig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %d: %s", idx+1, err.Error()))
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, errs
+}
+
+// NewRuleFromYAML creates and returns a Rule from its config
+func NewRuleFromYAML(ruleConfig *config.Config) (*Rule, error) {
+	source := ruleConfig.UString("when")
+	if source == "" {
+		return nil, errors.New("missing when")
+	}
+
+	when, err := ParseExpression(source)
+	if err != nil {
+		return nil, fmt.Errorf("when %q: %s", source, err.Error())
+	}
+
+	rule := Rule{
+		Badge:   ruleConfig.UString("badge"),
+		Border:  ruleConfig.UString("border"),
+		Message: ruleConfig.UString("message"),
+		Name:    ruleConfig.UString("name", source),
+		Notify:  ruleConfig.UBool("notify", false),
+		When:    when,
+	}
+
+	return &rule, nil
+}
+
+// ValueName joins the parts into the name of a value that can be used in an expression,
+// such as "uptime.my_website". Characters that can't be used in a name are replaced by
+// underscores, so parts can be taken from user-defined labels
+func ValueName(parts ...string) string {
+	safe := make([]string, len(parts))
+	for i, part := range parts {
+		safe[i] = strings.Trim(unsafeNameChars.ReplaceAllString(part, "_"), "_")
+	}
+
+	return strings.Join(safe, ".")
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Matches returns TRUE if the rule's condition is true for the given values
+func (rule *Rule) Matches(values map[string]interface{}) bool {
+	return rule.When.Matches(values)
+}
+
+// MessageFor returns the rule's message with the given values in place of their names. Rules
+// without a message describe their condition instead
+func (rule *Rule) MessageFor(values map[string]interface{}) string {
+	if rule.Message == "" {
+		return rule.When.String()
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(rule.Message, func(placeholder string) string {
+		name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+
+		value, ok := values[name]
+		if !ok {
+			return placeholder
+		}
+
+		return formatValue(value)
+	})
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func formatValue(value interface{}) string {
+	if number, ok := toNumber(value); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value)
+}
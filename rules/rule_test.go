@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewRulesFromYAML(t *testing.T) {
+	moduleConfig, err := config.ParseYaml(`
+rules:
+  - name: High CPU
+    when: cpu > 90
+    border: red
+    badge: HOT
+    notify: true
+  - border: yellow
+  - when: cpu >
+  - when: cpu > 50
+`)
+	assert.NoError(t, err)
+
+	rules, errs := NewRulesFromYAML(moduleConfig)
+
+	assert.Equal(t, 2, len(rules))
+	assert.Equal(t, "High CPU", rules[0].Name)
+	assert.Equal(t, "red", rules[0].Border)
+	assert.Equal(t, "HOT", rules[0].Badge)
+	assert.True(t, rules[0].Notify)
+	assert.Equal(t, "cpu > 50", rules[1].Name)
+	assert.False(t, rules[1].Notify)
+
+	if assert.Equal(t, 2, len(errs)) {
+		assert.Equal(t, "rule 2: missing when", errs[0].Error())
+		assert.Equal(t, "rule 3: when \"cpu >\": unexpected end of expression", errs[1].Error())
+	}
+}
+
+func Test_Rule_MessageFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{name: "no message", message: "", expected: "cpu > 90"},
+		{name: "with values", message: "CPU is at {cpu}% on {host}", expected: "CPU is at 95.5% on box"},
+		{name: "with missing value", message: "Swap is at {swap}%", expected: "Swap is at {swap}%"},
+	}
+
+	values := map[string]interface{}{"cpu": 95.5, "host": "box"}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			when, _ := ParseExpression("cpu > 90")
+			rule := Rule{Message: tt.message, When: when}
+
+			assert.Equal(t, tt.expected, rule.MessageFor(values))
+		})
+	}
+}
+
+func Test_ValueName(t *testing.T) {
+	assert.Equal(t, "uptime", ValueName("uptime"))
+	assert.Equal(t, "uptime.My_Website", ValueName("uptime", "My Website!"))
+	assert.Equal(t, "hour.New_York", ValueName("hour", "New York"))
+}
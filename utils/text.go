@@ -87,3 +87,68 @@ func PrettyNumber(number float64) string {
 
 	return p.Sprintf("%.2f", number)
 }
+
+// FuzzyScore returns how well the query matches the text, and whether it matches at all. The
+// query matches if all of its characters appear in the text in the same order, ignoring case.
+// Matches on consecutive characters and on the start of words score higher
+//
+// Example:
+//
+//    score, ok := FuzzyScore("jop", "Jira: open item")
+//    > 6, true
+//
+func FuzzyScore(query, text string) (int, bool) {
+	queryRunes := []rune(strings.ToLower(query))
+	textRunes := []rune(strings.ToLower(text))
+
+	if len(queryRunes) == 0 {
+		return 0, true
+	}
+
+	bestScore := -1
+
+	// Try each place the query could start matching and keep the best result
+	for start, char := range textRunes {
+		if char != queryRunes[0] {
+			continue
+		}
+
+		if score, ok := fuzzyScoreFrom(queryRunes, textRunes, start); ok && score > bestScore {
+			bestScore = score
+		}
+	}
+
+	if bestScore < 0 {
+		return 0, false
+	}
+
+	return bestScore, true
+}
+
+// fuzzyScoreFrom scores the query against the text, matching from the given start position
+func fuzzyScoreFrom(queryRunes, textRunes []rune, start int) (int, bool) {
+	score := 0
+	queryIdx := 0
+	prevMatch := -2
+
+	for textIdx := start; textIdx < len(textRunes) && queryIdx < len(queryRunes); textIdx++ {
+		if textRunes[textIdx] != queryRunes[queryIdx] {
+			continue
+		}
+
+		score++
+
+		if textIdx == prevMatch+1 {
+			score++
+		}
+
+		if textIdx == 0 || strings.ContainsRune(" :-_/.", textRunes[textIdx-1]) {
+			score++
+		}
+
+		prevMatch = textIdx
+		queryIdx++
+	}
+
+	return score, queryIdx == len(queryRunes)
+}
@@ -57,3 +57,51 @@ func Test_PrettyNumber(t *testing.T) {
 	assert.Equal(t, "0", PrettyNumber(0))
 	assert.Equal(t, "0.10", PrettyNumber(0.1))
 }
+
+func Test_FuzzyScore(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		text          string
+		expectedScore int
+		expectedMatch bool
+	}{
+		{
+			name:          "with an empty query",
+			query:         "",
+			text:          "Jira: open item",
+			expectedScore: 0,
+			expectedMatch: true,
+		},
+		{
+			name:          "with a subsequence",
+			query:         "jop",
+			text:          "Jira: open item",
+			expectedScore: 6,
+			expectedMatch: true,
+		},
+		{
+			name:          "with a different case",
+			query:         "PULL",
+			text:          "Git: pull",
+			expectedScore: 8,
+			expectedMatch: true,
+		},
+		{
+			name:          "with characters out of order",
+			query:         "pj",
+			text:          "Jira: open item",
+			expectedScore: 0,
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := FuzzyScore(tt.query, tt.text)
+
+			assert.Equal(t, tt.expectedMatch, ok)
+			assert.Equal(t, tt.expectedScore, score)
+		})
+	}
+}
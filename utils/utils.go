@@ -47,18 +47,29 @@ func DoesNotInclude(strs []string, val string) bool {
 
 // ExecuteCommand executes an external command on the local machine as the current user
 func ExecuteCommand(cmd *exec.Cmd) string {
+	str, err := RunCommand(cmd)
+	if err != nil {
+		return err.Error()
+	}
+
+	return str
+}
+
+// RunCommand executes an external command on the local machine as the current user and
+// returns its output, or the error it failed with
+func RunCommand(cmd *exec.Cmd) (string, error) {
 	if cmd == nil {
-		return ""
+		return "", nil
 	}
 
 	buf := &bytes.Buffer{}
 	cmd.Stdout = buf
 
 	if err := cmd.Run(); err != nil {
-		return err.Error()
+		return "", err
 	}
 
-	return buf.String()
+	return buf.String(), nil
 }
 
 // FindMatch takes a regex pattern and a string of data and returns back all the matches
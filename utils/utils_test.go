@@ -69,6 +69,20 @@ func Test_ExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_RunCommand(t *testing.T) {
+	str, err := RunCommand(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", str)
+
+	str, err = RunCommand(exec.Command("echo", "cats"))
+	assert.NoError(t, err)
+	assert.Equal(t, "cats\n", str)
+
+	str, err = RunCommand(exec.Command("false"))
+	assert.EqualError(t, err, "exit status 1")
+	assert.Equal(t, "", str)
+}
+
 func Test_FindMatch(t *testing.T) {
 	expected := [][]string{{"SSID: 7E5B5C", "7E5B5C"}}
 	result := FindMatch(`s*SSID: (.+)s*`, "SSID: 7E5B5C")
@@ -25,6 +25,10 @@ type Bar struct {
 	Percent    int
 	ValueLabel string
 	LabelColor string
+
+	// Trend, if set, is drawn between the bar and its value label, such as a Sparkline of
+	// the bar's recent values
+	Trend string
 }
 
 // NewBarGraph creates and returns an instance of BarGraph
@@ -81,6 +85,10 @@ func BuildStars(data []Bar, maxStars int, starChar string) string {
 			labelColor = "default"
 		}
 
+		if bar.Trend != "" {
+			label = bar.Trend + " " + label
+		}
+
 		//write the line
 		_, err := buffer.WriteString(
 			fmt.Sprintf(
@@ -101,6 +109,23 @@ func BuildStars(data []Bar, maxStars int, starChar string) string {
 	return buffer.String()
 }
 
+// ApplyColors redraws the view in the widget's colors, after they have changed. Must be
+// called from within the app's event loop
+func (widget *BarGraph) ApplyColors() {
+	widget.setColors(widget.View)
+}
+
+// RedrawTitle redraws the widget's title and border to reflect its current refresh state
+func (widget *BarGraph) RedrawTitle() {
+	widget.app.QueueUpdateDraw(func() {
+		widget.View.SetTitle(widget.ContextualTitle(widget.title))
+
+		if !widget.View.HasFocus() {
+			widget.View.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
+		}
+	})
+}
+
 func (widget *BarGraph) TextView() *tview.TextView {
 	return widget.View
 }
@@ -110,13 +135,23 @@ func (widget *BarGraph) TextView() *tview.TextView {
 func (widget *BarGraph) createView(bordered bool) *tview.TextView {
 	view := tview.NewTextView()
 
-	view.SetBackgroundColor(wtf.ColorFor(widget.commonSettings.Colors.WidgetTheme.Background))
 	view.SetBorder(bordered)
-	view.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
 	view.SetDynamicColors(true)
 	view.SetTitle(widget.ContextualTitle(widget.CommonSettings().Title))
-	view.SetTitleColor(wtf.ColorFor(widget.commonSettings.Colors.TextTheme.Title))
 	view.SetWrap(false)
 
+	widget.setColors(view)
+
 	return view
 }
+
+func (widget *BarGraph) setColors(view *tview.TextView) {
+	view.SetBackgroundColor(wtf.ColorFor(widget.commonSettings.Colors.WidgetTheme.Background))
+	view.SetTitleColor(wtf.ColorFor(widget.commonSettings.Colors.TextTheme.Title))
+
+	if view.HasFocus() {
+		view.SetBorderColor(wtf.ColorFor(widget.commonSettings.Colors.BorderTheme.Focused))
+	} else {
+		view.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
+	}
+}
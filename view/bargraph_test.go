@@ -82,3 +82,9 @@ func Test_BuildStars(t *testing.T) {
 		result,
 	)
 }
+
+func Test_BuildStarsWithTrend(t *testing.T) {
+	data := []Bar{{Label: "CPU", Percent: 50, ValueLabel: "50%", Trend: "▁▄█"}}
+
+	assert.Equal(t, "CPU[[default]**[default]  ] ▁▄█ 50%\n", BuildStars(data, 4, "*"))
+}
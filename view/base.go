@@ -2,25 +2,57 @@ package view
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cache"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/notify"
+	"github.com/wtfutil/wtf/rules"
 	"github.com/wtfutil/wtf/utils"
 )
 
+const (
+	// errorIndicator is prepended to the title of widgets whose last refresh failed
+	errorIndicator = "!"
+
+	// flashInterval is how often a flashing title switches between highlighted and normal
+	flashInterval = 500 * time.Millisecond
+
+	// refreshingIndicator is prepended to the title of widgets that are refreshing their data
+	refreshingIndicator = "↻"
+)
+
 type Base struct {
 	app             *tview.Application
 	bordered        bool
+	cache           *cache.Cache
+	cacheChecked    bool
 	commonSettings  *cfg.Common
 	enabled         bool
+	flashStartAt    time.Time
+	flashUntil      time.Time
 	focusChar       string
 	focusable       bool
+	fromCache       bool
+	matchedRules    map[*rules.Rule]bool
 	name            string
 	quitChan        chan bool
+	refreshErr      error
+	refreshErrAt    time.Time
+	refreshedAt     time.Time
 	refreshing      bool
 	refreshInterval int
+	refreshStartAt  time.Time
+	title           string
+	updatedAt       time.Time
+	values          map[string]interface{}
 	enabledMutex    *sync.Mutex
+	flashMutex      *sync.Mutex
+	refreshMutex    *sync.Mutex
+	valuesMutex     *sync.Mutex
 }
 
 func NewBase(app *tview.Application, commonSettings *cfg.Common) Base {
@@ -36,7 +68,15 @@ func NewBase(app *tview.Application, commonSettings *cfg.Common) Base {
 		refreshInterval: commonSettings.RefreshInterval,
 		refreshing:      false,
 		enabledMutex:    &sync.Mutex{},
+		flashMutex:      &sync.Mutex{},
+		refreshMutex:    &sync.Mutex{},
+		valuesMutex:     &sync.Mutex{},
 	}
+
+	if commonSettings.Cached {
+		base.cache, _ = cache.NewDefaultCache()
+	}
+
 	return base
 }
 
@@ -47,8 +87,24 @@ func (base *Base) Bordered() bool {
 	return base.bordered
 }
 
-// BorderColor returns the color that the border of this widget should be drawn in
+// BorderColor returns the color that the border of this widget should be drawn in. Widgets
+// whose last refresh failed are drawn in the errored color, widgets with a matching rule in
+// the rule's color, and widgets showing stale data in the stale color
 func (base *Base) BorderColor() string {
+	if base.RefreshError() != nil {
+		return base.commonSettings.Colors.BorderTheme.Errored
+	}
+
+	for _, rule := range base.MatchingRules() {
+		if rule.Border != "" {
+			return rule.Border
+		}
+	}
+
+	if base.Stale() {
+		return base.commonSettings.Colors.BorderTheme.Stale
+	}
+
 	if base.Focusable() {
 		return base.commonSettings.Colors.BorderTheme.Focusable
 	}
@@ -64,17 +120,48 @@ func (base *Base) ConfigText() string {
 	return utils.HelpFromInterface(cfg.Common{})
 }
 
+// ContextualTitle returns the title decorated with the widget's focus character, the badges
+// of its matching rules, and any refreshing, error, or stale data indicators
 func (base *Base) ContextualTitle(defaultStr string) string {
+	base.title = defaultStr
+
+	for _, rule := range base.MatchingRules() {
+		if rule.Badge != "" {
+			defaultStr = strings.TrimSpace(fmt.Sprintf("%s %s", defaultStr, rule.Badge))
+		}
+	}
+
+	if base.Stale() {
+		defaultStr = strings.TrimSpace(
+			fmt.Sprintf("%s [%s]updated %s[-]", defaultStr, base.commonSettings.Colors.BorderTheme.Stale, base.UpdatedAt().Format("15:04")),
+		)
+	}
+
+	if base.RefreshError() != nil {
+		defaultStr = strings.TrimSpace(
+			fmt.Sprintf("[%s]%s[-] %s", base.commonSettings.Colors.BorderTheme.Errored, errorIndicator, defaultStr),
+		)
+	}
+
+	if base.Refreshing() {
+		defaultStr = strings.TrimSpace(fmt.Sprintf("%s %s", refreshingIndicator, defaultStr))
+	}
+
+	if base.flashOn() {
+		colors := base.commonSettings.Colors
+		defaultStr = fmt.Sprintf("[%s:%s]%s[-:-]", colors.HighlightedForeground, colors.HighlightedBackground, tview.Escape(base.title))
+	}
+
 	switch {
 	case defaultStr == "" && base.FocusChar() == "":
 		return ""
 	case defaultStr != "" && base.FocusChar() == "":
 		return fmt.Sprintf(" %s ", defaultStr)
 	case defaultStr == "" && base.FocusChar() != "":
-		return fmt.Sprintf(" [darkgray::u]%s[::-][white] ", base.FocusChar())
+		return fmt.Sprintf(" [%s::u]%s[::-][-] ", base.commonSettings.Colors.Muted, base.FocusChar())
 	}
 
-	return fmt.Sprintf(" %s [darkgray::u]%s[::-][white] ", defaultStr, base.FocusChar())
+	return fmt.Sprintf(" %s [%s::u]%s[::-][-] ", defaultStr, base.commonSettings.Colors.Muted, base.FocusChar())
 }
 
 func (base *Base) Disable() {
@@ -104,6 +191,24 @@ func (base *Base) Focusable() bool {
 	return result
 }
 
+// Flash makes the base's title flash for the given duration, to draw attention to it. The
+// title only changes when it is redrawn, so the caller must redraw it while it flashes
+func (base *Base) Flash(duration time.Duration) {
+	base.flashMutex.Lock()
+	defer base.flashMutex.Unlock()
+
+	base.flashStartAt = time.Now()
+	base.flashUntil = base.flashStartAt.Add(duration)
+}
+
+// Flashing returns TRUE if the base's title is currently flashing
+func (base *Base) Flashing() bool {
+	base.flashMutex.Lock()
+	defer base.flashMutex.Unlock()
+
+	return time.Now().Before(base.flashUntil)
+}
+
 func (base *Base) FocusChar() string {
 	return base.focusChar
 }
@@ -112,6 +217,68 @@ func (base *Base) HelpText() string {
 	return fmt.Sprintf("\n  There is no help available for widget %s", base.commonSettings.Module.Type)
 }
 
+// LoadCache reads the data the base last saved with SaveCache into data. Returns TRUE if
+// caching is enabled and unexpired data was found. Loaded data is considered stale until
+// the base next refreshes successfully
+func (base *Base) LoadCache(data interface{}) bool {
+	if base.cache == nil {
+		return false
+	}
+
+	savedAt, ok := base.cache.Load(base.name, time.Duration(base.commonSettings.CacheTTL)*time.Second, data)
+	if !ok {
+		return false
+	}
+
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	base.fromCache = true
+	base.updatedAt = savedAt
+
+	return true
+}
+
+// LoadCacheOnce is LoadCache for the start of a widget's first refresh, so that it can
+// display the saved data while it fetches fresh data. Returns FALSE without reading the
+// cache on every later call
+func (base *Base) LoadCacheOnce(data interface{}) bool {
+	base.refreshMutex.Lock()
+	checked := base.cacheChecked
+	base.cacheChecked = true
+	base.refreshMutex.Unlock()
+
+	if checked {
+		return false
+	}
+
+	return base.LoadCache(data)
+}
+
+// MatchingRules returns the module's rules that match the values the base last recorded
+// with SetValues, in the order they are configured
+func (base *Base) MatchingRules() []*rules.Rule {
+	base.valuesMutex.Lock()
+	defer base.valuesMutex.Unlock()
+
+	matching := []*rules.Rule{}
+	for _, rule := range base.commonSettings.Rules {
+		if base.matchedRules[rule] {
+			matching = append(matching, rule)
+		}
+	}
+
+	return matching
+}
+
+// Notify raises a notification about something that has changed in the widget, which the
+// user is told about if the notification settings allow it
+func (base *Base) Notify(event notify.Event) {
+	event.Module = base.name
+
+	notify.Notify(event)
+}
+
 func (base *Base) Name() string {
 	return base.name
 }
@@ -120,8 +287,57 @@ func (base *Base) QuitChan() chan bool {
 	return base.quitChan
 }
 
+// RefreshError returns the error that caused the base's last refresh to fail, or nil if the
+// last refresh succeeded
+func (base *Base) RefreshError() error {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	return base.refreshErr
+}
+
+// RefreshErrorAt returns the time at which the base's last refresh failed, or the zero time
+// if the last refresh succeeded
+func (base *Base) RefreshErrorAt() time.Time {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	return base.refreshErrAt
+}
+
+// RefreshErrorText returns a short description of why the base's last refresh failed and
+// when, or an empty string if the last refresh succeeded
+func (base *Base) RefreshErrorText() string {
+	err := base.RefreshError()
+	if err == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("last refresh failed: %s at %s", err.Error(), base.RefreshErrorAt().Format("15:04:05"))
+}
+
+// RefreshedAt returns the time at which the base last finished refreshing its data, or the
+// zero time if it has never been refreshed
+func (base *Base) RefreshedAt() time.Time {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	return base.refreshedAt
+}
+
+// RefreshStartAt returns the time at which the base last started refreshing its data
+func (base *Base) RefreshStartAt() time.Time {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	return base.refreshStartAt
+}
+
 // Refreshing returns TRUE if the base is currently refreshing its data, FALSE if it is not
 func (base *Base) Refreshing() bool {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
 	return base.refreshing
 }
 
@@ -130,17 +346,165 @@ func (base *Base) RefreshInterval() int {
 	return base.refreshInterval
 }
 
+// SaveCache writes data to disk so that it can be displayed immediately the next time the
+// app starts. Does nothing if caching is not enabled for the base
+func (base *Base) SaveCache(data interface{}) error {
+	if base.cache == nil {
+		return nil
+	}
+
+	return base.cache.Save(base.name, data)
+}
+
+// SetValues records the structured values behind what the widget displays, such as a CPU
+// percentage, and applies the module's rules to them. Widgets call this after every
+// successful refresh, before redrawing. Rules that notify raise a notification when they
+// start matching
+func (base *Base) SetValues(values map[string]interface{}) {
+	matched := map[*rules.Rule]bool{}
+	started := []*rules.Rule{}
+
+	base.valuesMutex.Lock()
+
+	for _, rule := range base.commonSettings.Rules {
+		if !rule.Matches(values) {
+			continue
+		}
+
+		matched[rule] = true
+		if !base.matchedRules[rule] {
+			started = append(started, rule)
+		}
+	}
+
+	base.values = values
+	base.matchedRules = matched
+
+	base.valuesMutex.Unlock()
+
+	for _, rule := range started {
+		if rule.Notify {
+			base.Notify(notify.Event{Kind: "rule", Title: rule.Name, Message: rule.MessageFor(values)})
+		}
+	}
+}
+
 func (base *Base) SetFocusChar(char string) {
 	base.focusChar = char
 }
 
+// SetRefreshError records the outcome of the base's most recent refresh. Widgets should call
+// this at the end of every refresh, passing nil when the refresh succeeded
+func (base *Base) SetRefreshError(err error) {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	base.refreshErr = err
+
+	if err != nil {
+		base.refreshErrAt = time.Now()
+	} else {
+		base.refreshErrAt = time.Time{}
+	}
+}
+
+// SetRefreshedAt records the time at which the base last finished refreshing its data. If
+// the refresh succeeded this is also when its data was last updated
+func (base *Base) SetRefreshedAt(refreshedAt time.Time) {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	base.refreshedAt = refreshedAt
+
+	if base.refreshErr == nil {
+		base.fromCache = false
+		base.updatedAt = refreshedAt
+	}
+}
+
+// SetRefreshing records whether or not the base is currently refreshing its data
+func (base *Base) SetRefreshing(refreshing bool) {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	base.refreshing = refreshing
+
+	if refreshing {
+		base.refreshStartAt = time.Now()
+	}
+}
+
+// Stale returns TRUE if the base is displaying data loaded from the cache, or if its data
+// has not been successfully updated for longer than its staleAfter number of refresh intervals
+func (base *Base) Stale() bool {
+	base.refreshMutex.Lock()
+	fromCache := base.fromCache
+	base.refreshMutex.Unlock()
+
+	if fromCache {
+		return true
+	}
+
+	staleAfter := base.commonSettings.StaleAfter
+	if staleAfter <= 0 || base.refreshInterval <= 0 {
+		return false
+	}
+
+	updatedAt := base.UpdatedAt()
+	if updatedAt.IsZero() {
+		return false
+	}
+
+	maxAge := time.Duration(staleAfter*base.refreshInterval) * time.Second
+
+	return time.Since(updatedAt) > maxAge
+}
+
+// Stop disables the widget and signals its scheduler to quit. If the scheduler is
+// not currently listening it will notice the widget is disabled on its next tick
 func (base *Base) Stop() {
 	base.enabledMutex.Lock()
 	base.enabled = false
 	base.enabledMutex.Unlock()
-	base.quitChan <- true
+
+	select {
+	case base.quitChan <- true:
+	default:
+	}
+}
+
+// Values returns the structured values the base last recorded with SetValues
+func (base *Base) Values() map[string]interface{} {
+	base.valuesMutex.Lock()
+	defer base.valuesMutex.Unlock()
+
+	return base.values
 }
 
 func (base *Base) String() string {
 	return base.name
 }
+
+// UpdatedAt returns the time at which the base last successfully refreshed its data, or the
+// zero time if it never has
+func (base *Base) UpdatedAt() time.Time {
+	base.refreshMutex.Lock()
+	defer base.refreshMutex.Unlock()
+
+	return base.updatedAt
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// flashOn returns TRUE if the base's title is flashing and should be drawn highlighted
+func (base *Base) flashOn() bool {
+	base.flashMutex.Lock()
+	defer base.flashMutex.Unlock()
+
+	now := time.Now()
+	if !now.Before(base.flashUntil) {
+		return false
+	}
+
+	return (now.Sub(base.flashStartAt)/flashInterval)%2 == 0
+}
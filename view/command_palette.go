@@ -0,0 +1,114 @@
+package view
+
+import (
+	"sort"
+
+	"github.com/gdamore/tcell"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// PaletteCommand is a named action that can be run from the command palette
+type PaletteCommand struct {
+	Name   string
+	Action func()
+}
+
+// NewCommandPalette creates and returns a modal dialog that fuzzy-searches the given
+// commands by name as the user types. Enter closes the palette and runs the selected
+// command, Esc closes it without running anything
+func NewCommandPalette(commands []PaletteCommand, closeFunc func()) *tview.Frame {
+	matches := commands
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+	list.SetHighlightFullLine(true)
+
+	filter := func(query string) {
+		matches = filterPaletteCommands(commands, query)
+
+		list.Clear()
+		for _, command := range matches {
+			list.AddItem(tview.Escape(command.Name), "", 0, nil)
+		}
+	}
+
+	keyboardIntercept := func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeFunc()
+			return nil
+		case tcell.KeyEnter:
+			idx := list.GetCurrentItem()
+			closeFunc()
+
+			if idx < len(matches) {
+				matches[idx].Action()
+			}
+			return nil
+		case tcell.KeyUp, tcell.KeyDown, tcell.KeyPgUp, tcell.KeyPgDn:
+			list.InputHandler()(event, func(p tview.Primitive) {})
+			return nil
+		default:
+			return event
+		}
+	}
+
+	input := tview.NewInputField()
+	input.SetLabel("> ")
+	input.SetFieldBackgroundColor(tcell.ColorDefault)
+	input.SetChangedFunc(filter)
+	input.SetInputCapture(keyboardIntercept)
+
+	filter("")
+
+	flex := tview.NewFlex()
+	flex.SetDirection(tview.FlexRow)
+	flex.AddItem(input, 1, 0, true)
+	flex.AddItem(list, 0, 1, false)
+
+	frame := tview.NewFrame(flex)
+	frame.SetRect(offscreen, offscreen, modalWidth, modalHeight)
+
+	drawFunc := func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		w, h := screen.Size()
+		frame.SetRect((w/2)-(width/2), (h/2)-(height/2), width, height)
+		return x, y, width, height
+	}
+
+	frame.SetBorder(true)
+	frame.SetBorders(1, 1, 0, 0, 1, 1)
+	frame.SetDrawFunc(drawFunc)
+	frame.SetTitle(" Commands ")
+
+	return frame
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// filterPaletteCommands returns the commands whose names fuzzy-match the query, best
+// matches first. Commands that match equally well keep their original order
+func filterPaletteCommands(commands []PaletteCommand, query string) []PaletteCommand {
+	type scoredCommand struct {
+		command PaletteCommand
+		score   int
+	}
+
+	scored := []scoredCommand{}
+	for _, command := range commands {
+		if score, ok := utils.FuzzyScore(query, command.Name); ok {
+			scored = append(scored, scoredCommand{command, score})
+		}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+
+	matches := []PaletteCommand{}
+	for _, match := range scored {
+		matches = append(matches, match.command)
+	}
+
+	return matches
+}
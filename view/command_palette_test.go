@@ -0,0 +1,53 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_filterPaletteCommands(t *testing.T) {
+	commands := []PaletteCommand{
+		{Name: "Git: pull"},
+		{Name: "Jira: open item"},
+		{Name: "Jira: refresh"},
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []string
+	}{
+		{
+			name:     "with an empty query",
+			query:    "",
+			expected: []string{"Git: pull", "Jira: open item", "Jira: refresh"},
+		},
+		{
+			name:     "with a fuzzy query",
+			query:    "jop",
+			expected: []string{"Jira: open item"},
+		},
+		{
+			name:     "with better matches first",
+			query:    "re",
+			expected: []string{"Jira: refresh", "Jira: open item"},
+		},
+		{
+			name:     "with no matches",
+			query:    "zzz",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := []string{}
+			for _, command := range filterPaletteCommands(commands, tt.query) {
+				actual = append(actual, command.Name)
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -10,11 +10,18 @@ import (
 )
 
 type helpItem struct {
-	Key  string
-	Text string
+	Key    string
+	Text   string
+	Action string
 }
 
-// KeyboardWidget manages keyboard control for a widget
+// KeyboardWidget manages keyboard control for a widget. The keys bound to each action
+// can be remapped in the module's config by the action's name, which is derived from its
+// help text:
+//
+//	keys:
+//	  open-item-in-browser: "O"
+//	  select-next-item: ["j", "g n"]
 type KeyboardWidget struct {
 	app      *tview.Application
 	pages    *tview.Pages
@@ -23,9 +30,15 @@ type KeyboardWidget struct {
 
 	charMap  map[string]func()
 	keyMap   map[tcell.Key]func()
+	chords   *Keymap
 	charHelp []helpItem
 	keyHelp  []helpItem
 	maxKey   int
+
+	commands   []PaletteCommand
+	keyErrors  []cfg.Validatable
+	remapped   map[string]bool
+	remappings map[string][]string
 }
 
 // NewKeyboardWidget creates and returns a new instance of KeyboardWidget
@@ -36,8 +49,12 @@ func NewKeyboardWidget(app *tview.Application, pages *tview.Pages, settings *cfg
 		settings: settings,
 		charMap:  make(map[string]func()),
 		keyMap:   make(map[tcell.Key]func()),
+		chords:   NewKeymap(),
 		charHelp: []helpItem{},
 		keyHelp:  []helpItem{},
+
+		remapped:   map[string]bool{},
+		remappings: KeyBindingsFromConfig(settings.Config, "keys"),
 	}
 
 	return keyWidget
@@ -53,13 +70,11 @@ func (widget *KeyboardWidget) SetKeyboardChar(char string, fn func(), helpText s
 		return
 	}
 
-	// Check to ensure that the key trying to be used isn't already being used for something
-	if _, ok := widget.charMap[char]; ok {
-		panic(fmt.Sprintf("Key is already mapped to a keyboard command: %s\n", char))
+	if widget.applyRemapping(fn, helpText) {
+		return
 	}
 
-	widget.charMap[char] = fn
-	widget.charHelp = append(widget.charHelp, helpItem{char, helpText})
+	widget.bind([]string{char}, fn, helpText)
 }
 
 // SetKeyboardKey sets a tcell.Key/function combination that responds to key presses
@@ -68,12 +83,25 @@ func (widget *KeyboardWidget) SetKeyboardChar(char string, fn func(), helpText s
 //    widget.SetKeyboardKey(tcell.KeyCtrlD, widget.deleteSelectedItem)
 //
 func (widget *KeyboardWidget) SetKeyboardKey(key tcell.Key, fn func(), helpText string) {
-	widget.keyMap[key] = fn
-	widget.keyHelp = append(widget.keyHelp, helpItem{tcell.KeyNames[key], helpText})
+	if widget.applyRemapping(fn, helpText) {
+		return
+	}
 
-	if len(tcell.KeyNames[key]) > widget.maxKey {
-		widget.maxKey = len(tcell.KeyNames[key])
+	widget.bindKey(key, fn, helpText)
+}
+
+// SetKeyboardSequence sets a key sequence/function combination that responds to key presses.
+// The sequence is written the same way as in the config
+// Example:
+//
+//    widget.SetKeyboardSequence("ctrl-d", widget.deleteSelectedItem)
+//
+func (widget *KeyboardWidget) SetKeyboardSequence(sequence string, fn func(), helpText string) {
+	if widget.applyRemapping(fn, helpText) {
+		return
 	}
+
+	widget.bindSequence(sequence, fn, helpText)
 }
 
 // InitializeCommonControls sets up the keyboard controls that are common to
@@ -96,37 +124,61 @@ func (widget *KeyboardWidget) InputCapture(event *tcell.EventKey) *tcell.EventKe
 		return nil
 	}
 
-	fn := widget.charMap[string(event.Rune())]
-	if fn != nil {
-		fn()
-		return nil
+	_, handled, abandoned := widget.chords.Handle(event)
+
+	// Keys held back by an abandoned chord still do what they would have done on their own
+	for _, held := range abandoned {
+		widget.handleKey(held)
 	}
 
-	fn = widget.keyMap[event.Key()]
-	if fn != nil {
-		fn()
+	if handled {
 		return nil
 	}
 
-	return event
+	return widget.handleKey(event)
 }
 
 // HelpText returns the help text and keyboard command info for this widget
 func (widget *KeyboardWidget) HelpText() string {
-	str := " [green::b]Keyboard commands for " + strings.Title(widget.settings.Module.Type) + "[white]\n\n"
+	colors := widget.settings.Colors
+
+	str := fmt.Sprintf(" [%s::b]Keyboard commands for %s[-]\n\n", colors.Title, strings.Title(widget.settings.Module.Type))
 
 	for _, item := range widget.charHelp {
-		str += fmt.Sprintf("  %s\t%s\n", item.Key, item.Text)
+		str += fmt.Sprintf("  %s\t%s [%s]%s[-]\n", tview.Escape(item.Key), item.Text, colors.Muted, item.Action)
 	}
 	str += "\n\n"
 
 	for _, item := range widget.keyHelp {
-		str += fmt.Sprintf("  %-*s\t%s\n", widget.maxKey, item.Key, item.Text)
+		str += fmt.Sprintf("  %-*s\t%s [%s]%s[-]\n", widget.maxKey, item.Key, item.Text, colors.Muted, item.Action)
 	}
 
 	return str
 }
 
+// KeyboardCommands returns every action that has been mapped to a key, named by its help
+// text, so that they can be run from the command palette
+func (widget *KeyboardWidget) KeyboardCommands() []PaletteCommand {
+	return widget.commands
+}
+
+// KeyValidations returns the problems found with this widget's key bindings, such as keys
+// bound to more than one action or remappings of actions that do not exist
+func (widget *KeyboardWidget) KeyValidations() []cfg.Validatable {
+	validations := widget.keyErrors
+
+	for action, sequences := range widget.remappings {
+		if !widget.remapped[action] {
+			validations = append(
+				validations,
+				cfg.NewKeyValidation(action, strings.Join(sequences, ", "), ErrNoSuchKeyAction),
+			)
+		}
+	}
+
+	return validations
+}
+
 func (widget *KeyboardWidget) SetView(view *tview.TextView) {
 	widget.view = view
 }
@@ -146,3 +198,140 @@ func (widget *KeyboardWidget) ShowHelp() {
 		widget.app.Draw()
 	})
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// applyRemapping binds the keys configured for the action described by the help text in
+// place of its default keys. Returns FALSE if the action has not been remapped
+func (widget *KeyboardWidget) applyRemapping(fn func(), helpText string) bool {
+	action := KeyActionName(helpText)
+
+	sequences, ok := widget.remappings[action]
+	if !ok {
+		return false
+	}
+
+	// An action can have several default keys, but its remapped keys are only bound once
+	if widget.remapped[action] {
+		return true
+	}
+	widget.remapped[action] = true
+
+	for _, sequence := range sequences {
+		widget.bindSequence(sequence, fn, helpText)
+	}
+
+	return true
+}
+
+// bind maps a character or a chord to the function
+func (widget *KeyboardWidget) bind(keys []string, fn func(), helpText string) {
+	sequence := strings.Join(keys, " ")
+	action := KeyActionName(helpText)
+
+	if len(keys) == 1 {
+		// Check to ensure that the key trying to be used isn't already being used for something
+		if widget.isBound(sequence) {
+			widget.addKeyError(action, sequence, fmt.Errorf("key is already mapped to a keyboard command"))
+			return
+		}
+
+		if widget.chords.IsPrefix(sequence) {
+			widget.addKeyError(action, sequence, fmt.Errorf("key is the start of another key binding"))
+			return
+		}
+
+		if sequence == "space" {
+			widget.charMap[" "] = fn
+		} else {
+			widget.charMap[sequence] = fn
+		}
+	} else {
+		if widget.isBound(keys[0]) {
+			widget.addKeyError(action, sequence, fmt.Errorf("%q is already mapped to a keyboard command", keys[0]))
+			return
+		}
+
+		if err := widget.chords.Bind(keys, fn); err != nil {
+			widget.addKeyError(action, sequence, err)
+			return
+		}
+	}
+
+	widget.charHelp = append(widget.charHelp, helpItem{sequence, helpText, action})
+	widget.commands = append(widget.commands, PaletteCommand{Name: helpText, Action: fn})
+}
+
+// bindSequence maps a key sequence written as key names, e.g. "o", "ctrl-d" or "g g", to
+// the function
+func (widget *KeyboardWidget) bindSequence(sequence string, fn func(), helpText string) {
+	keys, err := ParseKeySequence(sequence)
+	if err != nil {
+		widget.addKeyError(KeyActionName(helpText), sequence, err)
+		return
+	}
+
+	if name, ok := keyNames[keys[0]]; ok && len(keys) == 1 {
+		widget.bindKey(name, fn, helpText)
+	} else {
+		widget.bind(keys, fn, helpText)
+	}
+}
+
+// bindKey maps a special key to the function
+func (widget *KeyboardWidget) bindKey(key tcell.Key, fn func(), helpText string) {
+	name := strings.ToLower(tcell.KeyNames[key])
+
+	if _, ok := widget.keyMap[key]; ok || widget.chords.IsPrefix(name) {
+		widget.addKeyError(KeyActionName(helpText), name, fmt.Errorf("key is already mapped to a keyboard command"))
+		return
+	}
+
+	widget.keyMap[key] = fn
+	widget.keyHelp = append(widget.keyHelp, helpItem{tcell.KeyNames[key], helpText, KeyActionName(helpText)})
+	widget.commands = append(widget.commands, PaletteCommand{Name: helpText, Action: fn})
+
+	if len(tcell.KeyNames[key]) > widget.maxKey {
+		widget.maxKey = len(tcell.KeyNames[key])
+	}
+}
+
+// handleKey runs the function mapped to the single key. Returns the event if no function
+// is mapped to it
+func (widget *KeyboardWidget) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	fn := widget.charMap[string(event.Rune())]
+	if fn != nil {
+		fn()
+		return nil
+	}
+
+	fn = widget.keyMap[event.Key()]
+	if fn != nil {
+		fn()
+		return nil
+	}
+
+	return event
+}
+
+// isBound returns TRUE if the single key is already mapped to a function
+func (widget *KeyboardWidget) isBound(key string) bool {
+	if key == "space" {
+		key = " "
+	}
+
+	if _, ok := widget.charMap[key]; ok {
+		return true
+	}
+
+	if name, ok := keyNames[key]; ok {
+		_, bound := widget.keyMap[name]
+		return bound
+	}
+
+	return false
+}
+
+func (widget *KeyboardWidget) addKeyError(action, sequence string, err error) {
+	widget.keyErrors = append(widget.keyErrors, cfg.NewKeyValidation(action, sequence, err))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
 	"github.com/rivo/tview"
 	"github.com/stretchr/testify/assert"
 	"github.com/wtfutil/wtf/cfg"
@@ -115,6 +116,34 @@ func Test_SetKeyboardKey(t *testing.T) {
 	}
 }
 
+func Test_SetKeyboardSequence(t *testing.T) {
+	tests := []struct {
+		name      string
+		sequence  string
+		char      string
+		key       tcell.Key
+		chord     string
+		expectErr bool
+	}{
+		{name: "character", sequence: "o", char: "o"},
+		{name: "special key", sequence: "ctrl-d", key: tcell.KeyCtrlD},
+		{name: "chord", sequence: "g g", chord: "g g"},
+		{name: "unknown key", sequence: "hyper-x", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyWid := testKeyboardWidget()
+			keyWid.SetKeyboardSequence(tt.sequence, test, "help")
+
+			assert.Equal(t, tt.char != "", keyWid.charMap[tt.char] != nil)
+			assert.Equal(t, tt.key != 0, keyWid.keyMap[tt.key] != nil)
+			assert.Equal(t, tt.chord != "", keyWid.chords.Bound(tt.chord))
+			assert.Equal(t, tt.expectErr, len(keyWid.KeyValidations()) > 0)
+		})
+	}
+}
+
 func Test_InputCapture(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -213,3 +242,52 @@ func Test_ShowHelp(t *testing.T) {
 
 	assert.NotPanics(t, func() { keyWid.ShowHelp() })
 }
+
+func Test_KeyRemapping(t *testing.T) {
+	moduleConfig, _ := config.ParseYaml("keys:\n  open-item: [O, \"g o\"]\n  select-next-item: ctrl-n\n  delete-item: \"bogus-key\"\n  unknown-action: x")
+
+	keyWid := NewKeyboardWidget(
+		tview.NewApplication(),
+		tview.NewPages(),
+		&cfg.Common{
+			Module: cfg.Module{Name: "testWidget", Type: "testType"},
+			Config: moduleConfig,
+		},
+	)
+
+	keyWid.SetKeyboardChar("o", test, "Open item")
+	keyWid.SetKeyboardKey(tcell.KeyEnter, test, "Open item")
+	keyWid.SetKeyboardChar("j", test, "Select next item")
+	keyWid.SetKeyboardChar("d", test, "Delete item")
+	keyWid.SetKeyboardChar("k", test, "Select previous item")
+	keyWid.SetKeyboardChar("k", test, "Select last item")
+
+	assert.Nil(t, keyWid.charMap["o"])
+	assert.Nil(t, keyWid.keyMap[tcell.KeyEnter])
+	assert.NotNil(t, keyWid.charMap["O"])
+	assert.NotNil(t, keyWid.keyMap[tcell.KeyCtrlN])
+	assert.Nil(t, keyWid.charMap["j"])
+	assert.Nil(t, keyWid.charMap["d"])
+	assert.NotNil(t, keyWid.charMap["k"])
+
+	assert.Nil(t, keyWid.InputCapture(tcell.NewEventKey(tcell.KeyRune, 'g', tcell.ModNone)))
+	assert.Nil(t, keyWid.InputCapture(tcell.NewEventKey(tcell.KeyRune, 'o', tcell.ModNone)))
+
+	assert.Contains(t, keyWid.HelpText(), "g o\tOpen item")
+	assert.Contains(t, keyWid.HelpText(), "Ctrl-N")
+
+	messages := []string{}
+	for _, validation := range keyWid.KeyValidations() {
+		messages = append(messages, validation.Error().Error())
+	}
+
+	assert.ElementsMatch(
+		t,
+		[]string{
+			`unknown key "bogus-key"`,
+			"key is already mapped to a keyboard command",
+			"no such action",
+		},
+		messages,
+	)
+}
@@ -0,0 +1,220 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+)
+
+// ErrNoSuchKeyAction is reported when the config remaps the keys of an action that does
+// not exist
+var ErrNoSuchKeyAction = errors.New("no such action")
+
+var actionNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// keyNames maps the lower-case name of every special key to the key, e.g. "ctrl-r"
+var keyNames = func() map[string]tcell.Key {
+	names := map[string]tcell.Key{}
+	for key, name := range tcell.KeyNames {
+		names[strings.ToLower(name)] = key
+	}
+	return names
+}()
+
+// Keymap maps key sequences to the actions they trigger. A sequence is a single key or a
+// chord of several keys pressed one after the other, written as space-separated key names:
+//
+//	"o"          <- a character
+//	"ctrl-r"     <- a special key, as named by tcell
+//	"g g"        <- a chord
+type Keymap struct {
+	actions  map[string]func()
+	prefixes map[string]bool
+	pending  []*tcell.EventKey
+}
+
+// NewKeymap creates and returns an empty Keymap
+func NewKeymap() *Keymap {
+	return &Keymap{
+		actions:  map[string]func(){},
+		prefixes: map[string]bool{},
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Bind maps the key sequence to the action. Returns an error if the sequence is already
+// bound, or if it would make another binding impossible to type
+func (keymap *Keymap) Bind(keys []string, fn func()) error {
+	sequence := strings.Join(keys, " ")
+
+	if _, ok := keymap.actions[sequence]; ok {
+		return fmt.Errorf("%q is already bound", sequence)
+	}
+
+	if keymap.prefixes[sequence] {
+		return fmt.Errorf("%q is the start of another key binding", sequence)
+	}
+
+	for i := 1; i < len(keys); i++ {
+		prefix := strings.Join(keys[:i], " ")
+		if _, ok := keymap.actions[prefix]; ok {
+			return fmt.Errorf("%q starts with %q, which is already bound", sequence, prefix)
+		}
+	}
+
+	keymap.actions[sequence] = fn
+
+	for i := 1; i < len(keys); i++ {
+		keymap.prefixes[strings.Join(keys[:i], " ")] = true
+	}
+
+	return nil
+}
+
+// Bound returns TRUE if the key sequence is bound to an action
+func (keymap *Keymap) Bound(sequence string) bool {
+	_, ok := keymap.actions[sequence]
+	return ok
+}
+
+// Handle runs the action bound to the key event, taking into account any keys of a chord
+// that were pressed before it. Returns the sequence that was run, or an empty string if the
+// event started or continued a chord, and whether or not the event was handled. If the event
+// abandons a chord, the key events held back by that chord are returned as well, so that
+// they can be passed on instead of being lost
+func (keymap *Keymap) Handle(event *tcell.EventKey) (string, bool, []*tcell.EventKey) {
+	events := append(keymap.pending, event)
+
+	keys := make([]string, len(events))
+	for idx, pressed := range events {
+		keys[idx] = EventKeyName(pressed)
+	}
+	sequence := strings.Join(keys, " ")
+
+	if fn, ok := keymap.actions[sequence]; ok {
+		keymap.pending = nil
+		fn()
+		return sequence, true, nil
+	}
+
+	if keymap.prefixes[sequence] {
+		keymap.pending = events
+		return "", true, nil
+	}
+
+	// The chord was abandoned, so try the key on its own
+	if len(keymap.pending) > 0 {
+		abandoned := keymap.pending
+		keymap.pending = nil
+
+		sequence, handled, _ := keymap.Handle(event)
+		return sequence, handled, abandoned
+	}
+
+	return "", false, nil
+}
+
+// IsPrefix returns TRUE if the key is the first key of a chord in the keymap
+func (keymap *Keymap) IsPrefix(key string) bool {
+	return keymap.prefixes[key]
+}
+
+// Pending returns TRUE if the keys of a chord have been pressed and the keymap is waiting
+// for the rest of it
+func (keymap *Keymap) Pending() bool {
+	return len(keymap.pending) > 0
+}
+
+// EventKeyName returns the name used in key sequences for the key event
+func EventKeyName(event *tcell.EventKey) string {
+	if event.Key() == tcell.KeyRune {
+		if event.Rune() == ' ' {
+			return "space"
+		}
+		return string(event.Rune())
+	}
+
+	if name, ok := tcell.KeyNames[event.Key()]; ok {
+		return strings.ToLower(name)
+	}
+
+	return fmt.Sprintf("key-%d", event.Key())
+}
+
+// KeyBindingsFromConfig returns the key sequences configured for each action under the
+// given config path. Each action may be bound to a single sequence or a list of them:
+//
+//	keys:
+//	  open-item-in-browser: "O"
+//	  select-next-item: ["j", "ctrl-n"]
+//	  refresh-widget: []          <- unbinds the action
+func KeyBindingsFromConfig(cfg *config.Config, path string) map[string][]string {
+	bindings := map[string][]string{}
+
+	if cfg == nil {
+		return bindings
+	}
+
+	actions, err := cfg.Map(path)
+	if err != nil {
+		return bindings
+	}
+
+	for action, value := range actions {
+		sequences := []string{}
+
+		switch value := value.(type) {
+		case string:
+			if value != "" {
+				sequences = append(sequences, value)
+			}
+		case []interface{}:
+			for _, sequence := range value {
+				sequences = append(sequences, fmt.Sprint(sequence))
+			}
+		default:
+			sequences = append(sequences, fmt.Sprint(value))
+		}
+
+		bindings[action] = sequences
+	}
+
+	return bindings
+}
+
+// KeyActionName returns the name that the action described by the help text is remapped
+// by in the config, e.g. "Open item in browser" is "open-item-in-browser"
+func KeyActionName(helpText string) string {
+	return strings.Trim(actionNameChars.ReplaceAllString(strings.ToLower(helpText), "-"), "-")
+}
+
+// ParseKeySequence splits a key sequence into its normalized key names, returning an error
+// if any of the keys are not recognized
+func ParseKeySequence(sequence string) ([]string, error) {
+	keys := strings.Fields(sequence)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("key sequence is empty")
+	}
+
+	for idx, key := range keys {
+		switch {
+		case len([]rune(key)) == 1:
+			// Single characters are case-sensitive
+		case strings.ToLower(key) == "space":
+			keys[idx] = "space"
+		default:
+			name := strings.ToLower(key)
+			if _, ok := keyNames[name]; !ok {
+				return nil, fmt.Errorf("unknown key %q", key)
+			}
+			keys[idx] = name
+		}
+	}
+
+	return keys, nil
+}
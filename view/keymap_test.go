@@ -0,0 +1,132 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseKeySequence(t *testing.T) {
+	tests := []struct {
+		name        string
+		sequence    string
+		expected    []string
+		expectedErr string
+	}{
+		{
+			name:     "with a character",
+			sequence: "O",
+			expected: []string{"O"},
+		},
+		{
+			name:     "with a special key",
+			sequence: "Ctrl-R",
+			expected: []string{"ctrl-r"},
+		},
+		{
+			name:     "with a chord",
+			sequence: "g  space enter",
+			expected: []string{"g", "space", "enter"},
+		},
+		{
+			name:        "with an unknown key",
+			sequence:    "hyper-x",
+			expectedErr: `unknown key "hyper-x"`,
+		},
+		{
+			name:        "with an empty sequence",
+			sequence:    " ",
+			expectedErr: "key sequence is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ParseKeySequence(tt.sequence)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_KeyActionName(t *testing.T) {
+	assert.Equal(t, "open-item-in-browser", KeyActionName("Open item in browser"))
+	assert.Equal(t, "show-hide-this-help-prompt", KeyActionName("Show/hide this help prompt"))
+}
+
+func Test_KeyBindingsFromConfig(t *testing.T) {
+	cfg, _ := config.ParseYaml("keys:\n  open: O\n  next: [j, \"g n\"]\n  refresh: []")
+
+	assert.Equal(
+		t,
+		map[string][]string{
+			"open":    {"O"},
+			"next":    {"j", "g n"},
+			"refresh": {},
+		},
+		KeyBindingsFromConfig(cfg, "keys"),
+	)
+	assert.Equal(t, map[string][]string{}, KeyBindingsFromConfig(nil, "keys"))
+}
+
+func Test_Keymap_Bind(t *testing.T) {
+	keymap := NewKeymap()
+
+	assert.NoError(t, keymap.Bind([]string{"ctrl-r"}, test))
+	assert.NoError(t, keymap.Bind([]string{"g", "g"}, test))
+
+	assert.EqualError(t, keymap.Bind([]string{"ctrl-r"}, test), `"ctrl-r" is already bound`)
+	assert.EqualError(t, keymap.Bind([]string{"g"}, test), `"g" is the start of another key binding`)
+	assert.EqualError(t, keymap.Bind([]string{"ctrl-r", "x"}, test), `"ctrl-r x" starts with "ctrl-r", which is already bound`)
+}
+
+func Test_Keymap_Handle(t *testing.T) {
+	ran := []string{}
+
+	keymap := NewKeymap()
+	_ = keymap.Bind([]string{"ctrl-r"}, func() { ran = append(ran, "refresh") })
+	_ = keymap.Bind([]string{"g", "g"}, func() { ran = append(ran, "top") })
+	_ = keymap.Bind([]string{"x"}, func() { ran = append(ran, "delete") })
+
+	press := func(key tcell.Key, char rune) (string, bool, []*tcell.EventKey) {
+		return keymap.Handle(tcell.NewEventKey(key, char, tcell.ModNone))
+	}
+
+	sequence, handled, abandoned := press(tcell.KeyCtrlR, 0)
+	assert.Equal(t, "ctrl-r", sequence)
+	assert.True(t, handled)
+	assert.Empty(t, abandoned)
+
+	sequence, handled, _ = press(tcell.KeyRune, 'g')
+	assert.Equal(t, "", sequence)
+	assert.True(t, handled)
+	assert.True(t, keymap.Pending())
+
+	sequence, handled, _ = press(tcell.KeyRune, 'g')
+	assert.Equal(t, "g g", sequence)
+	assert.True(t, handled)
+	assert.False(t, keymap.Pending())
+
+	// An abandoned chord falls back to the key on its own, and gives back the held keys
+	_, _, _ = press(tcell.KeyRune, 'g')
+	sequence, handled, abandoned = press(tcell.KeyRune, 'x')
+	assert.Equal(t, "x", sequence)
+	assert.True(t, handled)
+	assert.Len(t, abandoned, 1)
+	assert.Equal(t, 'g', abandoned[0].Rune())
+	assert.False(t, keymap.Pending())
+
+	_, _, _ = press(tcell.KeyRune, 'g')
+	_, handled, abandoned = press(tcell.KeyRune, 'q')
+	assert.False(t, handled)
+	assert.Len(t, abandoned, 1)
+
+	assert.Equal(t, []string{"refresh", "top", "delete"}, ran)
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+// regionTag matches the tags that start and end a highlightable region, e.g. ["3"] and [""]
+var regionTag = regexp.MustCompile(`\["([a-zA-Z0-9_,;: \-\.]*)"\]`)
+
+// RegionAt returns the ID of the highlightable region displayed on the given screen row of
+// the text view, or an empty string if there is none. Assumes that the text is not wrapped
+func RegionAt(textView *tview.TextView, y int) string {
+	_, innerY, _, height := textView.GetInnerRect()
+	if y < innerY || y >= innerY+height {
+		return ""
+	}
+
+	// The offset is negative until the text view has been drawn
+	offset, _ := textView.GetScrollOffset()
+	if offset < 0 {
+		offset = 0
+	}
+
+	line := offset + (y - innerY)
+
+	lines := strings.Split(textView.GetText(false), "\n")
+	if line >= len(lines) {
+		return ""
+	}
+
+	// A region can span several lines, so track which one is open at the start of each line
+	open := ""
+	for idx := 0; idx <= line; idx++ {
+		if idx == line && open != "" {
+			return open
+		}
+
+		for _, match := range regionTag.FindAllStringSubmatch(lines[idx], -1) {
+			if idx == line && match[1] != "" {
+				return match[1]
+			}
+			open = match[1]
+		}
+	}
+
+	return ""
+}
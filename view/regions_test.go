@@ -0,0 +1,70 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const regionText = `header
+["0"]first[""]
+["1"]second
+continued[""]
+plain`
+
+func Test_RegionAt(t *testing.T) {
+	textView := tview.NewTextView()
+	textView.SetRegions(true)
+	textView.SetText(regionText)
+	textView.SetRect(0, 2, 40, 6)
+
+	tests := []struct {
+		name     string
+		y        int
+		expected string
+	}{
+		{name: "above the view", y: 1, expected: ""},
+		{name: "line without a region", y: 2, expected: ""},
+		{name: "single-line region", y: 3, expected: "0"},
+		{name: "start of multi-line region", y: 4, expected: "1"},
+		{name: "end of multi-line region", y: 5, expected: "1"},
+		{name: "after the regions", y: 6, expected: ""},
+		{name: "past the end of the text", y: 7, expected: ""},
+		{name: "below the view", y: 8, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RegionAt(textView, tt.y))
+		})
+	}
+}
+
+func Test_SelectAt(t *testing.T) {
+	widget := NewScrollableWidget(tview.NewApplication(), &cfg.Common{})
+	widget.View.SetBorder(false)
+	widget.View.SetText(regionText)
+	widget.View.SetRect(0, 0, 40, 5)
+	widget.SetItemCount(2)
+
+	renders := 0
+	widget.SetRenderFunction(func() { renders++ })
+
+	opened := 0
+	widget.SetOpenFunction(func() { opened++ })
+
+	assert.False(t, widget.SelectAt(0))
+	assert.Equal(t, -1, widget.Selected)
+
+	widget.OpenSelected()
+	assert.Equal(t, 0, opened)
+
+	assert.True(t, widget.SelectAt(2))
+	assert.Equal(t, 1, widget.Selected)
+	assert.Equal(t, 1, renders)
+
+	widget.OpenSelected()
+	assert.Equal(t, 1, opened)
+}
@@ -12,6 +12,7 @@ type ScrollableWidget struct {
 
 	Selected       int
 	maxItems       int
+	openFunction   func()
 	RenderFunction func()
 }
 
@@ -33,6 +34,12 @@ func (widget *ScrollableWidget) SetRenderFunction(displayFunc func()) {
 	widget.RenderFunction = displayFunc
 }
 
+// SetOpenFunction sets the function that opens the selected item, which is run when an item
+// is double-clicked
+func (widget *ScrollableWidget) SetOpenFunction(openFunc func()) {
+	widget.openFunction = openFunc
+}
+
 func (widget *ScrollableWidget) SetItemCount(items int) {
 	widget.maxItems = items
 	if items == 0 {
@@ -74,6 +81,29 @@ func (widget *ScrollableWidget) Prev() {
 	widget.RenderFunction()
 }
 
+// OpenSelected opens the selected item, if there is one and the widget knows how to open it
+func (widget *ScrollableWidget) OpenSelected() {
+	if widget.openFunction != nil && widget.Selected >= 0 {
+		widget.openFunction()
+	}
+}
+
+// SelectAt selects the item displayed on the given screen row. Returns FALSE if there is
+// no item on that row
+func (widget *ScrollableWidget) SelectAt(y int) bool {
+	idx, err := strconv.Atoi(RegionAt(widget.View, y))
+	if err != nil || idx < 0 || idx >= widget.maxItems {
+		return false
+	}
+
+	widget.Selected = idx
+	if widget.RenderFunction != nil {
+		widget.RenderFunction()
+	}
+
+	return true
+}
+
 func (widget *ScrollableWidget) Unselect() {
 	widget.Selected = -1
 	if widget.RenderFunction != nil {
@@ -0,0 +1,316 @@
+package view
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+const (
+	// SparklineBlocks draws each sample as a column of block characters: ▁▂▃▄▅▆▇█
+	SparklineBlocks = "blocks"
+	// SparklineBraille draws the samples as a line of braille dots, two samples per character
+	SparklineBraille = "braille"
+)
+
+// sparkBlocks are the block characters for an eighth of a row up to a full row
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// brailleDots are the bits of a braille character's dots, by column and then by row from the
+// bottom up
+var brailleDots = [2][4]rune{
+	{0x40, 0x04, 0x02, 0x01},
+	{0x80, 0x20, 0x10, 0x08},
+}
+
+// Sparkline keeps a rolling history of numeric samples, such as a price or a response time,
+// and draws it as a small chart that fits in a line of text. Once it holds its capacity, each
+// new sample pushes out the oldest one. It is safe to add samples while the chart is drawn
+type Sparkline struct {
+	capacity int
+	fixed    bool
+	floor    float64
+	ceiling  float64
+	mutex    sync.Mutex
+	samples  []float64
+}
+
+// NewSparkline creates and returns a Sparkline that keeps the most recent capacity samples
+func NewSparkline(capacity int) *Sparkline {
+	return &Sparkline{
+		capacity: capacity,
+		samples:  []float64{},
+	}
+}
+
+// ValidateSparklineStyle returns an error if the style is not one that sparklines can be
+// drawn in
+func ValidateSparklineStyle(style string) error {
+	switch style {
+	case SparklineBlocks, SparklineBraille:
+		return nil
+	default:
+		return fmt.Errorf("unknown chart style %q, must be %s or %s", style, SparklineBlocks, SparklineBraille)
+	}
+}
+
+// SparklineWidth returns how many characters wide a chart of the given number of samples is
+// when drawn in the style
+func SparklineWidth(style string, samples int) int {
+	if style == SparklineBraille {
+		return (samples + 1) / 2
+	}
+
+	return samples
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Add appends a sample to the history, dropping the oldest one if it is full
+func (sparkline *Sparkline) Add(sample float64) {
+	sparkline.mutex.Lock()
+	defer sparkline.mutex.Unlock()
+
+	sparkline.samples = append(sparkline.samples, sample)
+	if sparkline.capacity > 0 && len(sparkline.samples) > sparkline.capacity {
+		sparkline.samples = sparkline.samples[len(sparkline.samples)-sparkline.capacity:]
+	}
+}
+
+// Len returns the number of samples in the history
+func (sparkline *Sparkline) Len() int {
+	sparkline.mutex.Lock()
+	defer sparkline.mutex.Unlock()
+
+	return len(sparkline.samples)
+}
+
+// Reset empties the history
+func (sparkline *Sparkline) Reset() {
+	sparkline.mutex.Lock()
+	defer sparkline.mutex.Unlock()
+
+	sparkline.samples = []float64{}
+}
+
+// Samples returns the samples in the history, oldest first
+func (sparkline *Sparkline) Samples() []float64 {
+	sparkline.mutex.Lock()
+	defer sparkline.mutex.Unlock()
+
+	return append([]float64{}, sparkline.samples...)
+}
+
+// SetRange fixes the values drawn at the bottom and the top of the chart, such as 0 and 100
+// for percentages. Without a range, the chart is scaled to the samples it draws
+func (sparkline *Sparkline) SetRange(floor, ceiling float64) {
+	sparkline.mutex.Lock()
+	defer sparkline.mutex.Unlock()
+
+	sparkline.fixed = true
+	sparkline.floor = floor
+	sparkline.ceiling = ceiling
+}
+
+// Min returns the smallest sample in the history, or 0 if it is empty
+func (sparkline *Sparkline) Min() float64 {
+	min, _, _ := sparkline.stats()
+	return min
+}
+
+// Max returns the largest sample in the history, or 0 if it is empty
+func (sparkline *Sparkline) Max() float64 {
+	_, max, _ := sparkline.stats()
+	return max
+}
+
+// Avg returns the mean of the samples in the history, or 0 if it is empty
+func (sparkline *Sparkline) Avg() float64 {
+	_, _, avg := sparkline.stats()
+	return avg
+}
+
+// Render draws the most recent samples as a chart width characters wide and height lines
+// tall, in the given style, newest on the right. A history too short to fill the chart is
+// padded on the left with spaces
+func (sparkline *Sparkline) Render(style string, width, height int) string {
+	if width < 1 || height < 1 {
+		return ""
+	}
+
+	perChar := 1
+	if style == SparklineBraille {
+		perChar = 2
+	}
+
+	samples := sparkline.Samples()
+	if len(samples) > width*perChar {
+		samples = samples[len(samples)-width*perChar:]
+	}
+
+	floor, ceiling := sparkline.scale(samples)
+
+	if style == SparklineBraille {
+		return strings.Join(renderBraille(samples, floor, ceiling, width, height), "\n")
+	}
+
+	return strings.Join(renderBlocks(samples, floor, ceiling, width, height), "\n")
+}
+
+// Summary returns the smallest, largest and mean samples in the history, each formatted
+// with the format, such as "min 1.20 max 3.40 avg 2.05". Returns an empty string if there
+// are no samples
+func (sparkline *Sparkline) Summary(format string) string {
+	if sparkline.Len() == 0 {
+		return ""
+	}
+
+	min, max, avg := sparkline.stats()
+
+	return fmt.Sprintf("min %s max %s avg %s", fmt.Sprintf(format, min), fmt.Sprintf(format, max), fmt.Sprintf(format, avg))
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// scale returns the values drawn at the bottom and the top of the chart
+func (sparkline *Sparkline) scale(samples []float64) (float64, float64) {
+	sparkline.mutex.Lock()
+	fixed, floor, ceiling := sparkline.fixed, sparkline.floor, sparkline.ceiling
+	sparkline.mutex.Unlock()
+
+	if fixed {
+		return floor, ceiling
+	}
+
+	if len(samples) == 0 {
+		return 0, 0
+	}
+
+	floor, ceiling = samples[0], samples[0]
+	for _, sample := range samples {
+		floor = math.Min(floor, sample)
+		ceiling = math.Max(ceiling, sample)
+	}
+
+	return floor, ceiling
+}
+
+func (sparkline *Sparkline) stats() (float64, float64, float64) {
+	sparkline.mutex.Lock()
+	defer sparkline.mutex.Unlock()
+
+	if len(sparkline.samples) == 0 {
+		return 0, 0, 0
+	}
+
+	min, max, sum := sparkline.samples[0], sparkline.samples[0], 0.0
+	for _, sample := range sparkline.samples {
+		min = math.Min(min, sample)
+		max = math.Max(max, sample)
+		sum += sample
+	}
+
+	return min, max, sum / float64(len(sparkline.samples))
+}
+
+// sparkLevel returns where the sample falls between the floor and the ceiling, from 0 to
+// steps-1. When every sample is the same they're drawn halfway up
+func sparkLevel(sample, floor, ceiling float64, steps int) int {
+	if ceiling <= floor {
+		return (steps - 1) / 2
+	}
+
+	ratio := (sample - floor) / (ceiling - floor)
+	ratio = math.Max(0, math.Min(1, ratio))
+
+	return int(math.Round(ratio * float64(steps-1)))
+}
+
+// renderBlocks returns the lines, from the top down, of a chart that draws each sample as a
+// column of blocks. Even the smallest sample gets a sliver, so that it can be seen
+func renderBlocks(samples []float64, floor, ceiling float64, width, height int) []string {
+	lines := make([][]rune, height)
+	for row := range lines {
+		lines[row] = []rune(strings.Repeat(" ", width))
+	}
+
+	offset := width - len(samples)
+	steps := len(sparkBlocks) * height
+
+	for idx, sample := range samples {
+		filled := sparkLevel(sample, floor, ceiling, steps) + 1
+
+		for row := 0; row < height; row++ {
+			fill := filled - row*len(sparkBlocks)
+			if fill <= 0 {
+				break
+			}
+			if fill > len(sparkBlocks) {
+				fill = len(sparkBlocks)
+			}
+
+			lines[height-1-row][offset+idx] = sparkBlocks[fill-1]
+		}
+	}
+
+	return runeLines(lines)
+}
+
+// renderBraille returns the lines, from the top down, of a chart that draws the samples as
+// a line of braille dots. Each sample is joined to the one before it by a vertical run of
+// dots
+func renderBraille(samples []float64, floor, ceiling float64, width, height int) []string {
+	dots := make([][]rune, height)
+	for row := range dots {
+		dots[row] = make([]rune, width)
+	}
+
+	offset := width*2 - len(samples)
+	steps := 4 * height
+	previous := -1
+
+	for idx, sample := range samples {
+		y := sparkLevel(sample, floor, ceiling, steps)
+		x := offset + idx
+
+		// Fill the gap to the previous sample's dot, without redrawing that dot
+		from, to := y, y
+		if previous >= 0 && previous < y-1 {
+			from = previous + 1
+		}
+		if previous > y+1 {
+			to = previous - 1
+		}
+
+		for dotY := from; dotY <= to; dotY++ {
+			row := height - 1 - dotY/4
+			dots[row][x/2] |= brailleDots[x%2][dotY%4]
+		}
+
+		previous = y
+	}
+
+	lines := make([][]rune, height)
+	for row := range dots {
+		lines[row] = make([]rune, width)
+		for col, bits := range dots[row] {
+			if bits == 0 {
+				lines[row][col] = ' '
+			} else {
+				lines[row][col] = 0x2800 + bits
+			}
+		}
+	}
+
+	return runeLines(lines)
+}
+
+func runeLines(lines [][]rune) []string {
+	strs := make([]string, len(lines))
+	for idx, line := range lines {
+		strs[idx] = string(line)
+	}
+
+	return strs
+}
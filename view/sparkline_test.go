@@ -0,0 +1,135 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sparkline_Add(t *testing.T) {
+	sparkline := NewSparkline(3)
+
+	for _, sample := range []float64{1, 2, 3, 4} {
+		sparkline.Add(sample)
+	}
+
+	assert.Equal(t, []float64{2, 3, 4}, sparkline.Samples())
+	assert.Equal(t, 3, sparkline.Len())
+
+	sparkline.Reset()
+	assert.Equal(t, 0, sparkline.Len())
+}
+
+func Test_Sparkline_Stats(t *testing.T) {
+	sparkline := NewSparkline(10)
+	assert.Equal(t, "", sparkline.Summary("%.1f"))
+
+	for _, sample := range []float64{4, 1, 7} {
+		sparkline.Add(sample)
+	}
+
+	assert.Equal(t, 1.0, sparkline.Min())
+	assert.Equal(t, 7.0, sparkline.Max())
+	assert.Equal(t, 4.0, sparkline.Avg())
+	assert.Equal(t, "min 1.0 max 7.0 avg 4.0", sparkline.Summary("%.1f"))
+}
+
+func Test_ValidateSparklineStyle(t *testing.T) {
+	assert.NoError(t, ValidateSparklineStyle(SparklineBlocks))
+	assert.NoError(t, ValidateSparklineStyle(SparklineBraille))
+	assert.EqualError(t, ValidateSparklineStyle("bars"), `unknown chart style "bars", must be blocks or braille`)
+}
+
+func Test_Sparkline_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []float64
+		fixed    bool
+		style    string
+		width    int
+		height   int
+		expected string
+	}{
+		{
+			name:     "with no samples",
+			samples:  []float64{},
+			style:    SparklineBlocks,
+			width:    3,
+			height:   1,
+			expected: "   ",
+		},
+		{
+			name:     "with blocks",
+			samples:  []float64{0, 1, 2, 3, 4, 5, 6, 7},
+			style:    SparklineBlocks,
+			width:    8,
+			height:   1,
+			expected: "▁▂▃▄▅▆▇█",
+		},
+		{
+			name:     "with more samples than fit",
+			samples:  []float64{9, 0, 7},
+			style:    SparklineBlocks,
+			width:    2,
+			height:   1,
+			expected: "▁█",
+		},
+		{
+			name:     "with fewer samples than fit",
+			samples:  []float64{0, 50, 100},
+			fixed:    true,
+			style:    SparklineBlocks,
+			width:    4,
+			height:   1,
+			expected: " ▁▅█",
+		},
+		{
+			name:     "with blocks two lines tall",
+			samples:  []float64{0, 100},
+			fixed:    true,
+			style:    SparklineBlocks,
+			width:    2,
+			height:   2,
+			expected: " █\n▁█",
+		},
+		{
+			name:     "with identical samples",
+			samples:  []float64{5, 5},
+			style:    SparklineBlocks,
+			width:    2,
+			height:   1,
+			expected: "▄▄",
+		},
+		{
+			name:     "with braille",
+			samples:  []float64{0, 1, 2, 3},
+			style:    SparklineBraille,
+			width:    2,
+			height:   1,
+			expected: "⡠⠊",
+		},
+		{
+			name:     "with braille joining distant samples",
+			samples:  []float64{0, 3},
+			style:    SparklineBraille,
+			width:    1,
+			height:   1,
+			expected: "⡸",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sparkline := NewSparkline(10)
+			if tt.fixed {
+				sparkline.SetRange(0, 100)
+			}
+
+			for _, sample := range tt.samples {
+				sparkline.Add(sample)
+			}
+
+			assert.Equal(t, tt.expected, sparkline.Render(tt.style, tt.width, tt.height))
+		})
+	}
+}
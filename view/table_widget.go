@@ -0,0 +1,562 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gdamore/tcell"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const filterModalHeight = 5
+
+// ColumnType is the kind of values a table column holds, which decides how the column is
+// sorted and aligned
+type ColumnType int
+
+const (
+	// ColumnText cells are sorted alphabetically, ignoring case, and aligned left
+	ColumnText ColumnType = iota
+	// ColumnNumber cells are sorted numerically and aligned right
+	ColumnNumber
+	// ColumnTime cells are sorted chronologically and aligned left
+	ColumnTime
+)
+
+// TableColumn describes one column of a TableWidget. The column is as wide as its widest
+// cell, up to MaxWidth if that is set. Longer cells are cut short with an ellipsis
+type TableColumn struct {
+	MaxWidth int
+	Name     string
+	Type     ColumnType
+}
+
+// TableCell is one cell of a table row. Text is what is displayed. Value, if set, is what
+// the cell is sorted by: a number for number columns or a time.Time for time columns.
+// Color, if set, overrides the row's color for this cell
+type TableCell struct {
+	Color string
+	Text  string
+	Value interface{}
+}
+
+// TableRow is one row of a table. Item is the data the row displays, such as a torrent,
+// so that the module can act on the selected row however the rows are sorted or filtered
+type TableRow struct {
+	Cells []TableCell
+	Item  interface{}
+}
+
+// TableWidget is a ScrollableWidget that displays rows of data as a table of columns, with
+// a header row. Rows can be sorted by any column and filtered by what their cells contain,
+// and the selection follows its row when they are. Modules declare the columns, hand it
+// the rows and bind its actions to keys:
+//
+//	widget.SetKeyboardChar("s", widget.SortNext, "Sort by the next column")
+//	widget.SetKeyboardChar("S", widget.SortReverse, "Reverse the sort order")
+//	widget.SetKeyboardChar("f", widget.ShowFilter, "Filter the rows")
+type TableWidget struct {
+	ScrollableWidget
+
+	columns    []TableColumn
+	filter     string
+	mutex      *sync.Mutex
+	pages      *tview.Pages
+	rows       []TableRow
+	sortColumn int
+	sortDesc   bool
+	visible    []int
+}
+
+// NewTableWidget creates and returns an empty, unsorted TableWidget with the given columns
+func NewTableWidget(app *tview.Application, pages *tview.Pages, commonSettings *cfg.Common, columns []TableColumn) TableWidget {
+	widget := TableWidget{
+		ScrollableWidget: NewScrollableWidget(app, commonSettings),
+
+		columns:    columns,
+		mutex:      &sync.Mutex{},
+		pages:      pages,
+		rows:       []TableRow{},
+		sortColumn: -1,
+		visible:    []int{},
+	}
+
+	return widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Filter returns the text that rows are filtered by
+func (widget *TableWidget) Filter() string {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	return widget.filter
+}
+
+// Rows returns the rows that are displayed, in the order they're displayed
+func (widget *TableWidget) Rows() []TableRow {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	rows := []TableRow{}
+	for _, idx := range widget.visible {
+		rows = append(rows, widget.rows[idx])
+	}
+
+	return rows
+}
+
+// SelectedRow returns the selected row, or nil if no row is selected
+func (widget *TableWidget) SelectedRow() *TableRow {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	if widget.Selected < 0 || widget.Selected >= len(widget.visible) {
+		return nil
+	}
+
+	row := widget.rows[widget.visible[widget.Selected]]
+
+	return &row
+}
+
+// SetFilter only displays the rows with a cell that contains the filter, ignoring case. An
+// empty filter displays every row
+func (widget *TableWidget) SetFilter(filter string) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	widget.filter = filter
+	widget.arrangeRows()
+}
+
+// SetRows replaces the rows of the table, sorting and filtering them as the table already
+// is. The selection stays on the row set at the same index as the one that was selected,
+// which is the same item as long as the rows are set in a consistent order
+func (widget *TableWidget) SetRows(rows []TableRow) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	widget.rows = rows
+	widget.arrangeRows()
+}
+
+// SortBy sorts the rows by the column at the given index. A column index of -1 displays the
+// rows in the order they were set
+func (widget *TableWidget) SortBy(column int, descending bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	if column < -1 || column >= len(widget.columns) {
+		column = -1
+	}
+
+	widget.sortColumn = column
+	widget.sortDesc = descending
+	widget.arrangeRows()
+}
+
+// SortColumn returns the index of the column the rows are sorted by, or -1 if they are not
+// sorted, and whether they are sorted in descending order
+func (widget *TableWidget) SortColumn() (int, bool) {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	return widget.sortColumn, widget.sortDesc
+}
+
+// SortNext sorts the rows by the next column, in ascending order. After the last column the
+// rows go back to the order they were set in
+func (widget *TableWidget) SortNext() {
+	column, _ := widget.SortColumn()
+
+	column++
+	if column >= len(widget.columns) {
+		column = -1
+	}
+
+	widget.SortBy(column, false)
+	widget.render()
+}
+
+// SortReverse reverses the order the rows are sorted in. Unsorted rows are sorted by the
+// first column, in descending order
+func (widget *TableWidget) SortReverse() {
+	column, descending := widget.SortColumn()
+
+	if column < 0 {
+		column = 0
+		descending = false
+	}
+
+	widget.SortBy(column, !descending)
+	widget.render()
+}
+
+// ShowFilter opens a prompt that filters the rows as the filter is typed. Enter keeps the
+// filter, Esc puts back the filter the rows had before
+func (widget *TableWidget) ShowFilter() {
+	previous := widget.Filter()
+
+	closeFunc := func() {
+		widget.pages.RemovePage("filter")
+		widget.app.SetFocus(widget.View)
+	}
+
+	input := tview.NewInputField()
+	input.SetLabel("Filter: ")
+	input.SetFieldBackgroundColor(tcell.ColorDefault)
+	input.SetText(previous)
+	input.SetChangedFunc(func(text string) {
+		widget.SetFilter(text)
+		widget.render()
+	})
+	input.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEsc {
+			widget.SetFilter(previous)
+			widget.render()
+		}
+
+		closeFunc()
+	})
+
+	frame := tview.NewFrame(input)
+	frame.SetRect(offscreen, offscreen, modalWidth, filterModalHeight)
+
+	drawFunc := func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		w, h := screen.Size()
+		frame.SetRect((w/2)-(width/2), (h/2)-(height/2), width, height)
+		return x, y, width, height
+	}
+
+	frame.SetBorder(true)
+	frame.SetBorders(1, 1, 0, 0, 1, 1)
+	frame.SetDrawFunc(drawFunc)
+
+	widget.pages.AddPage("filter", frame, false, true)
+	widget.app.SetFocus(frame)
+
+	widget.app.QueueUpdate(func() {
+		widget.app.Draw()
+	})
+}
+
+// TableContent returns the header row and the displayed rows, laid out in columns and
+// colored, for the widget's display function to draw
+func (widget *TableWidget) TableContent() string {
+	widget.mutex.Lock()
+	defer widget.mutex.Unlock()
+
+	headers := make([]string, len(widget.columns))
+	for col, column := range widget.columns {
+		headers[col] = column.Name
+		if col == widget.sortColumn {
+			headers[col] += sortArrow(widget.sortDesc)
+		}
+	}
+
+	widths := widget.columnWidths(headers)
+
+	headerCells := make([]TableCell, len(headers))
+	for col, header := range headers {
+		headerCells[col] = TableCell{Text: header}
+	}
+
+	header, _ := widget.layoutRow(headerCells, widths, "")
+	str := fmt.Sprintf("[%s::b]%s[-::-]\n", widget.CommonSettings().Colors.Subheading, header)
+
+	for pos, idx := range widget.visible {
+		rowColor := widget.RowColor(pos)
+		row, width := widget.layoutRow(widget.rows[idx].Cells, widths, rowColor)
+
+		str += utils.HighlightableHelper(widget.View, fmt.Sprintf("[%s]%s[-:-:-]", rowColor, row), pos, width)
+	}
+
+	return str
+}
+
+// TableTitle returns the title with the filter, if the rows are filtered, added to it
+func (widget *TableWidget) TableTitle(title string) string {
+	filter := widget.Filter()
+	if filter == "" {
+		return title
+	}
+
+	return fmt.Sprintf("%s (%s)", title, tview.Escape(filter))
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// arrangeRows works out which rows are displayed, and in what order, from the filter and
+// the sort column. The caller must hold the mutex
+func (widget *TableWidget) arrangeRows() {
+	selected := -1
+	if widget.Selected >= 0 && widget.Selected < len(widget.visible) {
+		selected = widget.visible[widget.Selected]
+	}
+
+	filter := strings.ToLower(widget.filter)
+
+	widget.visible = []int{}
+	for idx, row := range widget.rows {
+		if rowMatches(row, filter) {
+			widget.visible = append(widget.visible, idx)
+		}
+	}
+
+	if widget.sortColumn >= 0 {
+		column := widget.columns[widget.sortColumn]
+
+		sort.SliceStable(widget.visible, func(i, j int) bool {
+			a := cellAt(widget.rows[widget.visible[i]], widget.sortColumn)
+			b := cellAt(widget.rows[widget.visible[j]], widget.sortColumn)
+
+			if widget.sortDesc {
+				return compareCells(a, b, column.Type) > 0
+			}
+
+			return compareCells(a, b, column.Type) < 0
+		})
+	}
+
+	widget.SetItemCount(len(widget.visible))
+
+	if widget.Selected < 0 {
+		return
+	}
+
+	// Keep the same row selected, or clear the selection if that row is no longer displayed
+	widget.Selected = -1
+	for pos, idx := range widget.visible {
+		if idx == selected {
+			widget.Selected = pos
+			break
+		}
+	}
+}
+
+// columnWidths returns how wide each column is, to fit its header and its displayed cells.
+// The caller must hold the mutex
+func (widget *TableWidget) columnWidths(headers []string) []int {
+	widths := make([]int, len(widget.columns))
+
+	for col, column := range widget.columns {
+		widths[col] = utf8.RuneCountInString(headers[col])
+
+		for _, idx := range widget.visible {
+			widths[col] = utils.MaxInt(widths[col], utf8.RuneCountInString(cellAt(widget.rows[idx], col).Text))
+		}
+
+		if column.MaxWidth > 0 && widths[col] > column.MaxWidth {
+			widths[col] = column.MaxWidth
+		}
+	}
+
+	return widths
+}
+
+// layoutRow returns the cells fitted to the column widths and joined into a line, along
+// with how many characters wide the line is. Cells with their own color go back to the
+// row's color after them
+func (widget *TableWidget) layoutRow(cells []TableCell, widths []int, rowColor string) (string, int) {
+	parts := make([]string, len(widget.columns))
+	width := 0
+
+	for col, column := range widget.columns {
+		cell := TableCell{}
+		if col < len(cells) {
+			cell = cells[col]
+		}
+
+		text := fitCell(cell.Text, widths[col], column.Type == ColumnNumber)
+
+		// The last column isn't padded, so that it doesn't leave trailing spaces
+		if col == len(widget.columns)-1 && column.Type != ColumnNumber {
+			text = strings.TrimRight(text, " ")
+		}
+
+		width += utf8.RuneCountInString(text)
+		text = tview.Escape(text)
+
+		if cell.Color != "" {
+			text = fmt.Sprintf("[%s]%s[%s]", cell.Color, text, resetStyle(rowColor))
+		}
+
+		parts[col] = text
+	}
+
+	width += len(parts) - 1
+
+	return strings.Join(parts, " "), width
+}
+
+func (widget *TableWidget) render() {
+	if widget.RenderFunction != nil {
+		widget.RenderFunction()
+	}
+}
+
+// cellAt returns the row's cell in the given column, or an empty cell if the row is short
+func cellAt(row TableRow, col int) TableCell {
+	if col < len(row.Cells) {
+		return row.Cells[col]
+	}
+
+	return TableCell{}
+}
+
+// compareCells returns a negative number if a sorts before b, a positive number if it sorts
+// after b, and 0 if they sort the same. Numbers that can't be read sort before all others
+func compareCells(a, b TableCell, columnType ColumnType) int {
+	switch columnType {
+	case ColumnNumber:
+		aNum, aOk := cellNumber(a)
+		bNum, bOk := cellNumber(b)
+
+		switch {
+		case !aOk || !bOk:
+			return boolCompare(aOk, bOk)
+		case aNum < bNum:
+			return -1
+		case aNum > bNum:
+			return 1
+		}
+
+		return 0
+	case ColumnTime:
+		aTime, aOk := a.Value.(time.Time)
+		bTime, bOk := b.Value.(time.Time)
+
+		switch {
+		case !aOk && !bOk:
+			// Neither has a time, so they sort by their text
+		case !aOk || !bOk:
+			return boolCompare(aOk, bOk)
+		case aTime.Before(bTime):
+			return -1
+		case aTime.After(bTime):
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	return strings.Compare(strings.ToLower(cellString(a)), strings.ToLower(cellString(b)))
+}
+
+func boolCompare(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+
+	return -1
+}
+
+// cellNumber returns the number a cell sorts by: its value if it has one, otherwise its
+// text read as a number, ignoring a trailing percent sign
+func cellNumber(cell TableCell) (float64, bool) {
+	switch value := cell.Value.(type) {
+	case float64:
+		return value, true
+	case float32:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case int32:
+		return float64(value), true
+	case uint:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	case uint32:
+		return float64(value), true
+	}
+
+	num, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(cell.Text), "%"), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return num, true
+}
+
+func cellString(cell TableCell) string {
+	if str, ok := cell.Value.(string); ok {
+		return str
+	}
+
+	return cell.Text
+}
+
+// fitCell pads the text to the width, on the left if it is right-aligned, or cuts it short
+// with an ellipsis if it is too long
+func fitCell(text string, width int, rightAlign bool) string {
+	runes := []rune(text)
+
+	if len(runes) > width {
+		if width <= 1 {
+			return string(runes[:width])
+		}
+
+		return string(runes[:width-1]) + "…"
+	}
+
+	padding := strings.Repeat(" ", width-len(runes))
+	if rightAlign {
+		return padding + text
+	}
+
+	return text + padding
+}
+
+// resetStyle returns the style tag contents that go back to the row's color, clearing any
+// attributes, such as bold, that a cell's color set
+func resetStyle(rowColor string) string {
+	if rowColor == "" {
+		rowColor = "-"
+	}
+
+	switch strings.Count(rowColor, ":") {
+	case 0:
+		return rowColor + ":-:-"
+	case 1:
+		return rowColor + ":-"
+	}
+
+	return rowColor
+}
+
+func rowMatches(row TableRow, filter string) bool {
+	if filter == "" {
+		return true
+	}
+
+	for _, cell := range row.Cells {
+		if strings.Contains(strings.ToLower(cell.Text), filter) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortArrow(descending bool) string {
+	if descending {
+		return "▼"
+	}
+
+	return "▲"
+}
@@ -0,0 +1,270 @@
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+func testTableWidget() TableWidget {
+	widget := NewTableWidget(
+		tview.NewApplication(),
+		tview.NewPages(),
+		&cfg.Common{
+			Module: cfg.Module{
+				Name: "test widget",
+			},
+		},
+		[]TableColumn{
+			{Name: "Name", Type: ColumnText},
+			{Name: "Size", Type: ColumnNumber},
+		},
+	)
+
+	widget.SetRows([]TableRow{
+		{Cells: []TableCell{{Text: "beta"}, {Text: "10", Value: 10}}, Item: "b"},
+		{Cells: []TableCell{{Text: "Alpha"}, {Text: "2", Value: 2}}, Item: "a"},
+		{Cells: []TableCell{{Text: "gamma"}, {Text: "300", Value: 300}}, Item: "g"},
+	})
+
+	return widget
+}
+
+func tableItems(widget *TableWidget) []interface{} {
+	items := []interface{}{}
+	for _, row := range widget.Rows() {
+		items = append(items, row.Item)
+	}
+
+	return items
+}
+
+func Test_TableWidget_SortBy(t *testing.T) {
+	tests := []struct {
+		name       string
+		column     int
+		descending bool
+		expected   []interface{}
+	}{
+		{
+			name:     "unsorted",
+			column:   -1,
+			expected: []interface{}{"b", "a", "g"},
+		},
+		{
+			name:     "by text, ignoring case",
+			column:   0,
+			expected: []interface{}{"a", "b", "g"},
+		},
+		{
+			name:       "by text, descending",
+			column:     0,
+			descending: true,
+			expected:   []interface{}{"g", "b", "a"},
+		},
+		{
+			name:     "by number",
+			column:   1,
+			expected: []interface{}{"a", "b", "g"},
+		},
+		{
+			name:       "by number, descending",
+			column:     1,
+			descending: true,
+			expected:   []interface{}{"g", "b", "a"},
+		},
+		{
+			name:     "with a column that doesn't exist",
+			column:   5,
+			expected: []interface{}{"b", "a", "g"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widget := testTableWidget()
+			widget.SortBy(tt.column, tt.descending)
+
+			assert.Equal(t, tt.expected, tableItems(&widget))
+		})
+	}
+}
+
+func Test_TableWidget_SortNext(t *testing.T) {
+	widget := testTableWidget()
+
+	widget.SortNext()
+	column, descending := widget.SortColumn()
+	assert.Equal(t, 0, column)
+	assert.False(t, descending)
+
+	widget.SortReverse()
+	column, descending = widget.SortColumn()
+	assert.Equal(t, 0, column)
+	assert.True(t, descending)
+
+	widget.SortNext()
+	widget.SortNext()
+	column, _ = widget.SortColumn()
+	assert.Equal(t, -1, column)
+}
+
+func Test_TableWidget_SetFilter(t *testing.T) {
+	widget := testTableWidget()
+
+	widget.SetFilter("MM")
+	assert.Equal(t, []interface{}{"g"}, tableItems(&widget))
+
+	widget.SetFilter("nothing")
+	assert.Equal(t, []interface{}{}, tableItems(&widget))
+	assert.Nil(t, widget.SelectedRow())
+
+	widget.SetFilter("")
+	assert.Equal(t, []interface{}{"b", "a", "g"}, tableItems(&widget))
+}
+
+func Test_TableWidget_SelectedRow(t *testing.T) {
+	widget := testTableWidget()
+	assert.Nil(t, widget.SelectedRow())
+
+	widget.Selected = 0
+	assert.Equal(t, "b", widget.SelectedRow().Item)
+
+	// The selection follows its row when the rows are sorted
+	widget.SortBy(0, false)
+	assert.Equal(t, 1, widget.Selected)
+	assert.Equal(t, "b", widget.SelectedRow().Item)
+
+	// and is cleared when its row is filtered out
+	widget.SetFilter("alpha")
+	assert.Equal(t, -1, widget.Selected)
+	assert.Nil(t, widget.SelectedRow())
+}
+
+func Test_TableWidget_TableContent(t *testing.T) {
+	widget := testTableWidget()
+	widget.SortBy(1, true)
+
+	lines := strings.Split(widget.TableContent(), "\n")
+
+	assert.Equal(t, 5, len(lines))
+	assert.Equal(t, "[::b]Name  Size▼[-::-]", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], `["0"][""][]gamma   300[-:-:-]`))
+	assert.True(t, strings.HasPrefix(lines[2], `["1"][""][]beta     10[-:-:-]`))
+	assert.True(t, strings.HasPrefix(lines[3], `["2"][""][]Alpha     2[-:-:-]`))
+}
+
+func Test_TableWidget_TableTitle(t *testing.T) {
+	widget := testTableWidget()
+	assert.Equal(t, "Torrents", widget.TableTitle("Torrents"))
+
+	widget.SetFilter("ubuntu")
+	assert.Equal(t, "Torrents (ubuntu)", widget.TableTitle("Torrents"))
+}
+
+func Test_compareCells(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		a          TableCell
+		b          TableCell
+		columnType ColumnType
+		expected   int
+	}{
+		{
+			name:       "text",
+			a:          TableCell{Text: "apple"},
+			b:          TableCell{Text: "Banana"},
+			columnType: ColumnText,
+			expected:   -1,
+		},
+		{
+			name:       "numbers from values",
+			a:          TableCell{Text: "ten", Value: 10.0},
+			b:          TableCell{Text: "nine", Value: int64(9)},
+			columnType: ColumnNumber,
+			expected:   1,
+		},
+		{
+			name:       "numbers from text",
+			a:          TableCell{Text: "9%"},
+			b:          TableCell{Text: "10%"},
+			columnType: ColumnNumber,
+			expected:   -1,
+		},
+		{
+			name:       "a number that can't be read",
+			a:          TableCell{Text: "n/a"},
+			b:          TableCell{Text: "1"},
+			columnType: ColumnNumber,
+			expected:   -1,
+		},
+		{
+			name:       "times",
+			a:          TableCell{Text: "later", Value: now.Add(time.Hour)},
+			b:          TableCell{Text: "now", Value: now},
+			columnType: ColumnTime,
+			expected:   1,
+		},
+		{
+			name:       "the same time",
+			a:          TableCell{Value: now},
+			b:          TableCell{Value: now},
+			columnType: ColumnTime,
+			expected:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareCells(tt.a, tt.b, tt.columnType))
+		})
+	}
+}
+
+func Test_fitCell(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		width      int
+		rightAlign bool
+		expected   string
+	}{
+		{
+			name:     "padded on the right",
+			text:     "abc",
+			width:    5,
+			expected: "abc  ",
+		},
+		{
+			name:       "padded on the left",
+			text:       "42",
+			width:      5,
+			rightAlign: true,
+			expected:   "   42",
+		},
+		{
+			name:     "cut short",
+			text:     "ubuntu.iso",
+			width:    6,
+			expected: "ubunt…",
+		},
+		{
+			name:     "cut short without room for an ellipsis",
+			text:     "ubuntu.iso",
+			width:    1,
+			expected: "u",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, fitCell(tt.text, tt.width, tt.rightAlign))
+		})
+	}
+}
@@ -27,6 +27,12 @@ func NewTextWidget(app *tview.Application, commonSettings *cfg.Common) TextWidge
 
 /* -------------------- Exported Functions -------------------- */
 
+// ApplyColors redraws the view in the widget's colors, after they have changed. Must be
+// called from within the app's event loop
+func (widget *TextWidget) ApplyColors() {
+	widget.setColors(widget.View)
+}
+
 func (widget *TextWidget) TextView() *tview.TextView {
 	return widget.View
 }
@@ -38,24 +44,53 @@ func (widget *TextWidget) Redraw(data func() (string, string, bool)) {
 		widget.View.Clear()
 		widget.View.SetWrap(wrap)
 		widget.View.SetTitle(widget.ContextualTitle(title))
+		widget.updateBorderColor()
 		// widget.View.SetText(strings.TrimSpace(content))
 		widget.View.SetText(strings.TrimRight(content, "\n"))
 		// widget.View.SetText(content)
 	})
 }
 
+// RedrawTitle redraws the widget's title and border to reflect its current refresh state
+func (widget *TextWidget) RedrawTitle() {
+	widget.app.QueueUpdateDraw(func() {
+		widget.View.SetTitle(widget.ContextualTitle(widget.title))
+		widget.updateBorderColor()
+	})
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
+// updateBorderColor redraws the border in the widget's current state color. Focused widgets
+// keep their focus color, which is managed by the focus tracker
+func (widget *TextWidget) updateBorderColor() {
+	if widget.View.HasFocus() {
+		return
+	}
+
+	widget.View.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
+}
+
 func (widget *TextWidget) createView(bordered bool) *tview.TextView {
 	view := tview.NewTextView()
 
-	view.SetBackgroundColor(wtf.ColorFor(widget.commonSettings.Colors.WidgetTheme.Background))
 	view.SetBorder(bordered)
-	view.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
 	view.SetDynamicColors(true)
-	view.SetTextColor(wtf.ColorFor(widget.commonSettings.Colors.TextTheme.Text))
-	view.SetTitleColor(wtf.ColorFor(widget.commonSettings.Colors.TextTheme.Title))
 	view.SetWrap(false)
 
+	widget.setColors(view)
+
 	return view
 }
+
+func (widget *TextWidget) setColors(view *tview.TextView) {
+	view.SetBackgroundColor(wtf.ColorFor(widget.commonSettings.Colors.WidgetTheme.Background))
+	view.SetTextColor(wtf.ColorFor(widget.commonSettings.Colors.TextTheme.Text))
+	view.SetTitleColor(wtf.ColorFor(widget.commonSettings.Colors.TextTheme.Title))
+
+	if view.HasFocus() {
+		view.SetBorderColor(wtf.ColorFor(widget.commonSettings.Colors.BorderTheme.Focused))
+	} else {
+		view.SetBorderColor(wtf.ColorFor(widget.BorderColor()))
+	}
+}
@@ -1,10 +1,17 @@
 package view
 
 import (
+	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+	"github.com/wtfutil/wtf/cache"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/rules"
 )
 
 func testTextWidget() TextWidget {
@@ -206,3 +213,176 @@ func Test_String(t *testing.T) {
 		t.Errorf("\nexpected: %s\n     got: %s", expected, actual)
 	}
 }
+
+func Test_RefreshError(t *testing.T) {
+	txtWid := testTextWidget()
+	txtWid.commonSettings.Colors.BorderTheme = cfg.BorderTheme{
+		Errored:     "red",
+		Unfocusable: "gray",
+	}
+
+	assert.Nil(t, txtWid.RefreshError())
+	assert.Equal(t, "", txtWid.RefreshErrorText())
+	assert.Equal(t, "gray", txtWid.BorderColor())
+	assert.Equal(t, " title ", txtWid.ContextualTitle("title"))
+
+	txtWid.SetRefreshError(errors.New("timed out"))
+	txtWid.SetRefreshedAt(time.Now())
+
+	assert.EqualError(t, txtWid.RefreshError(), "timed out")
+	assert.False(t, txtWid.RefreshErrorAt().IsZero())
+	assert.Equal(
+		t,
+		"last refresh failed: timed out at "+txtWid.RefreshErrorAt().Format("15:04:05"),
+		txtWid.RefreshErrorText(),
+	)
+	assert.Equal(t, "red", txtWid.BorderColor())
+	assert.Equal(t, " [red]![-] title ", txtWid.ContextualTitle("title"))
+
+	txtWid.SetRefreshError(nil)
+
+	assert.True(t, txtWid.RefreshErrorAt().IsZero())
+	assert.Equal(t, "gray", txtWid.BorderColor())
+}
+
+func Test_Stale(t *testing.T) {
+	tests := []struct {
+		name       string
+		staleAfter int
+		updatedAgo time.Duration
+		expected   bool
+	}{
+		{
+			name:       "when never updated",
+			staleAfter: 3,
+			expected:   false,
+		},
+		{
+			name:       "when recently updated",
+			staleAfter: 3,
+			updatedAgo: 20 * time.Second,
+			expected:   false,
+		},
+		{
+			name:       "when updated too long ago",
+			staleAfter: 3,
+			updatedAgo: 40 * time.Second,
+			expected:   true,
+		},
+		{
+			name:       "when the check is disabled",
+			staleAfter: 0,
+			updatedAgo: 40 * time.Second,
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txtWid := testTextWidget()
+			txtWid.commonSettings.StaleAfter = tt.staleAfter
+			txtWid.refreshInterval = 10
+
+			if tt.updatedAgo > 0 {
+				txtWid.SetRefreshedAt(time.Now().Add(-tt.updatedAgo))
+			}
+
+			assert.Equal(t, tt.expected, txtWid.Stale())
+		})
+	}
+}
+
+func Test_SetRefreshing(t *testing.T) {
+	txtWid := testTextWidget()
+
+	assert.False(t, txtWid.Refreshing())
+	assert.True(t, txtWid.RefreshStartAt().IsZero())
+
+	txtWid.SetRefreshing(true)
+
+	assert.True(t, txtWid.Refreshing())
+	assert.False(t, txtWid.RefreshStartAt().IsZero())
+	assert.Equal(t, " ↻ title ", txtWid.ContextualTitle("title"))
+
+	txtWid.SetRefreshing(false)
+
+	assert.False(t, txtWid.Refreshing())
+	assert.Equal(t, " title ", txtWid.ContextualTitle("title"))
+}
+
+func Test_Flash(t *testing.T) {
+	txtWid := testTextWidget()
+	txtWid.commonSettings.Colors = cfg.NewDefaultColorTheme()
+
+	assert.False(t, txtWid.Flashing())
+
+	txtWid.Flash(time.Minute)
+
+	assert.True(t, txtWid.Flashing())
+	assert.Equal(t, " [black:green]title[-:-] ", txtWid.ContextualTitle("title"))
+
+	txtWid.Flash(0)
+
+	assert.False(t, txtWid.Flashing())
+	assert.Equal(t, " title ", txtWid.ContextualTitle("title"))
+}
+
+func Test_SetValues(t *testing.T) {
+	hot, _ := rules.ParseExpression("cpu > 90")
+	busy, _ := rules.ParseExpression("cpu > 50")
+
+	txtWid := testTextWidget()
+	txtWid.commonSettings.Colors = cfg.NewDefaultColorTheme()
+	txtWid.commonSettings.Rules = []*rules.Rule{
+		{Name: "hot", When: hot, Border: "red", Badge: "HOT"},
+		{Name: "busy", When: busy, Border: "yellow"},
+	}
+
+	txtWid.SetValues(map[string]interface{}{"cpu": 95})
+
+	assert.Equal(t, txtWid.commonSettings.Rules, txtWid.MatchingRules())
+	assert.Equal(t, "red", txtWid.BorderColor())
+	assert.Equal(t, " title HOT ", txtWid.ContextualTitle("title"))
+
+	txtWid.SetValues(map[string]interface{}{"cpu": 60})
+
+	assert.Equal(t, txtWid.commonSettings.Rules[1:], txtWid.MatchingRules())
+	assert.Equal(t, "yellow", txtWid.BorderColor())
+	assert.Equal(t, " title ", txtWid.ContextualTitle("title"))
+
+	txtWid.SetValues(map[string]interface{}{"cpu": 10})
+
+	assert.Equal(t, []*rules.Rule{}, txtWid.MatchingRules())
+	assert.Equal(t, map[string]interface{}{"cpu": 10}, txtWid.Values())
+}
+
+func Test_Cache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wtf-cache")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	txtWid := testTextWidget()
+	txtWid.commonSettings.CacheTTL = 60
+
+	loaded := []string{}
+
+	assert.False(t, txtWid.LoadCache(&loaded))
+	assert.NoError(t, txtWid.SaveCache([]string{"cats"}))
+
+	txtWid.cache = cache.NewCache(dir)
+
+	assert.NoError(t, txtWid.SaveCache([]string{"cats"}))
+	assert.True(t, txtWid.LoadCache(&loaded))
+	assert.Equal(t, []string{"cats"}, loaded)
+	assert.True(t, txtWid.Stale())
+
+	txtWid.SetRefreshedAt(time.Now())
+
+	assert.False(t, txtWid.Stale())
+
+	// Only the first refresh shows the cached data
+	loaded = []string{}
+	assert.True(t, txtWid.LoadCacheOnce(&loaded))
+	assert.Equal(t, []string{"cats"}, loaded)
+	assert.False(t, txtWid.LoadCacheOnce(&loaded))
+}
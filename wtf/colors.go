@@ -1,6 +1,7 @@
 package wtf
 
 import (
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -8,6 +9,19 @@ import (
 	"github.com/gdamore/tcell"
 )
 
+var (
+	tviewColorTagRegExp  = regexp.MustCompile(`\[([a-zA-Z]+|#[0-9a-zA-Z]{6}|\-)?(:([a-zA-Z]+|#[0-9a-zA-Z]{6}|\-)?(:([lbdru]+|\-)?)?)?\]`)
+	tviewRegionTagRegExp = regexp.MustCompile(`\["([a-zA-Z0-9_,;: \-\.]*)"\]`)
+
+	ansiAttributes = map[rune]string{
+		'b': "1",
+		'd': "2",
+		'u': "4",
+		'l': "5",
+		'r': "7",
+	}
+)
+
 var colorMap = map[int]string{
 	0:   "#000000",
 	1:   "#800000",
@@ -282,8 +296,62 @@ func ColorFor(label string) tcell.Color {
 	return tcell.GetColor(label)
 }
 
+// TviewToANSIColors converts the tview color tags in text into ANSI escape sequences,
+// suitable for writing to a terminal outside of the app. Region tags are removed
+func TviewToANSIColors(text string) string {
+	text = tviewRegionTagRegExp.ReplaceAllString(text, "")
+
+	converted := tviewColorTagRegExp.ReplaceAllStringFunc(text, replaceWithANSIEscape)
+	if converted != text {
+		converted += "\033[0m"
+	}
+
+	return converted
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
+func ansiColorCodes(label string, setCode, resetCode int) []string {
+	if label == "" {
+		return []string{}
+	}
+
+	color := ColorFor(label)
+	if label == "-" || color == tcell.ColorDefault {
+		return []string{strconv.Itoa(resetCode)}
+	}
+
+	r, g, b := color.RGB()
+	if r < 0 {
+		return []string{strconv.Itoa(resetCode)}
+	}
+
+	return []string{fmt.Sprintf("%d;2;%d;%d;%d", setCode, r, g, b)}
+}
+
+func replaceWithANSIEscape(tag string) string {
+	match := tviewColorTagRegExp.FindStringSubmatch(tag)
+
+	codes := ansiColorCodes(match[1], 38, 39)
+	codes = append(codes, ansiColorCodes(match[3], 48, 49)...)
+
+	switch attributes := match[5]; attributes {
+	case "":
+	case "-":
+		codes = append(codes, "22", "24", "25", "27")
+	default:
+		for _, attribute := range attributes {
+			codes = append(codes, ansiAttributes[attribute])
+		}
+	}
+
+	if len(codes) == 0 {
+		return ""
+	}
+
+	return "\033[" + strings.Join(codes, ";") + "m"
+}
+
 func replaceWithHexColorString(substring string) string {
 	colorID, err := strconv.Atoi(strings.Trim(
 		strings.Split(substring, ";")[2], "m"))
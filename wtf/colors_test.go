@@ -73,3 +73,42 @@ func Test_ColorFor(t *testing.T) {
 		})
 	}
 }
+
+func Test_TviewToANSIColors(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "with no tags",
+			text:     "cat",
+			expected: "cat",
+		},
+		{
+			name:     "with foreground color",
+			text:     "[red]cat[-]",
+			expected: "\033[38;2;255;0;0mcat\033[39m\033[0m",
+		},
+		{
+			name:     "with background color and attributes",
+			text:     "[:blue:b]cat",
+			expected: "\033[48;2;0;0;255;1mcat\033[0m",
+		},
+		{
+			name:     "with region tags",
+			text:     `["0"]cat[""]`,
+			expected: "cat",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := TviewToANSIColors(tt.text)
+
+			if tt.expected != actual {
+				t.Errorf("\nexpected: %q\n     got: %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -1,8 +1,20 @@
 package wtf
 
+import "time"
+
 // Schedulable is the interface that enforces scheduling capabilities on a module
 type Schedulable interface {
 	Refresh()
+	RefreshError() error
+	RefreshErrorAt() time.Time
+	RefreshErrorText() string
+	RefreshedAt() time.Time
 	Refreshing() bool
 	RefreshInterval() int
+	RefreshStartAt() time.Time
+	SetRefreshError(error)
+	SetRefreshedAt(time.Time)
+	SetRefreshing(bool)
+	Stale() bool
+	UpdatedAt() time.Time
 }
@@ -12,6 +12,7 @@ type Wtfable interface {
 	Schedulable
 	Stoppable
 
+	ApplyColors()
 	BorderColor() string
 	ConfigText() string
 	FocusChar() string
@@ -19,6 +20,7 @@ type Wtfable interface {
 	HelpText() string
 	Name() string
 	QuitChan() chan bool
+	RedrawTitle()
 	SetFocusChar(string)
 	TextView() *tview.TextView
 